@@ -0,0 +1,14 @@
+# Read all hooks of a project, following the pagination
+data "gitlab_api_request" "hooks" {
+  path = "projects/${gitlab_project.example.id}/hooks"
+}
+
+output "hook_urls" {
+  value = [for hook in jsondecode(data.gitlab_api_request.hooks.response) : hook.url]
+}
+
+# Read a single page only
+data "gitlab_api_request" "recent_events" {
+  path     = "events?per_page=5"
+  paginate = false
+}
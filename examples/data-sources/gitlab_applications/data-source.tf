@@ -0,0 +1 @@
+data "gitlab_applications" "example" {}
@@ -0,0 +1,27 @@
+data "gitlab_graphql_query" "compliance_frameworks" {
+  query = <<-EOT
+    query complianceFrameworks($fullPath: ID!) {
+      namespace(fullPath: $fullPath) {
+        complianceFrameworks {
+          nodes { id name }
+        }
+      }
+    }
+  EOT
+
+  variables = jsonencode({
+    fullPath = "my-group"
+  })
+
+  extract = "namespace.complianceFrameworks.nodes[].name"
+}
+
+output "compliance_framework_names" {
+  value = jsondecode(data.gitlab_graphql_query.compliance_frameworks.extracted_value)
+}
+
+# Strings are extracted as-is
+data "gitlab_graphql_query" "current_user" {
+  query   = "query { currentUser { username } }"
+  extract = "currentUser.username"
+}
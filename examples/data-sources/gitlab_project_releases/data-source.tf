@@ -0,0 +1,5 @@
+data "gitlab_project_releases" "example" {
+  project  = "foo/bar"
+  order_by = "created_at"
+  sort     = "asc"
+}
@@ -0,0 +1,11 @@
+# By project ID
+data "gitlab_release" "example" {
+  project  = "12345"
+  tag_name = "v1.0.0"
+}
+
+# By project full path
+data "gitlab_release" "example" {
+  project  = "foo/bar"
+  tag_name = "v1.0.0"
+}
@@ -0,0 +1,17 @@
+# Manage a project hook via the REST API
+resource "gitlab_api_resource" "hook" {
+  create_path = "projects/${gitlab_project.example.id}/hooks"
+  read_path   = "projects/${gitlab_project.example.id}/hooks/{id}"
+  update_path = "projects/${gitlab_project.example.id}/hooks/{id}"
+
+  body = jsonencode({
+    url         = "https://example.com/hook"
+    push_events = true
+  })
+
+  drift_attributes = ["url", "push_events"]
+}
+
+output "hook_created_at" {
+  value = jsondecode(gitlab_api_resource.hook.response).created_at
+}
@@ -0,0 +1,5 @@
+# A GitLab application can be imported using its ID, e.g.
+terraform import gitlab_application.example 1
+
+# NOTE: the `secret` and `scopes` resource attributes are not available for imported resources as this information cannot be read from the GitLab API.
+# The configured `scopes` are stored by the first apply after the import, without recreating the application.
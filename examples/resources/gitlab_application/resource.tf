@@ -0,0 +1,15 @@
+resource "gitlab_application" "oidc" {
+  name         = "internal-tool"
+  redirect_url = "https://internal-tool.example.com/oauth/callback"
+  scopes       = ["openid", "profile", "email"]
+  confidential = true
+}
+
+# The client ID and secret can be passed to the application, e.g. as CI/CD variables
+resource "gitlab_project_variable" "client_secret" {
+  project   = "12345"
+  key       = "OAUTH_CLIENT_SECRET"
+  value     = gitlab_application.oidc.secret
+  protected = true
+  masked    = true
+}
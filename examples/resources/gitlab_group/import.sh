@@ -1,4 +1,5 @@
 # You can import a group state using `terraform import <resource> <id>`.  The
-# `id` can be whatever the [details of a group][details_of_a_group] api takes for
-# its `:id` value, so for example:
-terraform import gitlab_group.example example
+# `id` can be the id or the full path of the group, which is resolved to the id,
+# so for example:
+terraform import gitlab_group.example 42
+terraform import gitlab_group.example my-org/example
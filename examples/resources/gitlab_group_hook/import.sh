@@ -1,4 +1,8 @@
 # A GitLab Group Hook can be imported using a key composed of `<group-id>:<hook-id>`, e.g.
 terraform import gitlab_group_hook.example "12345:1"
 
+# Alternatively, the group can be identified by its full path and the hook by its URL, if it's unique within the group.
+# The group is stored by its ID, therefore the `group` attribute must be configured with the ID to avoid a diff after the import, e.g.
+terraform import gitlab_group_hook.example "my-org/team:https://hooks.example/ci"
+
 # NOTE: the `token` resource attribute is not available for imported resources as this information cannot be read from the GitLab API.
@@ -1,2 +1,5 @@
 # GitLab group membership can be imported using an id made up of `group_id:user_id`, e.g.
 terraform import gitlab_group_membership.test "12345:1337"
+
+# Alternatively, the full path of the group and the username of the user can be used, e.g.
+terraform import gitlab_group_membership.test "my-org/team:jdoe"
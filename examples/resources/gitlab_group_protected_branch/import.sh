@@ -0,0 +1,2 @@
+# Gitlab group protected branches can be imported with a key composed of `<group>:<branch>`, e.g.
+terraform import gitlab_group_protected_branch.main "my-org:main"
@@ -0,0 +1,20 @@
+resource "gitlab_group_protected_branch" "main" {
+  group                        = "my-org"
+  branch                       = "main"
+  push_access_level            = "no one"
+  merge_access_level           = "maintainer"
+  unprotect_access_level       = "maintainer"
+  code_owner_approval_required = true
+}
+
+# Example using a wildcard and a subgroup allowed to push
+resource "gitlab_group_protected_branch" "release" {
+  group              = "my-org"
+  branch             = "release/*"
+  push_access_level  = "maintainer"
+  merge_access_level = "developer"
+
+  allowed_to_push {
+    group_id = 456
+  }
+}
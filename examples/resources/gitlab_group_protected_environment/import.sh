@@ -0,0 +1,2 @@
+# GitLab group protected environments can be imported using an id made up of `<group>:<environment-tier>`, e.g.
+terraform import gitlab_group_protected_environment.production 123:production
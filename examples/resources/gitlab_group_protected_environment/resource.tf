@@ -0,0 +1,20 @@
+# Example with access level
+resource "gitlab_group_protected_environment" "production" {
+  group                   = "my-org"
+  environment             = "production"
+  required_approval_count = 1
+
+  deploy_access_levels {
+    access_level = "maintainer"
+  }
+}
+
+# Example with a subgroup
+resource "gitlab_group_protected_environment" "staging" {
+  group       = "my-org"
+  environment = "staging"
+
+  deploy_access_levels {
+    group_id = 456
+  }
+}
@@ -0,0 +1,3 @@
+# GitLab group push rules can be imported using the group ID or full path, e.g.
+terraform import gitlab_group_push_rules.example 12345
+terraform import gitlab_group_push_rules.example my-group/my-subgroup
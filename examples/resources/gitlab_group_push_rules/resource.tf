@@ -0,0 +1,8 @@
+resource "gitlab_group_push_rules" "example" {
+  group                = "12345"
+  author_email_regex   = "@example\\.com$"
+  branch_name_regex    = "^(main|(feature|hotfix)\\/.*)$"
+  commit_message_regex = "^(feat|fix|docs|chore): .+"
+  deny_delete_tag      = true
+  max_file_size        = 10
+}
@@ -1,2 +1,5 @@
 # GitLab group shares can be imported using an id made up of `mainGroupId:shareGroupId`, e.g.
 terraform import gitlab_group_share_group.test 12345:1337
+
+# Alternatively, the full paths of the groups can be used, e.g.
+terraform import gitlab_group_share_group.test my-org/main:my-org/shared
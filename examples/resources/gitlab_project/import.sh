@@ -1,4 +1,5 @@
 # You can import a project state using `terraform import <resource> <id>`.  The
-# `id` can be whatever the [get single project api][get_single_project] takes for
-# its `:id` value, so for example:
+# `id` can be the id or the full path of the project, which is resolved to the id,
+# so for example:
+terraform import gitlab_project.example 42
 terraform import gitlab_project.example richardc/example
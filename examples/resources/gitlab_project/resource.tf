@@ -9,7 +9,7 @@ resource "gitlab_project" "example" {
 resource "gitlab_project" "example-two" {
   name = "example-two"
 
-  push_rules {
+  push_rules = {
     author_email_regex     = "@example\\.com$"
     commit_committer_check = true
     member_check           = true
@@ -1,4 +1,8 @@
 # A GitLab Project Hook can be imported using a key composed of `<project-id>:<hook-id>`, e.g.
 terraform import gitlab_project_hook.example "12345:1"
 
+# Alternatively, the project can be identified by its full path and the hook by its URL, if it's unique within the project.
+# The project is stored by its ID, therefore the `project` attribute must be configured with the ID to avoid a diff after the import, e.g.
+terraform import gitlab_project_hook.example "my-org/app:https://hooks.example/ci"
+
 # NOTE: the `token` resource attribute is not available for imported resources as this information cannot be read from the GitLab API.
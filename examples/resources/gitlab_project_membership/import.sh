@@ -1,2 +1,5 @@
 # GitLab project membership can be imported using an id made up of `project_id:user_id`, e.g.
 terraform import gitlab_project_membership.test "12345:1337"
+
+# Alternatively, the full path of the project and the username of the user can be used, e.g.
+terraform import gitlab_project_membership.test "my-org/app:jdoe"
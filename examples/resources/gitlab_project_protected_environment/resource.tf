@@ -53,3 +53,26 @@ resource "gitlab_project_protected_environment" "example_with_multiple" {
     user_id = 789
   }
 }
+
+# Example with approval rules, which are changed without unprotecting the environment
+resource "gitlab_project_protected_environment" "example_with_approval_rules" {
+  project     = gitlab_project_environment.this.project
+  environment = gitlab_project_environment.this.name
+
+  deploy_access_levels {
+    access_level = "developer"
+  }
+
+  approval_rules {
+    access_level       = "maintainer"
+    required_approvals = 2
+  }
+
+  approval_rules {
+    group_id = 456
+  }
+
+  approval_rules {
+    user_id = 789
+  }
+}
@@ -0,0 +1,3 @@
+# GitLab project push rules can be imported using the project ID or full path, e.g.
+terraform import gitlab_project_push_rules.example 12345
+terraform import gitlab_project_push_rules.example my-group/my-project
@@ -0,0 +1,8 @@
+resource "gitlab_project_push_rules" "example" {
+  project                 = "12345"
+  author_email_regex      = "@example\\.com$"
+  commit_committer_check  = true
+  member_check            = true
+  prevent_secrets         = true
+  reject_unsigned_commits = true
+}
@@ -1,2 +1,5 @@
 # GitLab project group shares can be imported using an id made up of `projectid:groupid`, e.g.
 terraform import gitlab_project_share_group.test 12345:1337
+
+# Alternatively, the full paths of the project and the group can be used, e.g.
+terraform import gitlab_project_share_group.test my-org/app:my-org/team
@@ -0,0 +1,20 @@
+resource "gitlab_project" "example" {
+  name        = "example"
+  description = "An example project"
+}
+
+resource "gitlab_release" "example" {
+  project     = gitlab_project.example.id
+  tag_name    = "v1.0.0"
+  ref         = "main"
+  name        = "Release 1.0.0"
+  description = "The first release of the example project."
+  milestones  = ["v1.0"]
+
+  links {
+    name      = "Linux binary"
+    url       = "https://example.com/downloads/example-linux-amd64"
+    filepath  = "/binaries/example-linux-amd64"
+    link_type = "package"
+  }
+}
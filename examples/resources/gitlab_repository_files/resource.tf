@@ -0,0 +1,19 @@
+resource "gitlab_project" "this" {
+  name                   = "example"
+  initialize_with_readme = true
+}
+
+resource "gitlab_repository_files" "ci_templates" {
+  project        = gitlab_project.this.id
+  branch         = "main"
+  author_email   = "terraform@example.com"
+  author_name    = "Terraform"
+  commit_message = "feature: manage CI templates"
+
+  files = {
+    // content will be auto base64 encoded
+    "templates/build.yml" = file("${path.module}/templates/build.yml")
+    "templates/test.yml"  = file("${path.module}/templates/test.yml")
+    "templates/meow.txt"  = base64encode("Meow goes the cat")
+  }
+}
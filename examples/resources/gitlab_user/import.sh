@@ -1,4 +1,5 @@
 # You can import a user to terraform state using `terraform import <resource> <id>`.
-# The `id` must be an integer for the id of the user you want to import,
+# The `id` can be the id or the username of the user you want to import,
 # for example:
 terraform import gitlab_user.example 42
+terraform import gitlab_user.example jdoe
@@ -0,0 +1,195 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// Tiers of GitLab which are required by some features.
+const (
+	TierFree     = "Free"
+	TierPremium  = "Premium"
+	TierUltimate = "Ultimate"
+)
+
+// Feature describes the requirements of a GitLab feature, which isn't available on all GitLab instances.
+type Feature struct {
+	// MinVersion is the minimum GitLab version in the form `major.minor`.
+	MinVersion string
+	// Tier is the minimum tier required for the feature.
+	// Features of the Premium and Ultimate tiers require the Enterprise Edition.
+	Tier string
+}
+
+// String returns the requirements in the form `GitLab >= 15.8 / Premium`.
+func (f Feature) String() string {
+	requirements := "GitLab"
+	if f.MinVersion != "" {
+		requirements += " >= " + f.MinVersion
+	}
+	if f.Tier != "" && f.Tier != TierFree {
+		requirements += " / " + f.Tier
+	}
+	return requirements
+}
+
+// Features is the registry of all features which are gated by the capabilities of the GitLab instance.
+// The keys are used with Capabilities.SupportsFeature.
+var Features = map[string]Feature{
+	"audit_events":                         {Tier: TierPremium},
+	"group_protected_branches":             {MinVersion: "15.9", Tier: TierPremium},
+	"group_protected_environments":         {MinVersion: "14.0", Tier: TierPremium},
+	"group_push_rules":                     {MinVersion: "13.4", Tier: TierPremium},
+	"project_push_rules":                   {Tier: TierPremium},
+	"protected_environment_approval_rules": {MinVersion: "14.10", Tier: TierPremium},
+	"protected_environment_update":         {MinVersion: "15.4", Tier: TierPremium},
+	"topic_title":                          {MinVersion: "15.0"},
+}
+
+// Capabilities provides the version and edition of a GitLab instance.
+// They are fetched lazily once and cached for the lifetime of the Capabilities.
+type Capabilities struct {
+	client *gitlab.Client
+
+	mu         sync.Mutex
+	loaded     bool
+	version    string
+	major      int
+	minor      int
+	enterprise bool
+}
+
+// NewCapabilities returns the capabilities of the GitLab instance of the given client.
+// The provider creates them once with its client and shares them with all resources through the ProviderData,
+// so that they are only fetched once.
+func NewCapabilities(client *gitlab.Client) *Capabilities {
+	return &Capabilities{client: client}
+}
+
+// load fetches the metadata of the GitLab instance, if it hasn't been fetched yet.
+// Errors aren't cached, so that a transient error is retried on the next call.
+func (c *Capabilities) load(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded {
+		return nil
+	}
+
+	// The metadata API is available since GitLab 15.2, older versions only provide the version API.
+	metadata, _, err := c.client.Metadata.GetMetadata(gitlab.WithContext(ctx))
+	if err != nil && !Is404(err) {
+		return fmt.Errorf("failed to get the metadata of the GitLab instance: %w", err)
+	}
+	if metadata != nil {
+		c.version = metadata.Version
+		c.enterprise = metadata.Enterprise
+	} else {
+		version, _, err := c.client.Version.GetVersion(gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to get the version of the GitLab instance: %w", err)
+		}
+		c.version = version.Version
+	}
+	// The `enterprise` metadata was only added in GitLab 15.6, the version of the Enterprise Edition has an `-ee` suffix.
+	if strings.HasSuffix(c.version, "-ee") {
+		c.enterprise = true
+	}
+
+	c.major, c.minor, err = parseVersionMajorMinor(c.version)
+	if err != nil {
+		return fmt.Errorf("failed to parse actual version %q: %w", c.version, err)
+	}
+
+	c.loaded = true
+	return nil
+}
+
+// Version returns the version of the GitLab instance, e.g. `15.8.0-ee`.
+func (c *Capabilities) Version(ctx context.Context) (string, error) {
+	if err := c.load(ctx); err != nil {
+		return "", err
+	}
+	return c.version, nil
+}
+
+// IsEnterprise returns true if the GitLab instance runs the Enterprise Edition.
+func (c *Capabilities) IsEnterprise(ctx context.Context) (bool, error) {
+	if err := c.load(ctx); err != nil {
+		return false, err
+	}
+	return c.enterprise, nil
+}
+
+// IsVersionAtLeast checks that the version of GitLab is at least the provided wantVersion.
+// It only checks the major and minor version numbers, not the patch.
+func (c *Capabilities) IsVersionAtLeast(ctx context.Context, wantVersion string) (bool, error) {
+	wantMajor, wantMinor, err := parseVersionMajorMinor(wantVersion)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse wanted version %q: %w", wantVersion, err)
+	}
+
+	if err := c.load(ctx); err != nil {
+		return false, err
+	}
+
+	if c.major == wantMajor {
+		return c.minor >= wantMinor, nil
+	}
+	return c.major > wantMajor, nil
+}
+
+// SupportsFeature checks that the GitLab instance fulfills the requirements of the given feature from the Features registry.
+// NOTE: the license of an Enterprise Edition instance isn't known, therefore only the edition is checked for the tier.
+func (c *Capabilities) SupportsFeature(ctx context.Context, name string) (bool, error) {
+	feature, ok := Features[name]
+	if !ok {
+		return false, fmt.Errorf("unknown GitLab feature %q", name)
+	}
+
+	if feature.MinVersion != "" {
+		isAtLeast, err := c.IsVersionAtLeast(ctx, feature.MinVersion)
+		if err != nil || !isAtLeast {
+			return false, err
+		}
+	}
+
+	if feature.Tier != "" && feature.Tier != TierFree {
+		return c.IsEnterprise(ctx)
+	}
+	return true, nil
+}
+
+// CheckFeatureForAttribute returns an error if the given attribute can't be used, because the GitLab instance
+// doesn't support the given feature, e.g. `attribute "title" requires GitLab >= 15.0`.
+func (c *Capabilities) CheckFeatureForAttribute(ctx context.Context, name string, attribute string) error {
+	return c.CheckFeature(ctx, name, fmt.Sprintf("attribute %q", attribute))
+}
+
+// CheckFeatureForResource returns an error if the given resource can't be used, because the GitLab instance
+// doesn't support the given feature, e.g. `resource "gitlab_group_push_rules" requires GitLab >= 13.4 / Premium`.
+func (c *Capabilities) CheckFeatureForResource(ctx context.Context, name string, resourceType string) error {
+	return c.CheckFeature(ctx, name, fmt.Sprintf("resource %q", resourceType))
+}
+
+// CheckFeature returns an error if the GitLab instance doesn't support the given feature.
+// The subject describes what requires the feature, e.g. `attribute "title"`.
+func (c *Capabilities) CheckFeature(ctx context.Context, name string, subject string) error {
+	supported, err := c.SupportsFeature(ctx, name)
+	if err != nil {
+		return err
+	}
+	if supported {
+		return nil
+	}
+
+	edition := "Community Edition"
+	if c.enterprise {
+		edition = "Enterprise Edition"
+	}
+	return fmt.Errorf("%s requires %s, but the GitLab instance runs version %s of the %s", subject, Features[name], c.version, edition)
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func newCapabilitiesTestClient(t *testing.T, handler http.HandlerFunc) *gitlab.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := gitlab.NewClient("token", gitlab.WithBaseURL(server.URL+"/api/v4/"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return client
+}
+
+func TestCapabilities_metadataIsCached(t *testing.T) {
+	requests := make(map[string]int)
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "15.8.1-ee", "revision": "abc", "enterprise": true}`)
+	})
+
+	capabilities := NewCapabilities(client)
+	for i := 0; i < 3; i++ {
+		if supported, err := capabilities.SupportsFeature(context.Background(), "topic_title"); err != nil || !supported {
+			t.Fatalf("expected topic_title to be supported, got %t, %v", supported, err)
+		}
+		if isAtLeast, err := capabilities.IsVersionAtLeast(context.Background(), "15.9"); err != nil || isAtLeast {
+			t.Fatalf("expected version to not be at least 15.9, got %t, %v", isAtLeast, err)
+		}
+	}
+
+	if requests["/api/v4/metadata"] != 1 {
+		t.Fatalf("expected the metadata to be fetched once, got %d requests", requests["/api/v4/metadata"])
+	}
+}
+
+func TestCapabilities_versionFallback(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/metadata" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "14.10.5-ee", "revision": "abc"}`)
+	})
+
+	capabilities := NewCapabilities(client)
+	if version, err := capabilities.Version(context.Background()); err != nil || version != "14.10.5-ee" {
+		t.Fatalf("got version %q, %v; expected %q", version, err, "14.10.5-ee")
+	}
+	if isEnterprise, err := capabilities.IsEnterprise(context.Background()); err != nil || !isEnterprise {
+		t.Fatalf("expected the Enterprise Edition to be detected from the version, got %t, %v", isEnterprise, err)
+	}
+
+	err := capabilities.CheckFeatureForAttribute(context.Background(), "topic_title", "title")
+	if err == nil || !strings.Contains(err.Error(), `attribute "title" requires GitLab >= 15.0`) {
+		t.Fatalf("expected a clear error about the required version, got: %v", err)
+	}
+}
+
+func TestCapabilities_tier(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "15.8.0", "revision": "abc", "enterprise": false}`)
+	})
+
+	Features["test_premium_feature"] = Feature{MinVersion: "15.0", Tier: TierPremium}
+	defer delete(Features, "test_premium_feature")
+
+	err := NewCapabilities(client).CheckFeatureForAttribute(context.Background(), "test_premium_feature", "foo")
+	if err == nil || !strings.Contains(err.Error(), `attribute "foo" requires GitLab >= 15.0 / Premium, but the GitLab instance runs version 15.8.0 of the Community Edition`) {
+		t.Fatalf("expected a clear error about the required tier, got: %v", err)
+	}
+
+	if _, err := NewCapabilities(client).SupportsFeature(context.Background(), "unknown"); err == nil {
+		t.Fatalf("expected an error for an unknown feature")
+	}
+}
+
+func TestCapabilities_resource(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": "15.8.0-ee", "revision": "abc", "enterprise": true}`)
+	})
+
+	capabilities := NewCapabilities(client)
+	err := capabilities.CheckFeatureForResource(context.Background(), "group_protected_branches", "gitlab_group_protected_branch")
+	if err == nil || !strings.Contains(err.Error(), `resource "gitlab_group_protected_branch" requires GitLab >= 15.9 / Premium, but the GitLab instance runs version 15.8.0-ee of the Enterprise Edition`) {
+		t.Fatalf("expected a clear error about the required version, got: %v", err)
+	}
+	if err := capabilities.CheckFeatureForResource(context.Background(), "group_push_rules", "gitlab_group_push_rules"); err != nil {
+		t.Fatalf("expected group push rules to be supported, got: %v", err)
+	}
+}
@@ -0,0 +1,215 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	jwtTokenType           = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+// ciJobTokenDocsURL is the documentation of the permissions of CI job tokens.
+const ciJobTokenDocsURL = "https://docs.gitlab.com/ee/ci/jobs/ci_job_token.html"
+
+// CIJobConfig configures the client to authenticate as the GitLab CI job it's running in.
+//
+// If a TokenExchangeURL is given, the OIDC id_token of the job is exchanged for a short-lived
+// access token at that URL using the OAuth 2.0 Token Exchange (RFC 8693).
+// Otherwise the `CI_JOB_TOKEN` of the job is used, which can only access a few API endpoints.
+type CIJobConfig struct {
+	// IDTokenVariable is the name of the environment variable which contains the OIDC id_token.
+	// Defaults to the deprecated `CI_JOB_JWT_V2` and `CI_JOB_JWT` variables.
+	IDTokenVariable  string
+	TokenExchangeURL string
+	Audience         string
+}
+
+// ciJobTokenEndpoints are the API endpoints which are accessible with a CI job token.
+// see https://docs.gitlab.com/ee/ci/jobs/ci_job_token.html
+var ciJobTokenEndpoints = []*regexp.Regexp{
+	regexp.MustCompile(`^GET /job$`),
+	regexp.MustCompile(`^[A-Z]+ /projects/[^/]+/packages(/.*)?$`),
+	regexp.MustCompile(`^GET /projects/[^/]+/jobs/[^/]+/artifacts(/.*)?$`),
+	regexp.MustCompile(`^GET /projects/[^/]+/jobs/artifacts/.+$`),
+	regexp.MustCompile(`^POST /projects/[^/]+/trigger/pipeline$`),
+	regexp.MustCompile(`^[A-Z]+ /projects/[^/]+/releases(/.*)?$`),
+	regexp.MustCompile(`^[A-Z]+ /projects/[^/]+/terraform/state/.+$`),
+	regexp.MustCompile(`^GET /projects/[^/]+/secure_files(/.*)?$`),
+}
+
+// isRunningInCIJob returns true if the provider runs in a GitLab CI job.
+func isRunningInCIJob() bool {
+	return os.Getenv("GITLAB_CI") == "true" && os.Getenv("CI_JOB_TOKEN") != ""
+}
+
+// ciJobBaseURL returns the API base URL of the GitLab instance the CI job is running on.
+func ciJobBaseURL() string {
+	baseURL := os.Getenv("CI_API_V4_URL")
+	if baseURL != "" && !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	return baseURL
+}
+
+// idToken returns the OIDC id_token of the CI job.
+func (c *CIJobConfig) idToken() (string, error) {
+	variables := []string{"CI_JOB_JWT_V2", "CI_JOB_JWT"}
+	if c.IDTokenVariable != "" {
+		variables = []string{c.IDTokenVariable}
+	}
+	for _, v := range variables {
+		if token := os.Getenv(v); token != "" {
+			return token, nil
+		}
+	}
+	return "", fmt.Errorf("no OIDC id_token found in the %s environment variable, configure it with the `id_tokens` keyword of the CI job", strings.Join(variables, " or "))
+}
+
+// tokenSource returns a token source which exchanges the OIDC id_token of the CI job for access tokens.
+// The given httpClient is used to request the tokens from the token exchange endpoint.
+func (c *CIJobConfig) tokenSource(httpClient *http.Client) (oauth2.TokenSource, error) {
+	idToken, err := c.idToken()
+	if err != nil {
+		return nil, err
+	}
+	return oauth2.ReuseTokenSource(nil, &tokenExchangeSource{
+		url:        c.TokenExchangeURL,
+		audience:   c.Audience,
+		idToken:    idToken,
+		httpClient: httpClient,
+	}), nil
+}
+
+// tokenExchangeSource obtains access tokens with the OAuth 2.0 Token Exchange (RFC 8693).
+type tokenExchangeSource struct {
+	url        string
+	audience   string
+	idToken    string
+	httpClient *http.Client
+}
+
+func (s *tokenExchangeSource) Token() (*oauth2.Token, error) {
+	form := url.Values{
+		"grant_type":         {tokenExchangeGrantType},
+		"subject_token":      {s.idToken},
+		"subject_token_type": {jwtTokenType},
+	}
+	if s.audience != "" {
+		form.Set("audience", s.audience)
+	}
+
+	resp, err := s.httpClient.PostForm(s.url, form)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange the CI job id_token for an access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange the CI job id_token for an access token: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to exchange the CI job id_token for an access token: %s: %s", resp.Status, body)
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse the token exchange response: %w", err)
+	}
+	if result.AccessToken == "" {
+		return nil, errors.New("the token exchange response does not contain an access_token")
+	}
+
+	token := &oauth2.Token{AccessToken: result.AccessToken, TokenType: "Bearer"}
+	if result.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// ciJobTokenTransport authenticates the requests with a CI job token and explains why a request was rejected.
+// The message of rejected requests is extended, because the API only responds with a generic 401 or 403.
+type ciJobTokenTransport struct {
+	token string
+	next  http.RoundTripper
+}
+
+func (t *ciJobTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.Header.Set("JOB-TOKEN", t.token)
+	resp, err := t.next.RoundTrip(r)
+	if err != nil || (resp.StatusCode != http.StatusUnauthorized && resp.StatusCode != http.StatusForbidden) {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	message := strings.TrimSpace(string(body))
+	var raw struct {
+		Message interface{} `json:"message"`
+		Error   string      `json:"error"`
+	}
+	if json.Unmarshal(body, &raw) == nil {
+		if raw.Message != nil {
+			message = fmt.Sprint(raw.Message)
+		} else if raw.Error != "" {
+			message = raw.Error
+		}
+	}
+
+	endpoint := fmt.Sprintf("%s %s", req.Method, ciJobTokenEndpointPath(req.URL))
+	if ciJobTokenCanAccess(endpoint) {
+		message += fmt.Sprintf("; the provider is authenticated with a CI job token which may not be allowed to access this project, check the token access settings of the project, see %s", ciJobTokenDocsURL)
+	} else {
+		message += fmt.Sprintf("; the provider is authenticated with a CI job token which cannot access the %s endpoint, configure the `ci_job` block with a `token_exchange_url` or use another authentication method, see %s", endpoint, ciJobTokenDocsURL)
+	}
+
+	body, err = json.Marshal(map[string]string{"message": message})
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+	return resp, nil
+}
+
+// ciJobTokenEndpointPath returns the path of the given API URL relative to the `/api/v4` prefix.
+func ciJobTokenEndpointPath(u *url.URL) string {
+	path := u.EscapedPath()
+	if i := strings.Index(path, "/api/v4/"); i >= 0 {
+		path = path[i+len("/api/v4"):]
+	}
+	return path
+}
+
+// ciJobTokenCanAccess returns true if the given endpoint, in the form `METHOD /path`, is accessible with a CI job token.
+func ciJobTokenCanAccess(endpoint string) bool {
+	for _, e := range ciJobTokenEndpoints {
+		if e.MatchString(endpoint) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCIJob_canAccess(t *testing.T) {
+	cases := []struct {
+		Endpoint  string
+		CanAccess bool
+	}{
+		{Endpoint: "GET /job", CanAccess: true},
+		{Endpoint: "PUT /projects/foo%2Fbar/packages/generic/pkg/1.0.0/file.txt", CanAccess: true},
+		{Endpoint: "GET /projects/42/jobs/1/artifacts", CanAccess: true},
+		{Endpoint: "POST /projects/42/releases", CanAccess: true},
+		{Endpoint: "POST /projects/42/terraform/state/default", CanAccess: true},
+		{Endpoint: "GET /user", CanAccess: false},
+		{Endpoint: "GET /projects/42", CanAccess: false},
+		{Endpoint: "POST /projects/42/variables", CanAccess: false},
+	}
+
+	for _, tc := range cases {
+		if got := ciJobTokenCanAccess(tc.Endpoint); got != tc.CanAccess {
+			t.Fatalf("got %t for endpoint %q, expected %t", got, tc.Endpoint, tc.CanAccess)
+		}
+	}
+}
+
+func TestCIJob_notInCIJob(t *testing.T) {
+	t.Setenv("GITLAB_CI", "")
+	t.Setenv("CI_JOB_TOKEN", "")
+
+	config := Config{CIJob: &CIJobConfig{}}
+	_, err := config.NewGitLabClient(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "GitLab CI job") {
+		t.Fatalf("expected an error about the missing CI job environment, got: %v", err)
+	}
+}
+
+func TestCIJob_jobToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("JOB-TOKEN") != "job-token" {
+			t.Errorf("got JOB-TOKEN header %q, expected %q", r.Header.Get("JOB-TOKEN"), "job-token")
+		}
+		switch r.URL.Path {
+		case "/api/v4/job":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"id": 1}`)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message": "403 Forbidden"}`)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("GITLAB_CI", "true")
+	t.Setenv("CI_JOB_TOKEN", "job-token")
+	t.Setenv("CI_API_V4_URL", server.URL+"/api/v4")
+
+	config := Config{CIJob: &CIJobConfig{}, EarlyAuthFail: true}
+	client, err := config.NewGitLabClient(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, _, err = client.ProjectVariables.ListVariables(42, nil)
+	if err == nil {
+		t.Fatalf("expected listing the project variables to fail")
+	}
+	if !strings.Contains(err.Error(), "cannot access the GET /projects/42/variables endpoint") {
+		t.Fatalf("expected the error to explain the job token limitation, got: %v", err)
+	}
+
+	_, _, err = client.Releases.ListReleases(42, nil)
+	if err == nil {
+		t.Fatalf("expected listing the project releases to fail")
+	}
+	if !strings.Contains(err.Error(), "token access settings of the project") {
+		t.Fatalf("expected the error to explain the job token project access, got: %v", err)
+	}
+}
+
+func TestCIJob_tokenExchange(t *testing.T) {
+	var exchanges int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/exchange":
+			exchanges++
+			if err := r.ParseForm(); err != nil {
+				t.Errorf("failed to parse token exchange request: %v", err)
+			}
+			if got := r.PostForm.Get("grant_type"); got != tokenExchangeGrantType {
+				t.Errorf("got grant type %q, expected %q", got, tokenExchangeGrantType)
+			}
+			if got := r.PostForm.Get("subject_token"); got != "id-token" {
+				t.Errorf("got subject token %q, expected %q", got, "id-token")
+			}
+			if got := r.PostForm.Get("audience"); got != "gitlab" {
+				t.Errorf("got audience %q, expected %q", got, "gitlab")
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token":      "access",
+				"issued_token_type": "urn:ietf:params:oauth:token-type:access_token",
+				"token_type":        "Bearer",
+				"expires_in":        3600,
+			})
+		case "/api/v4/user":
+			if got := r.Header.Get("Authorization"); got != "Bearer access" {
+				t.Errorf("got authorization header %q, expected %q", got, "Bearer access")
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"id": 1, "username": "root"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("GITLAB_CI", "true")
+	t.Setenv("CI_JOB_TOKEN", "job-token")
+	t.Setenv("CI_API_V4_URL", server.URL+"/api/v4")
+	t.Setenv("GITLAB_OIDC_TOKEN", "id-token")
+
+	config := Config{
+		CIJob: &CIJobConfig{
+			TokenExchangeURL: server.URL + "/exchange",
+			IDTokenVariable:  "GITLAB_OIDC_TOKEN",
+			Audience:         "gitlab",
+		},
+		EarlyAuthFail: true,
+	}
+	client, err := config.NewGitLabClient(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := client.Users.CurrentUser(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the access token is reused until it expires.
+	if exchanges != 1 {
+		t.Fatalf("expected 1 token exchange, got %d", exchanges)
+	}
+}
+
+func TestCIJob_missingIDToken(t *testing.T) {
+	t.Setenv("CI_JOB_JWT_V2", "")
+	t.Setenv("CI_JOB_JWT", "")
+
+	_, err := (&CIJobConfig{TokenExchangeURL: "https://example.com"}).tokenSource(http.DefaultClient)
+	if err == nil || !strings.Contains(err.Error(), "CI_JOB_JWT_V2 or CI_JOB_JWT") {
+		t.Fatalf("expected an error about the missing id_token, got: %v", err)
+	}
+}
@@ -5,11 +5,15 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/xanzy/go-gitlab"
+	"golang.org/x/oauth2"
 )
 
 // Config is per-provider, specifies where to connect to gitlab
@@ -21,12 +25,75 @@ type Config struct {
 	ClientCert    string
 	ClientKey     string
 	EarlyAuthFail bool
+
+	// Retry configures the retry behavior of the client.
+	// If it's nil, the go-gitlab defaults are used.
+	Retry *RetryConfig
+
+	// RequestsPerSecond and MaxConcurrentRequests throttle the requests to the GitLab instance.
+	// A value of zero disables the corresponding limit.
+	RequestsPerSecond     float64
+	MaxConcurrentRequests int
+
+	// OAuth configures the client to obtain its access tokens from an OAuth2 token endpoint.
+	// It's mutually exclusive with the Token.
+	OAuth *OAuthConfig
+
+	// ProxyURL is the URL of the proxy used for all requests.
+	// If it's empty, the proxy is configured with the environment variables like `HTTPS_PROXY`.
+	ProxyURL string
+
+	// Headers are added to all requests, e.g. for an identity-aware proxy in front of the GitLab instance.
+	Headers map[string]string
+
+	// RequestTimeout limits the time of a single request, including reading the response body.
+	// A value of zero means no timeout.
+	RequestTimeout time.Duration
+
+	// TokenCommand and TokenFile configure the client to read its token from the output of a command or from a file.
+	// The token is re-read when the GitLab API responds with a 401. They are mutually exclusive with the Token.
+	TokenCommand string
+	TokenFile    string
+
+	// CIJob configures the client to authenticate as the GitLab CI job it's running in.
+	// It's mutually exclusive with the Token.
+	CIJob *CIJobConfig
+
+	// DriftReport enables the warnings about audited resources which changed outside of Terraform,
+	// naming the author of the most recent change from the audit events.
+	DriftReport bool
 }
 
+// WrapBaseTransport, if set, wraps the transport which sends the requests of all clients to the GitLab instance.
+// It's a hook for the tests to record and replay the interactions with the GitLab API.
+var WrapBaseTransport func(http.RoundTripper) http.RoundTripper
+
 // Client returns a *gitlab.Client to interact with the configured gitlab instance
 func (c *Config) NewGitLabClient(ctx context.Context) (*gitlab.Client, error) {
-	if c.Token == "" {
-		return nil, errors.New("No GitLab token configured, either use the `token` provider argument or set it as `GITLAB_TOKEN` environment variable")
+	authMethods := 0
+	for _, configured := range []bool{c.Token != "", c.OAuth != nil, c.TokenCommand != "", c.TokenFile != "", c.CIJob != nil} {
+		if configured {
+			authMethods++
+		}
+	}
+	if authMethods > 1 {
+		return nil, errors.New("Only one of a GitLab token, token command, token file, OAuth or CI job configuration can be used")
+	}
+	if authMethods == 0 && isRunningInCIJob() {
+		return nil, errors.New("No GitLab token configured, the provider runs in a GitLab CI job, configure the `ci_job` provider argument to authenticate as the job or use one of the other authentication methods")
+	}
+	if authMethods == 0 {
+		return nil, errors.New("No GitLab token configured, either use the `token` provider argument, set it as `GITLAB_TOKEN` environment variable or configure one of the `token_command`, `token_file`, `oauth` or `ci_job` provider arguments")
+	}
+
+	// Detect the GitLab instance the CI job is running on.
+	if c.CIJob != nil {
+		if !isRunningInCIJob() {
+			return nil, errors.New("The CI job authentication is only available within a GitLab CI job, but the `GITLAB_CI` and `CI_JOB_TOKEN` environment variables are not set")
+		}
+		if c.BaseURL == "" {
+			c.BaseURL = ciJobBaseURL()
+		}
 	}
 
 	// Configure TLS/SSL
@@ -62,14 +129,92 @@ func (c *Config) NewGitLabClient(ctx context.Context) (*gitlab.Client, error) {
 	t.TLSClientConfig = tlsConfig
 	t.MaxIdleConnsPerHost = 100
 
+	// Use the configured proxy instead of the one from the environment variables.
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy url %q: %w", c.ProxyURL, err)
+		}
+		t.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	// The base transport is also used to obtain the access tokens, which may require the custom headers as well.
+	var base http.RoundTripper = t
+	if len(c.Headers) > 0 {
+		base = &headersTransport{headers: c.Headers, next: base}
+	}
+	if WrapBaseTransport != nil {
+		base = WrapBaseTransport(base)
+	}
+	baseClient := &http.Client{Transport: base, Timeout: c.RequestTimeout}
+
+	var transport http.RoundTripper = base
+
+	// Authenticate the requests with the OAuth access tokens, which are refreshed when they expire.
+	// NOTE: this transport is wrapped by the logging transport so that the access tokens are never logged.
+	if c.OAuth != nil {
+		tokenSource, err := c.OAuth.sharedTokenSource(c.BaseURL, baseClient)
+		if err != nil {
+			return nil, err
+		}
+		transport = &oauth2.Transport{Source: tokenSource, Base: transport}
+	}
+
+	// Authenticate the requests as the CI job, either with the access tokens exchanged for its OIDC id_token
+	// or with its job token, in which case rejected requests explain the limited permissions of job tokens.
+	useJobToken := c.CIJob != nil && c.CIJob.TokenExchangeURL == ""
+	if c.CIJob != nil {
+		if useJobToken {
+			transport = &ciJobTokenTransport{token: os.Getenv("CI_JOB_TOKEN"), next: transport}
+		} else {
+			tokenSource, err := c.CIJob.tokenSource(baseClient)
+			if err != nil {
+				return nil, err
+			}
+			transport = &oauth2.Transport{Source: tokenSource, Base: transport}
+		}
+	}
+
+	// Authenticate the requests with the token read from a command or file, which is re-read when it's rejected.
+	// NOTE: like the OAuth transport, this transport is wrapped by the logging transport so that the token is never logged.
+	var reloadable *reloadableToken
+	if c.TokenCommand != "" || c.TokenFile != "" {
+		var err error
+		if reloadable, err = newReloadableToken(c.TokenCommand, c.TokenFile); err != nil {
+			return nil, err
+		}
+		transport = &reloadableTokenTransport{token: reloadable, next: transport}
+	}
+
+	transport = logging.NewSubsystemLoggingHTTPTransport("GitLab", transport)
+
+	// Mask the tokens and sensitive values in the logs of the requests.
+	transport = &logMaskingTransport{token: reloadable, next: transport}
+	if reloadable != nil {
+		ctx = reloadable.maskInContext(ctx)
+	}
+
+	// Throttle the requests, this is shared with all other clients for the same instance.
+	if c.RequestsPerSecond > 0 || c.MaxConcurrentRequests > 0 {
+		transport = &throttledTransport{
+			throttle: throttleForInstance(c.BaseURL, c.RequestsPerSecond, c.MaxConcurrentRequests),
+			next:     transport,
+		}
+	}
+
 	opts := []gitlab.ClientOptionFunc{
 		gitlab.WithHTTPClient(
 			&http.Client{
-				Transport: logging.NewSubsystemLoggingHTTPTransport("GitLab", t),
+				Transport: transport,
+				Timeout:   c.RequestTimeout,
 			},
 		),
 	}
 
+	if c.Retry != nil {
+		opts = append(opts, c.Retry.clientOptions()...)
+	}
+
 	if c.BaseURL != "" {
 		opts = append(opts, gitlab.WithBaseURL(c.BaseURL))
 	}
@@ -77,15 +222,52 @@ func (c *Config) NewGitLabClient(ctx context.Context) (*gitlab.Client, error) {
 	// The OAuth method is also compatible with project/group/personal access and job tokens because they are all usable as Bearer tokens.
 	// Although the job token API access is very limited.
 	// see https://docs.gitlab.com/ee/api#authentication
-	client, err := gitlab.NewOAuthClient(c.Token, opts...)
+	newClient := gitlab.NewOAuthClient
+	if useJobToken {
+		// The job token is sent in the JOB-TOKEN header by the CI job token transport, so that it's never logged.
+		newClient = gitlab.NewJobClient
+	}
+	client, err := newClient(c.Token, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	// Test the credentials by checking we can get information about the authenticated user.
+	// A job token cannot access the user, therefore the job it belongs to is checked instead.
 	if c.EarlyAuthFail {
-		_, _, err = client.Users.CurrentUser(gitlab.WithContext(ctx))
+		if useJobToken {
+			_, _, err = client.Jobs.GetJobTokensJob(&gitlab.GetJobTokensJobOptions{}, gitlab.WithContext(ctx))
+		} else {
+			_, _, err = client.Users.CurrentUser(gitlab.WithContext(ctx))
+		}
 	}
 
 	return client, err
 }
+
+// ParseRequestTimeout parses the request timeout given as duration string like `30s` or `1m`.
+func ParseRequestTimeout(timeout string) (time.Duration, error) {
+	d, err := time.ParseDuration(timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid request timeout %q: %w", timeout, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid request timeout %q: must be positive", timeout)
+	}
+	return d, nil
+}
+
+// headersTransport adds the configured headers to all requests.
+// It's wrapped by the logging transport, because the headers may contain credentials.
+type headersTransport struct {
+	headers map[string]string
+	next    http.RoundTripper
+}
+
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	for k, v := range t.headers {
+		r.Header.Set(k, v)
+	}
+	return t.next.RoundTrip(r)
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestConfig_headers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Proxy-Auth"); got != "secret" {
+			t.Errorf("got X-Proxy-Auth header %q, expected %q", got, "secret")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1, "username": "root"}`)
+	}))
+	defer server.Close()
+
+	config := Config{
+		BaseURL:       server.URL + "/api/v4/",
+		Token:         "token",
+		Headers:       map[string]string{"X-Proxy-Auth": "secret"},
+		EarlyAuthFail: true,
+	}
+	if _, err := config.NewGitLabClient(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestConfig_proxyURL(t *testing.T) {
+	var proxied int
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxy receives the absolute URL of the requested resource.
+		if r.URL.Host == "gitlab.example.com" {
+			proxied++
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1, "username": "root"}`)
+	}))
+	defer proxy.Close()
+
+	config := Config{
+		BaseURL:       "http://gitlab.example.com/api/v4/",
+		Token:         "token",
+		ProxyURL:      proxy.URL,
+		EarlyAuthFail: true,
+	}
+	if _, err := config.NewGitLabClient(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if proxied == 0 {
+		t.Fatalf("expected the requests to be sent through the proxy")
+	}
+}
+
+func TestConfig_requestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	config := Config{
+		BaseURL:        server.URL + "/api/v4/",
+		Token:          "token",
+		RequestTimeout: 10 * time.Millisecond,
+		Retry:          &RetryConfig{MaxAttempts: 1},
+		EarlyAuthFail:  true,
+	}
+	if _, err := config.NewGitLabClient(context.Background()); err == nil {
+		t.Fatalf("expected the request to time out")
+	}
+}
+
+func TestConfig_parseRequestTimeout(t *testing.T) {
+	if d, err := ParseRequestTimeout("1m"); err != nil || d != time.Minute {
+		t.Fatalf("got %s, %v; expected 1m", d, err)
+	}
+	for _, invalid := range []string{"", "foo", "0s", "-1s"} {
+		if _, err := ParseRequestTimeout(invalid); err == nil {
+			t.Fatalf("expected an error for request timeout %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// driftReportPages limits the pages of audit events which are searched for the most recent change of a resource,
+// because the audit events of large projects and groups can't be searched completely on every refresh.
+const driftReportPages = 5
+
+// AuditedResource identifies the audit events of a resource.
+// Either the Project or the Group must be set, which is the entity the audit events are recorded for.
+type AuditedResource struct {
+	// Project or Group is the ID or full path of the project or group of the resource.
+	Project string
+	Group   string
+
+	// TargetType is the type of the audited object, e.g. `ProtectedBranch` or `User`.
+	TargetType string
+	// TargetID and TargetDetails match the audited object, e.g. by the ID of a user or the name of a branch.
+	// Empty values match all objects of the target type.
+	TargetID      string
+	TargetDetails string
+}
+
+func (r AuditedResource) matches(event *gitlab.AuditEvent) bool {
+	if !strings.EqualFold(event.Details.TargetType, r.TargetType) {
+		return false
+	}
+	if r.TargetID != "" && fmt.Sprint(event.Details.TargetID) != r.TargetID {
+		return false
+	}
+	return r.TargetDetails == "" || event.Details.TargetDetails == r.TargetDetails
+}
+
+// LatestAuditEvent returns the most recent audit event of the resource, or nil if there is none.
+// The audit events API requires GitLab Premium and at least the Maintainer role for projects or the Owner role for groups.
+func LatestAuditEvent(ctx context.Context, client *gitlab.Client, resource AuditedResource) (*gitlab.AuditEvent, error) {
+	options := &gitlab.ListAuditEventsOptions{ListOptions: gitlab.ListOptions{PerPage: 100, Page: 1}}
+	for page := 0; page < driftReportPages; page++ {
+		var events []*gitlab.AuditEvent
+		var resp *gitlab.Response
+		var err error
+		if resource.Project != "" {
+			events, resp, err = client.AuditEvents.ListProjectAuditEvents(resource.Project, options, gitlab.WithContext(ctx))
+		} else {
+			events, resp, err = client.AuditEvents.ListGroupAuditEvents(resource.Group, options, gitlab.WithContext(ctx))
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var latest *gitlab.AuditEvent
+		for _, event := range events {
+			if !resource.matches(event) {
+				continue
+			}
+			if latest == nil || (event.CreatedAt != nil && latest.CreatedAt != nil && event.CreatedAt.After(*latest.CreatedAt)) {
+				latest = event
+			}
+		}
+		// The audit events are returned with the most recent first.
+		if latest != nil {
+			return latest, nil
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+	return nil, nil
+}
+
+// DriftWarning returns the summary and detail of the warning for a resource whose attributes changed outside of Terraform.
+// The detail names the author and the time of the most recent audit event of the resource, if there is any.
+func DriftWarning(ctx context.Context, data *ProviderData, resource AuditedResource, resourceType string, attributes []string) (string, string) {
+	summary := fmt.Sprintf("%s changed outside of Terraform", resourceType)
+	detail := fmt.Sprintf("The attributes %s changed since the last refresh.", strings.Join(attributes, ", "))
+
+	// Without the audit events, the drift is still reported, but without its author.
+	supported, err := data.Capabilities.SupportsFeature(ctx, "audit_events")
+	if err != nil || !supported {
+		log.Printf("[DEBUG] the audit events of %s aren't available: %t, %v", resourceType, supported, err)
+		detail += " The author of the change is unknown, because the audit events require GitLab Premium."
+		return summary, detail
+	}
+
+	event, err := LatestAuditEvent(ctx, data.Client, resource)
+	switch {
+	case err != nil:
+		log.Printf("[DEBUG] failed to list the audit events of %s: %v", resourceType, err)
+		detail += fmt.Sprintf(" The author of the change is unknown, because the audit events can't be listed: %v", err)
+	case event == nil:
+		detail += " The author of the change is unknown, because there is no audit event for it."
+	default:
+		author := event.Details.AuthorName
+		if author == "" {
+			author = fmt.Sprintf("the user with the ID %d", event.AuthorID)
+		}
+		detail += fmt.Sprintf(" The most recent change was made by %s", author)
+		if event.CreatedAt != nil {
+			detail += fmt.Sprintf(" at %s", event.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"))
+		}
+		detail += "."
+		if event.Details.Change != "" {
+			detail += fmt.Sprintf(" It changed %s from %q to %q.", event.Details.Change, event.Details.From, event.Details.To)
+		}
+	}
+	return summary, detail
+}
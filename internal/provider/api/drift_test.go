@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDriftReport_enabledByConfig(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		config := Config{BaseURL: "http://gitlab.example.com/api/v4/", Token: "token", DriftReport: enabled}
+		data, err := config.NewProviderData(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if data.DriftReport != enabled {
+			t.Errorf("expected the drift report to be enabled: %t", enabled)
+		}
+	}
+}
+
+func TestLatestAuditEvent(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v4/metadata":
+			fmt.Fprint(w, `{"version": "15.8.0-ee", "revision": "abc", "enterprise": true}`)
+		case r.URL.Path == "/api/v4/projects/42/audit_events" && r.URL.Query().Get("page") == "1":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[
+				{"id": 3, "author_id": 1, "created_at": "2023-02-03T10:00:00Z", "details": {"target_type": "ProtectedBranch", "target_details": "develop"}},
+				{"id": 2, "author_id": 1, "created_at": "2023-02-02T10:00:00Z", "details": {"target_type": "User", "target_id": 7, "target_details": "Jane Doe"}}
+			]`)
+		case r.URL.Path == "/api/v4/projects/42/audit_events" && r.URL.Query().Get("page") == "2":
+			fmt.Fprint(w, `[
+				{"id": 1, "author_id": 1, "created_at": "2023-02-01T10:00:00Z", "details": {"author_name": "Administrator", "target_type": "ProtectedBranch", "target_details": "main", "change": "push access levels", "from": "Maintainers", "to": "No one"}}
+			]`)
+		case r.URL.Path == "/api/v4/groups/43/audit_events":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message": "403 Forbidden"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	ctx := context.Background()
+
+	event, err := LatestAuditEvent(ctx, client, AuditedResource{Project: "42", TargetType: "ProtectedBranch", TargetDetails: "main"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event == nil || event.ID != 1 {
+		t.Fatalf("expected the audit event 1 of the branch on the second page, got %+v", event)
+	}
+
+	event, err = LatestAuditEvent(ctx, client, AuditedResource{Project: "42", TargetType: "User", TargetID: "7"})
+	if err != nil || event == nil || event.ID != 2 {
+		t.Fatalf("expected the audit event 2 of the user, got %+v, %v", event, err)
+	}
+
+	event, err = LatestAuditEvent(ctx, client, AuditedResource{Project: "42", TargetType: "User", TargetID: "8"})
+	if err != nil || event != nil {
+		t.Fatalf("expected no audit event of an unknown user, got %+v, %v", event, err)
+	}
+
+	data := &ProviderData{Client: client, Capabilities: NewCapabilities(client)}
+	summary, detail := DriftWarning(ctx, data, AuditedResource{Project: "42", TargetType: "ProtectedBranch", TargetDetails: "main"}, "gitlab_branch_protection", []string{"push_access_level"})
+	if summary != "gitlab_branch_protection changed outside of Terraform" {
+		t.Errorf("unexpected summary %q", summary)
+	}
+	for _, expected := range []string{"push_access_level", "by Administrator at 2023-02-01T10:00:00Z", `from "Maintainers" to "No one"`} {
+		if !strings.Contains(detail, expected) {
+			t.Errorf("expected the detail to contain %q, got %q", expected, detail)
+		}
+	}
+
+	_, detail = DriftWarning(ctx, data, AuditedResource{Group: "43", TargetType: "User", TargetID: "7"}, "gitlab_group_membership", []string{"access_level"})
+	if !strings.Contains(detail, "the audit events can't be listed") {
+		t.Errorf("expected the detail to explain that the audit events are unavailable, got %q", detail)
+	}
+}
+
+func TestDriftWarning_communityEdition(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v4/metadata":
+			fmt.Fprint(w, `{"version": "15.8.0", "revision": "abc", "enterprise": false}`)
+		case "/api/v4/projects/42/audit_events":
+			t.Errorf("unexpected request of the audit events on the Community Edition")
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	data := &ProviderData{Client: client, Capabilities: NewCapabilities(client)}
+	summary, detail := DriftWarning(context.Background(), data, AuditedResource{Project: "42", TargetType: "ProtectedBranch"}, "gitlab_branch_protection", []string{"push_access_level"})
+	if summary != "gitlab_branch_protection changed outside of Terraform" {
+		t.Errorf("unexpected summary %q", summary)
+	}
+	if !strings.Contains(detail, "push_access_level") || !strings.Contains(detail, "the audit events require GitLab Premium") {
+		t.Errorf("expected the detail to explain that the audit events require GitLab Premium, got %q", detail)
+	}
+}
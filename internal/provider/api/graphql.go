@@ -0,0 +1,183 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// GraphQLRequest is a request to the GitLab GraphQL API.
+//
+// see https://docs.gitlab.com/ee/api/graphql/
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLError is an entry of the `errors` array of a GraphQL response.
+type GraphQLError struct {
+	Message   string        `json:"message"`
+	Path      []interface{} `json:"path,omitempty"`
+	Locations []struct {
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	} `json:"locations,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+func (e GraphQLError) Error() string {
+	if len(e.Path) == 0 {
+		return e.Message
+	}
+	path := make([]string, 0, len(e.Path))
+	for _, p := range e.Path {
+		path = append(path, fmt.Sprint(p))
+	}
+	return fmt.Sprintf("%s (path: %s)", e.Message, strings.Join(path, "."))
+}
+
+// GraphQLErrors is returned by SendGraphQLRequest if the response contains errors.
+// The response may still contain partial data, which is decoded nevertheless.
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return "GraphQL request failed: " + strings.Join(messages, "; ")
+}
+
+// graphQLResponse is the envelope of all GraphQL responses.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors"`
+}
+
+// SendGraphQLRequest sends the request to the GraphQL API of GitLab and decodes the `data` of the response into data.
+// A GraphQLErrors error is returned if the response contains errors, even if it contains partial data.
+func SendGraphQLRequest(ctx context.Context, client *gitlab.Client, request GraphQLRequest, data interface{}) error {
+	req, err := client.NewRequest(http.MethodPost, "", request, nil)
+	if err != nil {
+		return err
+	}
+	// Overwrite the path of the existing request, as otherwise the go-gitlab client appends /api/v4 instead.
+	req.URL.Path = strings.TrimSuffix(req.URL.Path, "/")
+	req.URL.Path = strings.TrimSuffix(req.URL.Path, "/v4") + "/graphql"
+	req = req.WithContext(ctx)
+
+	var response graphQLResponse
+	if _, err := client.Do(req, &response); err != nil {
+		return err
+	}
+
+	if data != nil && len(response.Data) > 0 && string(response.Data) != "null" {
+		if err := json.Unmarshal(response.Data, data); err != nil {
+			return fmt.Errorf("failed to decode GraphQL response data: %w", err)
+		}
+	}
+
+	if len(response.Errors) > 0 {
+		return response.Errors
+	}
+	return nil
+}
+
+// GraphQLPageInfo is the `pageInfo` of a GraphQL connection.
+type GraphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// PaginateGraphQLRequest sends the request once per page of a GraphQL connection.
+// The query must declare an `$after: String` variable and pass it to the `after` argument of the connection
+// and it must select the `pageInfo { hasNextPage endCursor }` of the connection.
+// The page function is called with the raw `data` of each page and must return the `pageInfo` of the connection.
+func PaginateGraphQLRequest(ctx context.Context, client *gitlab.Client, request GraphQLRequest, page func(data json.RawMessage) (GraphQLPageInfo, error)) error {
+	variables := make(map[string]interface{}, len(request.Variables)+1)
+	for k, v := range request.Variables {
+		variables[k] = v
+	}
+	request.Variables = variables
+
+	for {
+		var data json.RawMessage
+		if err := SendGraphQLRequest(ctx, client, request, &data); err != nil {
+			return err
+		}
+
+		pageInfo, err := page(data)
+		if err != nil {
+			return err
+		}
+		if !pageInfo.HasNextPage {
+			return nil
+		}
+		if pageInfo.EndCursor == "" || pageInfo.EndCursor == request.Variables["after"] {
+			return errors.New("GraphQL pagination did not advance, the connection must select `pageInfo { hasNextPage endCursor }`")
+		}
+		request.Variables["after"] = pageInfo.EndCursor
+	}
+}
+
+// GlobalID is a parsed global GraphQL ID.
+//
+// e.g. 'gid://gitlab/Namespaces::UserNamespace/1000' -> {Type: "Namespaces::UserNamespace", ID: 1000}
+//
+// see https://docs.gitlab.com/ee/development/api_graphql_styleguide.html#global-ids
+type GlobalID struct {
+	Type string
+	ID   int
+}
+
+const globalIDPrefix = "gid://gitlab/"
+
+// String returns the global GraphQL ID.
+func (g GlobalID) String() string {
+	return fmt.Sprintf("%s%s/%d", globalIDPrefix, g.Type, g.ID)
+}
+
+// BuildGlobalID returns the global GraphQL ID of the given type and internal model ID.
+//
+// e.g. ('Project', 42) -> 'gid://gitlab/Project/42'
+func BuildGlobalID(modelType string, id int) string {
+	return GlobalID{Type: modelType, ID: id}.String()
+}
+
+// ParseGlobalID parses a global GraphQL ID into its type and internal model ID.
+func ParseGlobalID(globalID string) (GlobalID, error) {
+	if !strings.HasPrefix(globalID, globalIDPrefix) {
+		return GlobalID{}, fmt.Errorf("unable to parse global id %q. Was looking for the %q prefix.", globalID, globalIDPrefix)
+	}
+	modelType := strings.TrimPrefix(globalID, globalIDPrefix)
+	if i := strings.LastIndex(modelType, "/"); i >= 0 {
+		modelType = modelType[:i]
+	}
+
+	id, err := ExtractIIDFromGlobalID(globalID)
+	if err != nil {
+		return GlobalID{}, err
+	}
+	return GlobalID{Type: modelType, ID: id}, nil
+}
+
+// ExtractIIDFromGlobalID extracts the internal model ID from a global GraphQL ID.
+//
+// e.g. 'gid://gitlab/User/1' -> 1 or 'gid://gitlab/Project/42' -> 42
+//
+// see https://docs.gitlab.com/ee/development/api_graphql_styleguide.html#global-ids
+func ExtractIIDFromGlobalID(globalID string) (int, error) {
+	parts := strings.Split(globalID, "/")
+	iid, err := strconv.Atoi(parts[len(parts)-1])
+	if err != nil {
+		return 0, fmt.Errorf("unable to extract iid from global id %q. Was looking for an integer after the last slash (/).", globalID)
+	}
+	return iid, nil
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// newGraphQLTestClient returns a client for a GraphQL test server.
+// The rate limiter of the client sends an initial GET request to the base URL, which is ignored.
+func newGraphQLTestClient(t *testing.T, handler http.HandlerFunc) *gitlab.Client {
+	return newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			return
+		}
+		handler(w, r)
+	})
+}
+
+func TestSendGraphQLRequest_variables(t *testing.T) {
+	client := newGraphQLTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/graphql" {
+			t.Errorf("got path %q, expected %q", r.URL.Path, "/api/graphql")
+		}
+
+		var request GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if request.OperationName != "project" || request.Variables["fullPath"] != "foo/bar" {
+			t.Errorf("got unexpected request: %+v", request)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"project": {"id": "gid://gitlab/Project/42"}}}`)
+	})
+
+	var response struct {
+		Project struct {
+			ID string `json:"id"`
+		} `json:"project"`
+	}
+	err := SendGraphQLRequest(context.Background(), client, GraphQLRequest{
+		Query:         `query project($fullPath: ID!) { project(fullPath: $fullPath) { id } }`,
+		OperationName: "project",
+		Variables:     map[string]interface{}{"fullPath": "foo/bar"},
+	}, &response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.Project.ID != "gid://gitlab/Project/42" {
+		t.Fatalf("got project %q, expected %q", response.Project.ID, "gid://gitlab/Project/42")
+	}
+}
+
+func TestSendGraphQLRequest_errors(t *testing.T) {
+	client := newGraphQLTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"data": {"project": {"id": "gid://gitlab/Project/42", "secret": null}},
+			"errors": [{"message": "access denied", "path": ["project", "secret"]}, {"message": "boom"}]
+		}`)
+	})
+
+	var response struct {
+		Project struct {
+			ID string `json:"id"`
+		} `json:"project"`
+	}
+	err := SendGraphQLRequest(context.Background(), client, GraphQLRequest{Query: `query { project { id secret } }`}, &response)
+
+	var graphQLErrors GraphQLErrors
+	if !errors.As(err, &graphQLErrors) || len(graphQLErrors) != 2 {
+		t.Fatalf("expected two GraphQL errors, got: %v", err)
+	}
+	if graphQLErrors[0].Error() != "access denied (path: project.secret)" {
+		t.Fatalf("got error %q, expected the path to be included", graphQLErrors[0].Error())
+	}
+	if response.Project.ID != "gid://gitlab/Project/42" {
+		t.Fatalf("expected the partial data to be decoded, got %q", response.Project.ID)
+	}
+}
+
+func TestPaginateGraphQLRequest(t *testing.T) {
+	pages := map[string]string{
+		"":   `{"data": {"projects": {"nodes": [{"id": "1"}, {"id": "2"}], "pageInfo": {"hasNextPage": true, "endCursor": "c1"}}}}`,
+		"c1": `{"data": {"projects": {"nodes": [{"id": "3"}], "pageInfo": {"hasNextPage": false, "endCursor": "c2"}}}}`,
+	}
+	client := newGraphQLTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var request GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if request.Variables["search"] != "foo" {
+			t.Errorf("expected the variables to be sent with every page, got %+v", request.Variables)
+		}
+		after, _ := request.Variables["after"].(string)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, pages[after])
+	})
+
+	var ids []string
+	err := PaginateGraphQLRequest(context.Background(), client, GraphQLRequest{
+		Query:     `query($search: String, $after: String) { projects(search: $search, after: $after) { nodes { id } pageInfo { hasNextPage endCursor } } }`,
+		Variables: map[string]interface{}{"search": "foo"},
+	}, func(data json.RawMessage) (GraphQLPageInfo, error) {
+		var page struct {
+			Projects struct {
+				Nodes []struct {
+					ID string `json:"id"`
+				} `json:"nodes"`
+				PageInfo GraphQLPageInfo `json:"pageInfo"`
+			} `json:"projects"`
+		}
+		if err := json.Unmarshal(data, &page); err != nil {
+			return GraphQLPageInfo{}, err
+		}
+		for _, node := range page.Projects.Nodes {
+			ids = append(ids, node.ID)
+		}
+		return page.Projects.PageInfo, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fmt.Sprint(ids) != "[1 2 3]" {
+		t.Fatalf("got ids %v, expected all pages to be read", ids)
+	}
+}
+
+func TestPaginateGraphQLRequest_noProgress(t *testing.T) {
+	client := newGraphQLTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {}}`)
+	})
+
+	err := PaginateGraphQLRequest(context.Background(), client, GraphQLRequest{Query: `query { projects { nodes { id } } }`}, func(data json.RawMessage) (GraphQLPageInfo, error) {
+		return GraphQLPageInfo{HasNextPage: true}, nil
+	})
+	if err == nil {
+		t.Fatalf("expected an error if the cursor doesn't advance")
+	}
+}
+
+func TestGlobalID(t *testing.T) {
+	globalID, err := ParseGlobalID("gid://gitlab/Namespaces::UserNamespace/1000")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if globalID.Type != "Namespaces::UserNamespace" || globalID.ID != 1000 {
+		t.Fatalf("got %+v, expected type Namespaces::UserNamespace and id 1000", globalID)
+	}
+	if globalID.String() != "gid://gitlab/Namespaces::UserNamespace/1000" {
+		t.Fatalf("got %q, expected the global id to round-trip", globalID.String())
+	}
+
+	if BuildGlobalID("Project", 42) != "gid://gitlab/Project/42" {
+		t.Fatalf("got %q, expected %q", BuildGlobalID("Project", 42), "gid://gitlab/Project/42")
+	}
+
+	for _, invalid := range []string{"", "42", "gid://gitlab/User/", "gid://gitlab/Namespaces::UserNamespace"} {
+		if _, err := ParseGlobalID(invalid); err == nil {
+			t.Fatalf("expected an error for global id %q", invalid)
+		}
+	}
+}
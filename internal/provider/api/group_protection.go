@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// The group-level protected branches and protected environments aren't supported by go-gitlab yet.
+// Their endpoints accept the same options and return the same objects as the project-level endpoints,
+// so the requests below reuse the types of go-gitlab for branches and the ones of protected_environment.go for environments.
+
+func groupProtectedBranchesPath(group string) string {
+	return fmt.Sprintf("groups/%s/protected_branches", gitlab.PathEscape(group))
+}
+
+func groupProtectedEnvironmentsPath(group string) string {
+	return fmt.Sprintf("groups/%s/protected_environments", gitlab.PathEscape(group))
+}
+
+// GetGroupProtectedBranch gets a protected branch of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_branches.html#get-a-single-protected-branch-or-wildcard-protected-branch
+func GetGroupProtectedBranch(ctx context.Context, client *gitlab.Client, group string, branch string) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	pb := new(gitlab.ProtectedBranch)
+	resp, err := sendRequest(ctx, client, http.MethodGet, groupProtectedBranchesPath(group)+"/"+url.PathEscape(branch), nil, pb)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pb, resp, nil
+}
+
+// ProtectedBranchAccessLevels are the access levels of a protected branch including their IDs,
+// which aren't provided by go-gitlab yet, but are required to destroy the access levels on updates.
+type ProtectedBranchAccessLevels struct {
+	PushAccessLevels      []*ProtectedBranchAccessLevel `json:"push_access_levels"`
+	MergeAccessLevels     []*ProtectedBranchAccessLevel `json:"merge_access_levels"`
+	UnprotectAccessLevels []*ProtectedBranchAccessLevel `json:"unprotect_access_levels"`
+}
+
+// ProtectedBranchAccessLevel is an access level of a protected branch, which is granted to a role or a specific user or group.
+type ProtectedBranchAccessLevel struct {
+	ID          int                     `json:"id"`
+	AccessLevel gitlab.AccessLevelValue `json:"access_level"`
+	UserID      int                     `json:"user_id"`
+	GroupID     int                     `json:"group_id"`
+}
+
+// GetGroupProtectedBranchAccessLevels gets the access levels of a protected branch of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_branches.html#get-a-single-protected-branch-or-wildcard-protected-branch
+func GetGroupProtectedBranchAccessLevels(ctx context.Context, client *gitlab.Client, group string, branch string) (*ProtectedBranchAccessLevels, *gitlab.Response, error) {
+	levels := new(ProtectedBranchAccessLevels)
+	resp, err := sendRequest(ctx, client, http.MethodGet, groupProtectedBranchesPath(group)+"/"+url.PathEscape(branch), nil, levels)
+	if err != nil {
+		return nil, resp, err
+	}
+	return levels, resp, nil
+}
+
+// ProtectGroupBranch protects a branch, or the branches matching a wildcard, in all projects of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_branches.html#protect-repository-branches
+func ProtectGroupBranch(ctx context.Context, client *gitlab.Client, group string, opt *gitlab.ProtectRepositoryBranchesOptions) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	pb := new(gitlab.ProtectedBranch)
+	resp, err := sendRequest(ctx, client, http.MethodPost, groupProtectedBranchesPath(group), opt, pb)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pb, resp, nil
+}
+
+// UpdateGroupProtectedBranchOptions represents the available options to update a protected branch of a group.
+// The access levels of the Allowed* options are changed by destroying the existing ones and adding new ones.
+type UpdateGroupProtectedBranchOptions struct {
+	AllowForcePush            *bool                           `json:"allow_force_push,omitempty"`
+	CodeOwnerApprovalRequired *bool                           `json:"code_owner_approval_required,omitempty"`
+	AllowedToPush             []*ProtectedBranchAccessOptions `json:"allowed_to_push,omitempty"`
+	AllowedToMerge            []*ProtectedBranchAccessOptions `json:"allowed_to_merge,omitempty"`
+	AllowedToUnprotect        []*ProtectedBranchAccessOptions `json:"allowed_to_unprotect,omitempty"`
+}
+
+// ProtectedBranchAccessOptions adds an access level to a protected branch,
+// or destroys the existing access level with the given ID.
+type ProtectedBranchAccessOptions struct {
+	ID          *int                     `json:"id,omitempty"`
+	Destroy     *bool                    `json:"_destroy,omitempty"`
+	AccessLevel *gitlab.AccessLevelValue `json:"access_level,omitempty"`
+}
+
+// UpdateGroupProtectedBranch updates a protected branch of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_branches.html#update-a-protected-branch
+func UpdateGroupProtectedBranch(ctx context.Context, client *gitlab.Client, group string, branch string, opt *UpdateGroupProtectedBranchOptions) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+	pb := new(gitlab.ProtectedBranch)
+	resp, err := sendRequest(ctx, client, http.MethodPatch, groupProtectedBranchesPath(group)+"/"+url.PathEscape(branch), opt, pb)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pb, resp, nil
+}
+
+// UnprotectGroupBranch removes a protected branch of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_branches.html#unprotect-repository-branches
+func UnprotectGroupBranch(ctx context.Context, client *gitlab.Client, group string, branch string) (*gitlab.Response, error) {
+	return sendRequest(ctx, client, http.MethodDelete, groupProtectedBranchesPath(group)+"/"+url.PathEscape(branch), nil, nil)
+}
+
+// GetGroupProtectedEnvironment gets a protected environment of a group.
+// The environments of a group are identified by their deployment tier, e.g. `production`.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_environments.html#get-a-single-protected-environment
+func GetGroupProtectedEnvironment(ctx context.Context, client *gitlab.Client, group string, environment string) (*ProtectedEnvironment, *gitlab.Response, error) {
+	pe := new(ProtectedEnvironment)
+	resp, err := sendRequest(ctx, client, http.MethodGet, groupProtectedEnvironmentsPath(group)+"/"+url.PathEscape(environment), nil, pe)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pe, resp, nil
+}
+
+// ProtectGroupEnvironment protects the environments of a deployment tier in all projects of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_environments.html#protect-a-single-environment
+func ProtectGroupEnvironment(ctx context.Context, client *gitlab.Client, group string, opt *ProtectEnvironmentOptions) (*ProtectedEnvironment, *gitlab.Response, error) {
+	pe := new(ProtectedEnvironment)
+	resp, err := sendRequest(ctx, client, http.MethodPost, groupProtectedEnvironmentsPath(group), opt, pe)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pe, resp, nil
+}
+
+// UnprotectGroupEnvironment removes a protected environment of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_protected_environments.html#unprotect-a-single-environment
+func UnprotectGroupEnvironment(ctx context.Context, client *gitlab.Client, group string, environment string) (*gitlab.Response, error) {
+	return sendRequest(ctx, client, http.MethodDelete, groupProtectedEnvironmentsPath(group)+"/"+url.PathEscape(environment), nil, nil)
+}
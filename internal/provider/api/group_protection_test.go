@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestGroupProtectedBranch(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "POST /api/v4/groups/my-org%2Fteam/protected_branches":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"name":"release/*","push_access_level":40,"allowed_to_push":[{"group_id":7}]}` {
+				t.Errorf("got body %q", string(body))
+			}
+			fmt.Fprint(w, `{"id": 1, "name": "release/*", "push_access_levels": [{"access_level": 40}, {"group_id": 7}]}`)
+		case "GET /api/v4/groups/my-org%2Fteam/protected_branches/release%2F%2A":
+			fmt.Fprint(w, `{"id": 1, "name": "release/*", "code_owner_approval_required": true, "push_access_levels": [{"id": 11, "access_level": 40}, {"id": 12, "group_id": 7}]}`)
+		case "PATCH /api/v4/groups/my-org%2Fteam/protected_branches/release%2F%2A":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"allow_force_push":true,"allowed_to_push":[{"id":11,"_destroy":true},{"access_level":30}]}` {
+				t.Errorf("got body %q", string(body))
+			}
+			fmt.Fprint(w, `{"id": 1, "name": "release/*", "allow_force_push": true, "push_access_levels": [{"id": 13, "access_level": 30}, {"id": 12, "group_id": 7}]}`)
+		case "DELETE /api/v4/groups/my-org%2Fteam/protected_branches/release%2F%2A":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	ctx := context.Background()
+
+	pb, _, err := ProtectGroupBranch(ctx, client, "my-org/team", &gitlab.ProtectRepositoryBranchesOptions{
+		Name:            gitlab.String("release/*"),
+		PushAccessLevel: gitlab.AccessLevel(gitlab.MaintainerPermissions),
+		AllowedToPush:   &[]*gitlab.BranchPermissionOptions{{GroupID: gitlab.Int(7)}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pb.ID != 1 || len(pb.PushAccessLevels) != 2 || pb.PushAccessLevels[1].GroupID != 7 {
+		t.Fatalf("unexpected protected branch %+v", pb)
+	}
+
+	pb, _, err = GetGroupProtectedBranch(ctx, client, "my-org/team", "release/*")
+	if err != nil || !pb.CodeOwnerApprovalRequired {
+		t.Fatalf("unexpected protected branch %+v, %v", pb, err)
+	}
+
+	levels, _, err := GetGroupProtectedBranchAccessLevels(ctx, client, "my-org/team", "release/*")
+	if err != nil || len(levels.PushAccessLevels) != 2 || levels.PushAccessLevels[0].ID != 11 || levels.PushAccessLevels[1].GroupID != 7 {
+		t.Fatalf("unexpected access levels %+v, %v", levels, err)
+	}
+
+	pb, _, err = UpdateGroupProtectedBranch(ctx, client, "my-org/team", "release/*", &UpdateGroupProtectedBranchOptions{
+		AllowForcePush: gitlab.Bool(true),
+		AllowedToPush: []*ProtectedBranchAccessOptions{
+			{ID: gitlab.Int(11), Destroy: gitlab.Bool(true)},
+			{AccessLevel: gitlab.AccessLevel(gitlab.DeveloperPermissions)},
+		},
+	})
+	if err != nil || !pb.AllowForcePush || pb.PushAccessLevels[0].AccessLevel != gitlab.DeveloperPermissions {
+		t.Fatalf("unexpected protected branch %+v, %v", pb, err)
+	}
+
+	if _, err := UnprotectGroupBranch(ctx, client, "my-org/team", "release/*"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestGroupProtectedEnvironment(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "POST /api/v4/groups/42/protected_environments":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"name":"production","deploy_access_levels":[{"access_level":40}],"required_approval_count":1}` {
+				t.Errorf("got body %q", string(body))
+			}
+			fmt.Fprint(w, `{"name": "production", "deploy_access_levels": [{"access_level": 40, "access_level_description": "Maintainers"}], "required_approval_count": 1}`)
+		case "GET /api/v4/groups/42/protected_environments/staging":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "404 Not found"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	ctx := context.Background()
+
+	pe, _, err := ProtectGroupEnvironment(ctx, client, "42", &ProtectEnvironmentOptions{
+		Name:                  gitlab.String("production"),
+		DeployAccessLevels:    &[]*EnvironmentAccessOptions{{AccessLevel: gitlab.AccessLevel(gitlab.MaintainerPermissions)}},
+		RequiredApprovalCount: gitlab.Int(1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pe.Name != "production" || pe.RequiredApprovalCount != 1 || pe.DeployAccessLevels[0].AccessLevelDescription != "Maintainers" {
+		t.Fatalf("unexpected protected environment %+v", pe)
+	}
+
+	if _, _, err := GetGroupProtectedEnvironment(ctx, client, "42", "staging"); !Is404(err) {
+		t.Fatalf("expected a 404 error, got %v", err)
+	}
+}
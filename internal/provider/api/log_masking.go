@@ -0,0 +1,23 @@
+package api
+
+import (
+	"net/http"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// logMaskingTransport masks the GitLab tokens and the sensitive fields of the endpoint in the logs of the requests.
+// It must wrap the logging transport, because the masking is configured in the context of each request.
+type logMaskingTransport struct {
+	// token is the optional token read from a command or file, which doesn't have a well-known format.
+	token *reloadableToken
+	next  http.RoundTripper
+}
+
+func (t *logMaskingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := utils.ApplyRequestLogMaskingToContext(req.Context(), req.URL.Path)
+	if t.token != nil {
+		ctx = t.token.maskInContext(ctx)
+	}
+	return t.next.RoundTrip(req.WithContext(ctx))
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// The lookups below resolve the human-readable identifiers accepted when importing resources,
+// like full paths, usernames or hook URLs, to the canonical numeric IDs stored in the Terraform state.
+// A value which is already numeric is always taken as the ID and returned as is, without calling the API.
+
+// isNumericID returns true if the value is a numeric ID rather than a path or name.
+func isNumericID(value string) bool {
+	_, err := strconv.Atoi(value)
+	return err == nil
+}
+
+// LookupGroupID resolves the full path of a group, e.g. `my-org/team`, to its ID.
+func LookupGroupID(ctx context.Context, client *gitlab.Client, group string) (string, error) {
+	if isNumericID(group) {
+		return group, nil
+	}
+
+	g, _, err := client.Groups.GetGroup(group, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("unable to find group %q: %w", group, err)
+	}
+	return strconv.Itoa(g.ID), nil
+}
+
+// LookupProjectID resolves the full path of a project, e.g. `my-org/app`, to its ID.
+func LookupProjectID(ctx context.Context, client *gitlab.Client, project string) (string, error) {
+	if isNumericID(project) {
+		return project, nil
+	}
+
+	p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("unable to find project %q: %w", project, err)
+	}
+	return strconv.Itoa(p.ID), nil
+}
+
+// LookupUserID resolves the username of a user, e.g. `jdoe`, to its ID.
+func LookupUserID(ctx context.Context, client *gitlab.Client, username string) (string, error) {
+	if isNumericID(username) {
+		return username, nil
+	}
+
+	users, _, err := client.Users.ListUsers(&gitlab.ListUsersOptions{Username: &username}, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("unable to find user %q: %w", username, err)
+	}
+	if len(users) != 1 {
+		return "", fmt.Errorf("unable to find user %q: expected exactly one user with this username, found %d", username, len(users))
+	}
+	return strconv.Itoa(users[0].ID), nil
+}
+
+// LookupProjectHookID resolves the URL of a hook of the given project to its ID.
+// It's an error if the project has no or multiple hooks with this URL.
+func LookupProjectHookID(ctx context.Context, client *gitlab.Client, project string, url string) (string, error) {
+	if isNumericID(url) {
+		return url, nil
+	}
+
+	options := gitlab.ListProjectHooksOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	var ids []int
+	for options.Page != 0 {
+		hooks, resp, err := client.Projects.ListProjectHooks(project, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", fmt.Errorf("unable to list hooks of project %q: %w", project, err)
+		}
+
+		for _, hook := range hooks {
+			if hook.URL == url {
+				ids = append(ids, hook.ID)
+			}
+		}
+		options.Page = resp.NextPage
+	}
+	return singleHookID(ids, url, "project", project)
+}
+
+// LookupGroupHookID resolves the URL of a hook of the given group to its ID.
+// It's an error if the group has no or multiple hooks with this URL.
+func LookupGroupHookID(ctx context.Context, client *gitlab.Client, group string, url string) (string, error) {
+	if isNumericID(url) {
+		return url, nil
+	}
+
+	options := gitlab.ListGroupHooksOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	var ids []int
+	for options.Page != 0 {
+		hooks, resp, err := client.Groups.ListGroupHooks(group, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return "", fmt.Errorf("unable to list hooks of group %q: %w", group, err)
+		}
+
+		for _, hook := range hooks {
+			if hook.URL == url {
+				ids = append(ids, hook.ID)
+			}
+		}
+		options.Page = resp.NextPage
+	}
+	return singleHookID(ids, url, "group", group)
+}
+
+func singleHookID(ids []int, url string, kind string, parent string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("unable to find a hook with the URL %q in %s %q", url, kind, parent)
+	case 1:
+		return strconv.Itoa(ids[0]), nil
+	default:
+		return "", fmt.Errorf("found %d hooks with the URL %q in %s %q, use the hook ID instead", len(ids), url, kind, parent)
+	}
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLookup_numericIDsAreNotResolved(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+		http.NotFound(w, r)
+	})
+
+	ctx := context.Background()
+	lookups := map[string]func() (string, error){
+		"group":        func() (string, error) { return LookupGroupID(ctx, client, "42") },
+		"project":      func() (string, error) { return LookupProjectID(ctx, client, "42") },
+		"user":         func() (string, error) { return LookupUserID(ctx, client, "42") },
+		"project hook": func() (string, error) { return LookupProjectHookID(ctx, client, "1", "42") },
+		"group hook":   func() (string, error) { return LookupGroupHookID(ctx, client, "1", "42") },
+	}
+	for name, lookup := range lookups {
+		if id, err := lookup(); err != nil || id != "42" {
+			t.Errorf("expected the %s ID to be returned as is, got %q, %v", name, id, err)
+		}
+	}
+}
+
+func TestLookup_pathsAndNamesAreResolved(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.EscapedPath() == "/api/v4/groups/my-org%2Fteam":
+			fmt.Fprint(w, `{"id": 7, "full_path": "my-org/team"}`)
+		case r.URL.EscapedPath() == "/api/v4/projects/my-org%2Fapp":
+			fmt.Fprint(w, `{"id": 8, "path_with_namespace": "my-org/app"}`)
+		case r.URL.Path == "/api/v4/users" && r.URL.Query().Get("username") == "jdoe":
+			fmt.Fprint(w, `[{"id": 9, "username": "jdoe"}]`)
+		case r.URL.Path == "/api/v4/users":
+			fmt.Fprint(w, `[]`)
+		case r.URL.Path == "/api/v4/projects/8/hooks" && r.URL.Query().Get("page") == "1":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id": 1, "url": "https://hooks.example/other"}]`)
+		case r.URL.Path == "/api/v4/projects/8/hooks" && r.URL.Query().Get("page") == "2":
+			fmt.Fprint(w, `[{"id": 2, "url": "https://hooks.example/ci"}]`)
+		case r.URL.Path == "/api/v4/groups/7/hooks":
+			fmt.Fprint(w, `[{"id": 3, "url": "https://hooks.example/ci"}, {"id": 4, "url": "https://hooks.example/ci"}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name     string
+		lookup   func() (string, error)
+		expected string
+	}{
+		{"group", func() (string, error) { return LookupGroupID(ctx, client, "my-org/team") }, "7"},
+		{"project", func() (string, error) { return LookupProjectID(ctx, client, "my-org/app") }, "8"},
+		{"user", func() (string, error) { return LookupUserID(ctx, client, "jdoe") }, "9"},
+		{"project hook", func() (string, error) { return LookupProjectHookID(ctx, client, "8", "https://hooks.example/ci") }, "2"},
+	} {
+		if id, err := tc.lookup(); err != nil || id != tc.expected {
+			t.Errorf("expected the %s ID %q, got %q, %v", tc.name, tc.expected, id, err)
+		}
+	}
+
+	if _, err := LookupUserID(ctx, client, "unknown"); err == nil || !strings.Contains(err.Error(), "found 0") {
+		t.Errorf("expected an error for an unknown user, got %v", err)
+	}
+	if _, err := LookupGroupHookID(ctx, client, "7", "https://hooks.example/ci"); err == nil || !strings.Contains(err.Error(), "found 2 hooks") {
+		t.Errorf("expected an error for an ambiguous hook URL, got %v", err)
+	}
+	if _, err := LookupProjectHookID(ctx, client, "8", "https://hooks.example/unknown"); err == nil {
+		t.Errorf("expected an error for an unknown hook URL")
+	}
+	if _, err := LookupGroupID(ctx, client, "my-org/unknown"); err == nil {
+		t.Errorf("expected an error for an unknown group")
+	}
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// OAuthConfig configures the provider to obtain access tokens from an OAuth2 token endpoint
+// instead of using a static token. The access tokens are refreshed transparently when they expire.
+//
+// The grant type is determined by the given credentials:
+//   - `refresh_token` if a RefreshToken is given
+//   - `password` if a Username and Password are given
+//   - `client_credentials` otherwise
+type OAuthConfig struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	RefreshToken string
+	Username     string
+	Password     string
+}
+
+// defaultOAuthTokenURL returns the OAuth2 token endpoint of the GitLab instance with the given API base URL.
+func defaultOAuthTokenURL(baseURL string) (string, error) {
+	if baseURL == "" {
+		baseURL = "https://gitlab.com/api/v4/"
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse base url %q: %w", baseURL, err)
+	}
+	u.Path = "/oauth/token"
+	u.RawQuery = ""
+	return u.String(), nil
+}
+
+var (
+	oauthTokenSourcesMu sync.Mutex
+	// oauthTokenSources holds the token sources per GitLab instance and OAuth configuration,
+	// so that all clients created with the same configuration, like the ones of the SDK and the framework provider,
+	// share the same access tokens. Otherwise, a refresh token would be exchanged by each client,
+	// which fails for all but the first one if the GitLab instance rotates the refresh tokens.
+	oauthTokenSources = make(map[string]oauth2.TokenSource)
+)
+
+// sharedTokenSource returns the token source shared by all clients with the same OAuth configuration for the given GitLab instance.
+// The token source is created with the given httpClient by the first client.
+func (o *OAuthConfig) sharedTokenSource(baseURL string, httpClient *http.Client) (oauth2.TokenSource, error) {
+	oauthTokenSourcesMu.Lock()
+	defer oauthTokenSourcesMu.Unlock()
+
+	key := strings.Join([]string{
+		baseURL, o.TokenURL, o.ClientID, o.ClientSecret, strings.Join(o.Scopes, " "), o.RefreshToken, o.Username, o.Password,
+	}, "\x00")
+	if ts, ok := oauthTokenSources[key]; ok {
+		return ts, nil
+	}
+	ts, err := o.tokenSource(baseURL, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	oauthTokenSources[key] = ts
+	return ts, nil
+}
+
+// tokenSource returns a token source which obtains and refreshes the access tokens.
+// The given httpClient is used to request the tokens from the token endpoint.
+func (o *OAuthConfig) tokenSource(baseURL string, httpClient *http.Client) (oauth2.TokenSource, error) {
+	if o.ClientID == "" {
+		return nil, errors.New("the `client_id` is required for the OAuth authentication")
+	}
+
+	tokenURL := o.TokenURL
+	if tokenURL == "" {
+		var err error
+		if tokenURL, err = defaultOAuthTokenURL(baseURL); err != nil {
+			return nil, err
+		}
+	}
+
+	// NOTE: this context is used for all future token requests, therefore it must not be
+	//       the context of the provider configuration, which is cancelled once the provider is configured.
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+
+	if o.RefreshToken == "" && o.Username == "" && o.Password == "" {
+		config := &clientcredentials.Config{
+			ClientID:     o.ClientID,
+			ClientSecret: o.ClientSecret,
+			TokenURL:     tokenURL,
+			Scopes:       o.Scopes,
+		}
+		return config.TokenSource(ctx), nil
+	}
+
+	config := &oauth2.Config{
+		ClientID:     o.ClientID,
+		ClientSecret: o.ClientSecret,
+		Endpoint:     oauth2.Endpoint{TokenURL: tokenURL},
+		Scopes:       o.Scopes,
+	}
+
+	if o.RefreshToken != "" {
+		// The token is expired, therefore a new access token is requested with the refresh token on first use.
+		return config.TokenSource(ctx, &oauth2.Token{RefreshToken: o.RefreshToken}), nil
+	}
+
+	if o.Username == "" || o.Password == "" {
+		return nil, errors.New("both `username` and `password` are required for the OAuth password grant")
+	}
+	token, err := config.PasswordCredentialsToken(ctx, o.Username, o.Password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain OAuth access token with the password grant: %w", err)
+	}
+	return config.TokenSource(ctx, token), nil
+}
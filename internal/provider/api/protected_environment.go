@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// The approval rules of protected environments and the update of protected environments aren't supported by go-gitlab yet.
+// The types below extend the ones of go-gitlab with them, as well as with the IDs of the deploy access levels,
+// which are required to remove them in an update.
+
+// ProtectedEnvironment represents a protected environment including its approval rules.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_environments.html
+type ProtectedEnvironment struct {
+	Name                  string                          `json:"name"`
+	DeployAccessLevels    []*EnvironmentAccessDescription `json:"deploy_access_levels"`
+	RequiredApprovalCount int                             `json:"required_approval_count"`
+	ApprovalRules         []*EnvironmentApprovalRule      `json:"approval_rules"`
+}
+
+// EnvironmentAccessDescription represents a deploy access level of a protected environment.
+type EnvironmentAccessDescription struct {
+	ID                     int                     `json:"id"`
+	AccessLevel            gitlab.AccessLevelValue `json:"access_level"`
+	AccessLevelDescription string                  `json:"access_level_description"`
+	UserID                 int                     `json:"user_id"`
+	GroupID                int                     `json:"group_id"`
+}
+
+// EnvironmentApprovalRule represents an approval rule of a protected environment.
+type EnvironmentApprovalRule struct {
+	ID                     int                     `json:"id"`
+	AccessLevel            gitlab.AccessLevelValue `json:"access_level"`
+	AccessLevelDescription string                  `json:"access_level_description"`
+	UserID                 int                     `json:"user_id"`
+	GroupID                int                     `json:"group_id"`
+	RequiredApprovals      int                     `json:"required_approvals"`
+}
+
+// ProtectEnvironmentOptions represents the options to protect an environment or to update a protected environment.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_environments.html#protect-a-single-environment
+type ProtectEnvironmentOptions struct {
+	Name                  *string                            `json:"name,omitempty"`
+	DeployAccessLevels    *[]*EnvironmentAccessOptions       `json:"deploy_access_levels,omitempty"`
+	RequiredApprovalCount *int                               `json:"required_approval_count,omitempty"`
+	ApprovalRules         *[]*EnvironmentApprovalRuleOptions `json:"approval_rules,omitempty"`
+}
+
+// EnvironmentAccessOptions represents a deploy access level of a protected environment.
+// In an update, an existing deploy access level is identified by its ID and removed with Destroy.
+type EnvironmentAccessOptions struct {
+	ID          *int                     `json:"id,omitempty"`
+	AccessLevel *gitlab.AccessLevelValue `json:"access_level,omitempty"`
+	UserID      *int                     `json:"user_id,omitempty"`
+	GroupID     *int                     `json:"group_id,omitempty"`
+	Destroy     *bool                    `json:"_destroy,omitempty"`
+}
+
+// EnvironmentApprovalRuleOptions represents an approval rule of a protected environment.
+// In an update, an existing approval rule is identified by its ID and removed with Destroy.
+type EnvironmentApprovalRuleOptions struct {
+	ID                *int                     `json:"id,omitempty"`
+	AccessLevel       *gitlab.AccessLevelValue `json:"access_level,omitempty"`
+	UserID            *int                     `json:"user_id,omitempty"`
+	GroupID           *int                     `json:"group_id,omitempty"`
+	RequiredApprovals *int                     `json:"required_approvals,omitempty"`
+	Destroy           *bool                    `json:"_destroy,omitempty"`
+}
+
+func protectedEnvironmentsPath(project string) string {
+	return fmt.Sprintf("projects/%s/protected_environments", gitlab.PathEscape(project))
+}
+
+// GetProtectedEnvironment gets a protected environment of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_environments.html#get-a-single-protected-environment
+func GetProtectedEnvironment(ctx context.Context, client *gitlab.Client, project string, environment string) (*ProtectedEnvironment, *gitlab.Response, error) {
+	pe := new(ProtectedEnvironment)
+	resp, err := sendRequest(ctx, client, http.MethodGet, protectedEnvironmentsPath(project)+"/"+url.PathEscape(environment), nil, pe)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pe, resp, nil
+}
+
+// ProtectEnvironment protects an environment of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_environments.html#protect-a-single-environment
+func ProtectEnvironment(ctx context.Context, client *gitlab.Client, project string, opt *ProtectEnvironmentOptions) (*ProtectedEnvironment, *gitlab.Response, error) {
+	pe := new(ProtectedEnvironment)
+	resp, err := sendRequest(ctx, client, http.MethodPost, protectedEnvironmentsPath(project), opt, pe)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pe, resp, nil
+}
+
+// UpdateProtectedEnvironment updates a protected environment of a project, without unprotecting it in the meantime.
+// The deploy access levels and approval rules of the options are added to the existing ones,
+// unless they are identified by their ID, in which case they are updated or removed.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_environments.html#update-a-protected-environment
+func UpdateProtectedEnvironment(ctx context.Context, client *gitlab.Client, project string, environment string, opt *ProtectEnvironmentOptions) (*ProtectedEnvironment, *gitlab.Response, error) {
+	pe := new(ProtectedEnvironment)
+	resp, err := sendRequest(ctx, client, http.MethodPut, protectedEnvironmentsPath(project)+"/"+url.PathEscape(environment), opt, pe)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pe, resp, nil
+}
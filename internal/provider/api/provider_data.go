@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// ProviderData is passed by the configured provider to all resources and data sources,
+// as the `meta` of the SDK resources and as the provider data of the framework resources.
+type ProviderData struct {
+	Client *gitlab.Client
+	// Capabilities of the GitLab instance of the client, shared by all resources so that they are only fetched once.
+	Capabilities *Capabilities
+	// DriftReport is true if the provider is configured with `drift_report`.
+	DriftReport bool
+}
+
+// NewProviderData creates the GitLab client from the configuration and the data shared with the resources.
+func (c *Config) NewProviderData(ctx context.Context) (*ProviderData, error) {
+	client, err := c.NewGitLabClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderData{Client: client, Capabilities: NewCapabilities(client), DriftReport: c.DriftReport}, nil
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// Release extends the release of go-gitlab with its milestones and evidences, which aren't supported by go-gitlab yet.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/releases/
+type Release struct {
+	gitlab.Release
+	Milestones []*ReleaseMilestone `json:"milestones"`
+	Evidences  []*ReleaseEvidence  `json:"evidences"`
+}
+
+// ReleaseMilestone represents a milestone associated with a release.
+type ReleaseMilestone struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+// ReleaseEvidence represents the evidence collected for a release.
+type ReleaseEvidence struct {
+	SHA         string     `json:"sha"`
+	Filepath    string     `json:"filepath"`
+	CollectedAt *time.Time `json:"collected_at"`
+}
+
+// GetRelease gets the release of a tag in a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/releases/#get-a-release-by-a-tag-name
+func GetRelease(ctx context.Context, client *gitlab.Client, project string, tagName string) (*Release, *gitlab.Response, error) {
+	release := new(Release)
+	resp, err := sendRequest(ctx, client, http.MethodGet, fmt.Sprintf("projects/%s/releases/%s", gitlab.PathEscape(project), gitlab.PathEscape(tagName)), nil, release)
+	if err != nil {
+		return nil, resp, err
+	}
+	return release, resp, nil
+}
+
+// ListReleases lists all releases of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/releases/#list-releases
+func ListReleases(ctx context.Context, client *gitlab.Client, project string, opt *gitlab.ListReleasesOptions) ([]*Release, error) {
+	options := gitlab.ListReleasesOptions{}
+	if opt != nil {
+		options = *opt
+	}
+	options.PerPage = restPerPage
+	options.Page = 1
+
+	var releases []*Release
+	for options.Page != 0 {
+		var page []*Release
+		resp, err := sendRequest(ctx, client, http.MethodGet, fmt.Sprintf("projects/%s/releases", gitlab.PathEscape(project)), &options, &page)
+		if err != nil {
+			return nil, err
+		}
+		releases = append(releases, page...)
+		options.Page = resp.NextPage
+	}
+	return releases, nil
+}
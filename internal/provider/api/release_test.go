@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestGetRelease(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v4/projects/foo%2Fbar/releases/v1%2E0+rc1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"tag_name": "v1.0+rc1",
+			"name": "First release",
+			"assets": {"links": [{"id": 1, "name": "binary", "url": "https://example.com/binary"}]},
+			"milestones": [{"id": 2, "title": "v1.0"}],
+			"evidences": [{"sha": "abc", "filepath": "https://example.com/evidence.json", "collected_at": "2023-02-01T10:00:00Z"}]
+		}`)
+	})
+
+	release, _, err := GetRelease(context.Background(), client, "foo/bar", "v1.0+rc1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if release.TagName != "v1.0+rc1" || release.Name != "First release" || len(release.Assets.Links) != 1 {
+		t.Errorf("unexpected release %+v", release.Release)
+	}
+	if len(release.Milestones) != 1 || release.Milestones[0].Title != "v1.0" {
+		t.Errorf("unexpected milestones %+v", release.Milestones)
+	}
+	if len(release.Evidences) != 1 || release.Evidences[0].SHA != "abc" || release.Evidences[0].CollectedAt == nil {
+		t.Errorf("unexpected evidences %+v", release.Evidences)
+	}
+}
+
+func TestListReleases(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/projects/42/releases" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("order_by") != "created_at" {
+			t.Errorf("expected the options to be sent, got query %q", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"tag_name": "v2.0"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"tag_name": "v1.0", "milestones": [{"id": 2, "title": "v1.0"}]}]`)
+		}
+	})
+
+	orderBy := "created_at"
+	releases, err := ListReleases(context.Background(), client, "42", &gitlab.ListReleasesOptions{OrderBy: &orderBy})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(releases) != 2 || releases[1].TagName != "v1.0" || releases[1].Milestones[0].Title != "v1.0" {
+		t.Fatalf("expected the releases of both pages, got %+v", releases)
+	}
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// restPerPage is the page size used by PaginateRESTRequest, which is the maximum supported by GitLab.
+const restPerPage = 100
+
+// SendRESTRequest sends a request with a raw JSON body to the GitLab REST API and returns the raw response body.
+// The path is relative to the API base URL, e.g. `projects/42/hooks`, and may contain a query string.
+// The request is sent with the client, so that the authentication, TLS and retry configuration of the provider are used.
+func SendRESTRequest(ctx context.Context, client *gitlab.Client, method string, path string, body json.RawMessage) (json.RawMessage, *gitlab.Response, error) {
+	path, rawQuery, _ := strings.Cut(path, "?")
+
+	req, err := client.NewRequest(method, strings.TrimPrefix(path, "/"), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, nil, err
+	}
+	req.URL.RawQuery = rawQuery
+
+	if body != nil {
+		if err := req.SetBody([]byte(body)); err != nil {
+			return nil, nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	var response bytes.Buffer
+	resp, err := client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+	return response.Bytes(), resp, nil
+}
+
+// sendRequest sends a request with the options of go-gitlab and decodes the response into v, if given.
+// It's used for the endpoints and attributes which aren't supported by go-gitlab yet.
+// The options are encoded as query string for GET requests and as JSON body otherwise.
+func sendRequest(ctx context.Context, client *gitlab.Client, method string, path string, opt interface{}, v interface{}) (*gitlab.Response, error) {
+	// go-gitlab only encodes the options of POST and PUT requests as JSON body, the ones of PATCH requests as query string,
+	// which doesn't support nested options like the access levels of protected branches.
+	var body interface{}
+	if method == http.MethodPatch {
+		body, opt = opt, nil
+	}
+
+	req, err := client.NewRequest(method, path, opt, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		if err := req.SetBody(encoded); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return client.Do(req, v)
+}
+
+// PaginateRESTRequest sends a GET request to the GitLab REST API and follows the offset pagination of the response.
+// The items of all pages are returned as a single JSON array.
+// Responses which aren't a JSON array, e.g. of a single resource, are returned as-is.
+func PaginateRESTRequest(ctx context.Context, client *gitlab.Client, path string) (json.RawMessage, error) {
+	path, rawQuery, _ := strings.Cut(path, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query string %q: %w", rawQuery, err)
+	}
+	if query.Get("per_page") == "" {
+		query.Set("per_page", strconv.Itoa(restPerPage))
+	}
+
+	items := []json.RawMessage{}
+	for {
+		body, resp, err := SendRESTRequest(ctx, client, http.MethodGet, path+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []json.RawMessage
+		if err := json.Unmarshal(body, &page); err != nil {
+			if len(items) == 0 {
+				return body, nil
+			}
+			return nil, fmt.Errorf("page %s of %q is not a JSON array: %w", query.Get("page"), path, err)
+		}
+		items = append(items, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		query.Set("page", strconv.Itoa(resp.NextPage))
+	}
+
+	return json.Marshal(items)
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestSendRESTRequest(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			return
+		}
+		if r.URL.EscapedPath() != "/api/v4/projects/foo%2Fbar/hooks/1" || r.URL.RawQuery != "a=b" {
+			t.Errorf("got path %q and query %q", r.URL.EscapedPath(), r.URL.RawQuery)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"url":"https://example.com"}` {
+			t.Errorf("got body %q", string(body))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	response, _, err := SendRESTRequest(context.Background(), client, http.MethodPatch, "projects/foo%2Fbar/hooks/1?a=b", json.RawMessage(`{"url":"https://example.com"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(response) != `{"id": 1}` {
+		t.Fatalf("got response %q", string(response))
+	}
+}
+
+func TestPaginateRESTRequest(t *testing.T) {
+	client := newCapabilitiesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v4/projects":
+			if r.URL.Query().Get("per_page") != "100" || r.URL.Query().Get("search") != "foo" {
+				t.Errorf("got unexpected query %q", r.URL.RawQuery)
+			}
+			if r.URL.Query().Get("page") == "2" {
+				fmt.Fprint(w, `[{"id": 3}]`)
+				return
+			}
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+		case "/api/v4/projects/1":
+			fmt.Fprint(w, `{"id": 1}`)
+		}
+	})
+
+	response, err := PaginateRESTRequest(context.Background(), client, "projects?search=foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(response) != `[{"id":1},{"id":2},{"id":3}]` {
+		t.Fatalf("got response %s, expected the items of all pages", response)
+	}
+
+	response, err = PaginateRESTRequest(context.Background(), client, "projects/1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(response) != `{"id": 1}` {
+		t.Fatalf("got response %s, expected a single object to be returned as-is", response)
+	}
+}
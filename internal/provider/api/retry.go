@@ -0,0 +1,163 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/xanzy/go-gitlab"
+)
+
+const (
+	DefaultRetryMaxAttempts = 5
+	DefaultRetryMinBackoff  = 1 * time.Second
+	DefaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// RetryableStatusCodes are the HTTP status codes of responses for which a request is retried.
+var RetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryConfig configures how requests to the GitLab API are retried
+// in case of rate limiting or transient server errors.
+type RetryConfig struct {
+	MaxAttempts int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+}
+
+// NewRetryConfig creates a RetryConfig from the provider attribute values.
+// Zero values and empty strings are replaced with the defaults.
+func NewRetryConfig(maxAttempts int, minBackoff string, maxBackoff string) (*RetryConfig, error) {
+	config := &RetryConfig{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		MinBackoff:  DefaultRetryMinBackoff,
+		MaxBackoff:  DefaultRetryMaxBackoff,
+	}
+
+	if maxAttempts != 0 {
+		config.MaxAttempts = maxAttempts
+	}
+	if minBackoff != "" {
+		d, err := time.ParseDuration(minBackoff)
+		if err != nil {
+			return nil, fmt.Errorf("invalid `min_backoff` duration %q: %w", minBackoff, err)
+		}
+		config.MinBackoff = d
+	}
+	if maxBackoff != "" {
+		d, err := time.ParseDuration(maxBackoff)
+		if err != nil {
+			return nil, fmt.Errorf("invalid `max_backoff` duration %q: %w", maxBackoff, err)
+		}
+		config.MaxBackoff = d
+	}
+
+	if config.MaxAttempts < 1 {
+		return nil, fmt.Errorf("`max_attempts` must be at least 1, got %d", config.MaxAttempts)
+	}
+	if config.MinBackoff < 0 || config.MaxBackoff < 0 {
+		return nil, fmt.Errorf("`min_backoff` and `max_backoff` must not be negative")
+	}
+	if config.MinBackoff > config.MaxBackoff {
+		return nil, fmt.Errorf("`min_backoff` (%s) must not be greater than `max_backoff` (%s)", config.MinBackoff, config.MaxBackoff)
+	}
+
+	return config, nil
+}
+
+// clientOptions returns the go-gitlab client options to apply the retry configuration.
+func (r *RetryConfig) clientOptions() []gitlab.ClientOptionFunc {
+	return []gitlab.ClientOptionFunc{
+		// The retryablehttp client counts retries, not attempts.
+		gitlab.WithCustomRetryMax(r.MaxAttempts - 1),
+		gitlab.WithCustomRetryWaitMinMax(r.MinBackoff, r.MaxBackoff),
+		gitlab.WithCustomRetry(retryCheck),
+		gitlab.WithCustomBackoff(retryBackoff),
+	}
+}
+
+// retryCheck decides if a request should be retried.
+// Connection errors are handled the same way as the retryablehttp default policy does,
+// responses are only retried if they have one of the RetryableStatusCodes.
+func retryCheck(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	if err != nil {
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+
+	for _, code := range RetryableStatusCodes {
+		if resp.StatusCode == code {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// retryBackoff returns the time to wait before the next attempt.
+// If the server tells us how long to wait using the `Retry-After` or the `RateLimit-Reset` headers,
+// that time is honoured. Otherwise, an exponential backoff with jitter bounded by min and max is used.
+func retryBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if wait, ok := retryAfterFromResponse(resp); ok {
+		if wait < min {
+			return min
+		}
+		return wait
+	}
+
+	backoff := float64(min) * math.Pow(2, float64(attemptNum))
+	if backoff > float64(max) || math.IsInf(backoff, 1) {
+		backoff = float64(max)
+	}
+
+	// add up to 25% of jitter to prevent a thundering herd of retries
+	jitter := rand.Float64() * backoff * 0.25
+	wait := time.Duration(backoff - jitter)
+	if wait < min {
+		return min
+	}
+	return wait
+}
+
+// retryAfterFromResponse extracts the time to wait from the `Retry-After` or `RateLimit-Reset` headers.
+// `Retry-After` may be given in seconds or as HTTP date, `RateLimit-Reset` is a Unix timestamp.
+func retryAfterFromResponse(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.ParseInt(v, 10, 64); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(v); err == nil {
+			return positiveDuration(time.Until(date)), true
+		}
+	}
+
+	if v := resp.Header.Get("RateLimit-Reset"); v != "" {
+		if reset, err := strconv.ParseInt(v, 10, 64); err == nil && reset > 0 {
+			return positiveDuration(time.Until(time.Unix(reset, 0))), true
+		}
+	}
+
+	return 0, false
+}
+
+func positiveDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
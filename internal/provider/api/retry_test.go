@@ -0,0 +1,275 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil/fakegitlab"
+)
+
+func TestNewRetryConfig(t *testing.T) {
+	config, err := NewRetryConfig(0, "", "")
+	if err != nil {
+		t.Fatalf("expected defaults to be valid, got: %v", err)
+	}
+	if config.MaxAttempts != DefaultRetryMaxAttempts || config.MinBackoff != DefaultRetryMinBackoff || config.MaxBackoff != DefaultRetryMaxBackoff {
+		t.Fatalf("expected default retry config, got %+v", config)
+	}
+
+	config, err = NewRetryConfig(3, "500ms", "10s")
+	if err != nil {
+		t.Fatalf("expected valid retry config, got: %v", err)
+	}
+	if config.MaxAttempts != 3 || config.MinBackoff != 500*time.Millisecond || config.MaxBackoff != 10*time.Second {
+		t.Fatalf("unexpected retry config %+v", config)
+	}
+
+	invalidCases := []struct {
+		MaxAttempts int
+		MinBackoff  string
+		MaxBackoff  string
+	}{
+		{MaxAttempts: -1},
+		{MinBackoff: "one second"},
+		{MaxBackoff: "10"},
+		{MinBackoff: "1m", MaxBackoff: "1s"},
+	}
+	for _, tc := range invalidCases {
+		if _, err := NewRetryConfig(tc.MaxAttempts, tc.MinBackoff, tc.MaxBackoff); err == nil {
+			t.Fatalf("expected invalid retry config for %+v", tc)
+		}
+	}
+}
+
+func TestRetryCheck(t *testing.T) {
+	cases := []struct {
+		StatusCode int
+		Retry      bool
+	}{
+		{StatusCode: http.StatusOK, Retry: false},
+		{StatusCode: http.StatusBadRequest, Retry: false},
+		{StatusCode: http.StatusNotFound, Retry: false},
+		{StatusCode: http.StatusInternalServerError, Retry: false},
+		{StatusCode: http.StatusTooManyRequests, Retry: true},
+		{StatusCode: http.StatusBadGateway, Retry: true},
+		{StatusCode: http.StatusServiceUnavailable, Retry: true},
+		{StatusCode: http.StatusGatewayTimeout, Retry: true},
+	}
+
+	for _, tc := range cases {
+		retry, err := retryCheck(context.Background(), &http.Response{StatusCode: tc.StatusCode}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error for status code %d: %v", tc.StatusCode, err)
+		}
+		if retry != tc.Retry {
+			t.Fatalf("got retry %v for status code %d, expected %v", retry, tc.StatusCode, tc.Retry)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if retry, _ := retryCheck(ctx, &http.Response{StatusCode: http.StatusTooManyRequests}, nil); retry {
+		t.Fatalf("expected no retry with cancelled context")
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	min, max := 1*time.Second, 8*time.Second
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := retryBackoff(min, max, attempt, &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}})
+		if wait < min || wait > max {
+			t.Fatalf("expected backoff for attempt %d to be between %s and %s, got %s", attempt, min, max, wait)
+		}
+	}
+
+	retryAfter := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	retryAfter.Header.Set("Retry-After", "42")
+	if wait := retryBackoff(min, max, 0, retryAfter); wait != 42*time.Second {
+		t.Fatalf("expected Retry-After header to be honoured, got %s", wait)
+	}
+
+	rateLimitReset := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	rateLimitReset.Header.Set("RateLimit-Reset", strconv.FormatInt(time.Now().Add(20*time.Second).Unix(), 10))
+	if wait := retryBackoff(min, max, 0, rateLimitReset); wait < 18*time.Second || wait > 20*time.Second {
+		t.Fatalf("expected RateLimit-Reset header to be honoured, got %s", wait)
+	}
+
+	rateLimitResetInThePast := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	rateLimitResetInThePast.Header.Set("RateLimit-Reset", strconv.FormatInt(time.Now().Add(-20*time.Second).Unix(), 10))
+	if wait := retryBackoff(min, max, 0, rateLimitResetInThePast); wait != min {
+		t.Fatalf("expected minimum backoff for a reset time in the past, got %s", wait)
+	}
+}
+
+// TestRetry_fakeGitLab covers the retries and the errors surfaced to the resources for the endpoint families of the resources.
+func TestRetry_fakeGitLab(t *testing.T) {
+	server := fakegitlab.NewServer()
+	defer server.Close()
+
+	config := Config{
+		BaseURL: server.URL(),
+		Token:   fakegitlab.Token,
+		Retry:   &RetryConfig{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	}
+	client, err := config.NewGitLabClient(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	project, _, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.String("foo")})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	deletedProject, _, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.String("deleted")})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+
+	cases := []struct {
+		name    string
+		method  string
+		path    string
+		request func() (*gitlab.Response, error)
+	}{
+		{
+			name: "create project", method: http.MethodPost, path: "projects",
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.String("bar")})
+				return resp, err
+			},
+		},
+		{
+			name: "update project", method: http.MethodPut, path: fmt.Sprintf("projects/%d", project.ID),
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.Projects.EditProject(project.ID, &gitlab.EditProjectOptions{Description: gitlab.String("updated")})
+				return resp, err
+			},
+		},
+		{
+			name: "delete project", method: http.MethodDelete, path: fmt.Sprintf("projects/%d", deletedProject.ID),
+			request: func() (*gitlab.Response, error) {
+				return client.Projects.DeleteProject(deletedProject.ID)
+			},
+		},
+		{
+			name: "create group", method: http.MethodPost, path: "groups",
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.Groups.CreateGroup(&gitlab.CreateGroupOptions{Name: gitlab.String("group"), Path: gitlab.String("group")})
+				return resp, err
+			},
+		},
+		{
+			name: "add project member", method: http.MethodPost, path: fmt.Sprintf("projects/%d/members", project.ID),
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.ProjectMembers.AddProjectMember(project.ID, &gitlab.AddProjectMemberOptions{UserID: 42, AccessLevel: gitlab.AccessLevel(gitlab.DeveloperPermissions)})
+				return resp, err
+			},
+		},
+		{
+			name: "create project variable", method: http.MethodPost, path: fmt.Sprintf("projects/%d/variables", project.ID),
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.ProjectVariables.CreateVariable(project.ID, &gitlab.CreateProjectVariableOptions{Key: gitlab.String("FOO"), Value: gitlab.String("bar")})
+				return resp, err
+			},
+		},
+		{
+			name: "create branch", method: http.MethodPost, path: fmt.Sprintf("projects/%d/repository/branches", project.ID),
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.Branches.CreateBranch(project.ID, &gitlab.CreateBranchOptions{Branch: gitlab.String("feature"), Ref: gitlab.String("main")})
+				return resp, err
+			},
+		},
+		{
+			name: "protect branch", method: http.MethodPost, path: fmt.Sprintf("projects/%d/protected_branches", project.ID),
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.ProtectedBranches.ProtectRepositoryBranches(project.ID, &gitlab.ProtectRepositoryBranchesOptions{Name: gitlab.String("main")})
+				return resp, err
+			},
+		},
+		{
+			name: "add project hook", method: http.MethodPost, path: fmt.Sprintf("projects/%d/hooks", project.ID),
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.Projects.AddProjectHook(project.ID, &gitlab.AddProjectHookOptions{URL: gitlab.String("https://example.com")})
+				return resp, err
+			},
+		},
+		{
+			name: "create label", method: http.MethodPost, path: fmt.Sprintf("projects/%d/labels", project.ID),
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.Labels.CreateLabel(project.ID, &gitlab.CreateLabelOptions{Name: gitlab.String("bug"), Color: gitlab.String("#FF0000")})
+				return resp, err
+			},
+		},
+		{
+			name: "add project badge", method: http.MethodPost, path: fmt.Sprintf("projects/%d/badges", project.ID),
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.ProjectBadges.AddProjectBadge(project.ID, &gitlab.AddProjectBadgeOptions{LinkURL: gitlab.String("https://example.com"), ImageURL: gitlab.String("https://example.com/badge.svg")})
+				return resp, err
+			},
+		},
+		{
+			name: "create repository file", method: http.MethodPost, path: fmt.Sprintf("projects/%d/repository/files", project.ID),
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.RepositoryFiles.CreateFile(project.ID, "README.md", &gitlab.CreateFileOptions{Branch: gitlab.String("main"), Content: gitlab.String("hello"), CommitMessage: gitlab.String("add readme")})
+				return resp, err
+			},
+		},
+		{
+			name: "update application settings", method: http.MethodPut, path: "application/settings",
+			request: func() (*gitlab.Response, error) {
+				_, resp, err := client.Settings.UpdateSettings(&gitlab.UpdateSettingsOptions{AfterSignUpText: gitlab.String("Welcome")})
+				return resp, err
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Client errors aren't retried and are returned with their status code.
+			server.InjectError(tc.method, tc.path, http.StatusForbidden, 1)
+			requests := len(server.Requests())
+			if resp, err := tc.request(); err == nil || resp == nil || resp.StatusCode != http.StatusForbidden {
+				t.Fatalf("expected a 403 error, got: %v", err)
+			}
+			if len(server.Requests()) != requests+1 {
+				t.Fatalf("expected a 403 error to not be retried, got the requests %v", server.Requests()[requests:])
+			}
+
+			// Transient errors are returned once the attempts are exhausted.
+			server.InjectError(tc.method, tc.path, http.StatusServiceUnavailable, 3)
+			requests = len(server.Requests())
+			if resp, err := tc.request(); err == nil || resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+				t.Fatalf("expected a 503 error after three attempts, got: %v", err)
+			}
+			if len(server.Requests()) != requests+3 {
+				t.Fatalf("expected three attempts, got the requests %v", server.Requests()[requests:])
+			}
+
+			// Transient errors are retried until the request succeeds.
+			server.InjectError(tc.method, tc.path, http.StatusBadGateway, 1)
+			server.InjectError(tc.method, tc.path, http.StatusServiceUnavailable, 1)
+			if _, err := tc.request(); err != nil {
+				t.Fatalf("expected the request to succeed after two retries, got: %v", err)
+			}
+			if pending := server.PendingErrors(); pending != 0 {
+				t.Fatalf("expected all injected errors to be returned, got %d pending errors", pending)
+			}
+		})
+	}
+
+	// Other errors aren't retried.
+	requests := len(server.Requests())
+	if _, _, err := client.Projects.GetProject(deletedProject.ID, nil); !Is404(err) {
+		t.Fatalf("expected a 404 error, got: %v", err)
+	}
+	if len(server.Requests()) != requests+1 {
+		t.Fatalf("expected a 404 error to not be retried")
+	}
+}
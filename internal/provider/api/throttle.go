@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+var (
+	throttlesMu sync.Mutex
+	// throttles holds the throttles per GitLab instance, so that all clients
+	// created for the same instance, like the ones of the SDK and the framework provider,
+	// share the same limits.
+	throttles = make(map[string]*Throttle)
+)
+
+// Throttle limits the rate and the number of concurrent requests to a GitLab instance.
+// Waiting for a throttle is `context.Context` aware, e.g. it'll respect cancelling and timeouts.
+type Throttle struct {
+	limiter  *rate.Limiter
+	inFlight chan struct{}
+}
+
+// NewThrottle creates a new throttle allowing requestsPerSecond with a burst of
+// the next integer value of it and at most maxConcurrentRequests requests in-flight.
+// A value of zero disables the corresponding limit.
+func NewThrottle(requestsPerSecond float64, maxConcurrentRequests int) *Throttle {
+	t := &Throttle{}
+	if requestsPerSecond > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), int(math.Ceil(requestsPerSecond)))
+	}
+	if maxConcurrentRequests > 0 {
+		t.inFlight = make(chan struct{}, maxConcurrentRequests)
+	}
+	return t
+}
+
+// throttleForInstance returns the throttle shared by all clients with the same limits for the given GitLab instance.
+func throttleForInstance(baseURL string, requestsPerSecond float64, maxConcurrentRequests int) *Throttle {
+	throttlesMu.Lock()
+	defer throttlesMu.Unlock()
+
+	key := fmt.Sprintf("%s|%g|%d", baseURL, requestsPerSecond, maxConcurrentRequests)
+	if t, ok := throttles[key]; ok {
+		return t
+	}
+	t := NewThrottle(requestsPerSecond, maxConcurrentRequests)
+	throttles[key] = t
+	return t
+}
+
+// acquire blocks until a request is allowed to be sent.
+// Every successful acquire must be followed by a release.
+func (t *Throttle) acquire(ctx context.Context) error {
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if t.inFlight != nil {
+		select {
+		case t.inFlight <- struct{}{}:
+			// slot acquired
+		case <-ctx.Done():
+			// Timeout
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (t *Throttle) release() {
+	if t.inFlight != nil {
+		<-t.inFlight
+	}
+}
+
+// throttledTransport is an http.RoundTripper which waits for the throttle before sending each request.
+type throttledTransport struct {
+	throttle *Throttle
+	next     http.RoundTripper
+}
+
+func (t *throttledTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.throttle.acquire(req.Context()); err != nil {
+		return nil, err
+	}
+	defer t.throttle.release()
+
+	return t.next.RoundTrip(req)
+}
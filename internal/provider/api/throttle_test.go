@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestThrottle_maxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &throttledTransport{throttle: NewThrottle(0, 2), next: http.DefaultTransport},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestThrottle_requestsPerSecond(t *testing.T) {
+	throttle := NewThrottle(10, 0)
+
+	start := time.Now()
+	for i := 0; i < 20; i++ {
+		if err := throttle.acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		throttle.release()
+	}
+
+	// the first 10 requests are allowed as burst, the other 10 are spread over one second.
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("expected requests to be throttled to 10 per second, 20 requests took %s", elapsed)
+	}
+}
+
+func TestThrottle_contextCancelled(t *testing.T) {
+	throttle := NewThrottle(0, 1)
+	if err := throttle.acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer throttle.release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := throttle.acquire(ctx); err == nil {
+		t.Fatalf("expected acquire to fail with a cancelled context")
+	}
+}
+
+func TestThrottle_sharedPerInstance(t *testing.T) {
+	a := throttleForInstance("https://gitlab.example.com/api/v4/", 5, 10)
+	b := throttleForInstance("https://gitlab.example.com/api/v4/", 5, 10)
+	c := throttleForInstance("https://other.example.com/api/v4/", 5, 10)
+
+	if a != b {
+		t.Fatalf("expected clients of the same instance to share a throttle")
+	}
+	if a == c {
+		t.Fatalf("expected clients of different instances to not share a throttle")
+	}
+}
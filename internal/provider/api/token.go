@@ -0,0 +1,162 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// reloadableToken is a token which is read from the output of a command or from a file.
+// It's re-read when the GitLab API rejects it, which allows rotating the token without
+// restarting Terraform.
+type reloadableToken struct {
+	command string
+	file    string
+
+	mu    sync.RWMutex
+	token string
+	// all tokens which have been resolved so far, they are all masked in the logs.
+	resolved []string
+}
+
+func newReloadableToken(command, file string) (*reloadableToken, error) {
+	t := &reloadableToken{command: command, file: file}
+	if _, err := t.reload(""); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// current returns the currently valid token.
+func (t *reloadableToken) current() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.token
+}
+
+// maskInContext masks all tokens which have been resolved so far in the logs of the given context.
+func (t *reloadableToken) maskInContext(ctx context.Context) context.Context {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return utils.ApplyTokenLogMaskingToContext(ctx, t.resolved...)
+}
+
+// reload re-reads the token, unless it has already been reloaded since the given stale token was read.
+// It returns the new token.
+func (t *reloadableToken) reload(stale string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != stale {
+		return t.token, nil
+	}
+
+	token, err := t.read()
+	if err != nil {
+		return "", err
+	}
+	if token == "" {
+		return "", errors.New("the resolved GitLab token is empty")
+	}
+
+	t.token = token
+	for _, r := range t.resolved {
+		if r == token {
+			return token, nil
+		}
+	}
+	t.resolved = append(t.resolved, token)
+	return token, nil
+}
+
+// read reads the token from the command output or the file.
+// Leading and trailing whitespace, like the final newline, is removed.
+func (t *reloadableToken) read() (string, error) {
+	if t.file != "" {
+		content, err := os.ReadFile(t.file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read GitLab token from file %q: %w", t.file, err)
+		}
+		return strings.TrimSpace(string(content)), nil
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", t.command)
+	} else {
+		cmd = exec.Command("sh", "-c", t.command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		// NOTE: the stdout is not part of the error, because it may contain the token.
+		return "", fmt.Errorf("failed to read GitLab token from command %q: %w: %s", t.command, err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// reloadableTokenTransport authenticates the requests with a reloadable token.
+// If a request is rejected with a 401 the token is reloaded and the request is retried once with the new token.
+type reloadableTokenTransport struct {
+	token *reloadableToken
+	next  http.RoundTripper
+}
+
+func (t *reloadableTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Buffer the request body so that it can be sent again when the request is retried.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	token := t.token.current()
+	resp, err := t.next.RoundTrip(withBearerToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	newToken, err := t.token.reload(token)
+	if err != nil || newToken == token {
+		// NOTE: a failure to reload the token must not hide the original 401 response.
+		return resp, nil
+	}
+
+	retry := withBearerToken(req, newToken)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	return t.next.RoundTrip(retry)
+}
+
+// withBearerToken returns a copy of the request which is authenticated with the given token.
+func withBearerToken(req *http.Request, token string) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
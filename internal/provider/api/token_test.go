@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestReloadableToken_file(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("first\n"), 0600); err != nil {
+		t.Fatalf("failed to write token file: %v", err)
+	}
+
+	var validToken atomic.Value
+	validToken.Store("first")
+	var requestCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requestCount, 1)
+		if r.Header.Get("Authorization") != "Bearer "+validToken.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message": "401 Unauthorized"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1, "username": "root"}`)
+	}))
+	defer server.Close()
+
+	config := Config{
+		BaseURL:       server.URL + "/api/v4/",
+		TokenFile:     tokenFile,
+		EarlyAuthFail: true,
+	}
+	client, err := config.NewGitLabClient(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// rotate the token, the next request is rejected and retried with the new token.
+	atomic.StoreInt32(&requestCount, 0)
+	validToken.Store("second")
+	if err := os.WriteFile(tokenFile, []byte("second\n"), 0600); err != nil {
+		t.Fatalf("failed to write token file: %v", err)
+	}
+	if _, _, err := client.Users.CurrentUser(); err != nil {
+		t.Fatalf("expected request to succeed with the rotated token, got: %v", err)
+	}
+	if requestCount != 2 {
+		t.Fatalf("expected 2 requests, got %d", requestCount)
+	}
+
+	// an invalid token is not retried more than once.
+	validToken.Store("third")
+	if _, _, err := client.Users.CurrentUser(); err == nil {
+		t.Fatalf("expected request to fail with an invalid token")
+	}
+	if requestCount != 3 {
+		t.Fatalf("expected 3 requests, got %d", requestCount)
+	}
+}
+
+func TestReloadableToken_command(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command requires a POSIX shell")
+	}
+
+	token, err := newReloadableToken("echo '  secret-token  '", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.current() != "secret-token" {
+		t.Fatalf("got token %q, expected %q", token.current(), "secret-token")
+	}
+
+	_, err = newReloadableToken("echo $((40 + 2)); echo oops >&2; exit 1", "")
+	if err == nil {
+		t.Fatalf("expected an error for a failing command")
+	}
+	if strings.Contains(err.Error(), "42") || !strings.Contains(err.Error(), "oops") {
+		t.Fatalf("expected the error to contain the stderr but not the stdout of the command, got: %v", err)
+	}
+
+	if _, err = newReloadableToken("true", ""); err == nil {
+		t.Fatalf("expected an error for an empty token")
+	}
+}
+
+func TestReloadableToken_retryWithBody(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("first"), 0600); err != nil {
+		t.Fatalf("failed to write token file: %v", err)
+	}
+
+	var validToken atomic.Value
+	validToken.Store("first")
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read request body: %v", err)
+		}
+		if r.Method == http.MethodPost {
+			bodies = append(bodies, string(body))
+		}
+		if r.Header.Get("Authorization") != "Bearer "+validToken.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1, "name": "foo"}`)
+	}))
+	defer server.Close()
+
+	config := Config{BaseURL: server.URL + "/api/v4/", TokenFile: tokenFile, EarlyAuthFail: true}
+	client, err := config.NewGitLabClient(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	validToken.Store("second")
+	if err := os.WriteFile(tokenFile, []byte("second"), 0600); err != nil {
+		t.Fatalf("failed to write token file: %v", err)
+	}
+	if _, _, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.String("foo")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] || bodies[1] == "" {
+		t.Fatalf("expected the request body to be sent again, got %q", bodies)
+	}
+}
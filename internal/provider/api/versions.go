@@ -20,28 +20,10 @@ func IsGitLabVersionLessThan(ctx context.Context, client *gitlab.Client, version
 
 // IsGitLabVersionAtLeast is a SkipFunc that checks that the version of GitLab is at least the
 // provided wantVersion. It only checks the major and minor version numbers, not the patch.
+// The version isn't cached, resources use the Capabilities of the ProviderData instead.
 func IsGitLabVersionAtLeast(ctx context.Context, client *gitlab.Client, wantVersion string) func() (bool, error) {
 	return func() (bool, error) {
-		wantMajor, wantMinor, err := parseVersionMajorMinor(wantVersion)
-		if err != nil {
-			return false, fmt.Errorf("failed to parse wanted version %q: %w", wantVersion, err)
-		}
-
-		actualVersion, _, err := client.Version.GetVersion(gitlab.WithContext(ctx))
-		if err != nil {
-			return false, err
-		}
-
-		actualMajor, actualMinor, err := parseVersionMajorMinor(actualVersion.Version)
-		if err != nil {
-			return false, fmt.Errorf("failed to parse actual version %q: %w", actualVersion.Version, err)
-		}
-
-		if actualMajor == wantMajor {
-			return actualMinor >= wantMinor, nil
-		}
-
-		return actualMajor > wantMajor, nil
+		return NewCapabilities(client).IsVersionAtLeast(ctx, wantVersion)
 	}
 }
 
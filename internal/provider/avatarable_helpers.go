@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// avatarableAttributes returns the attributes required to support Avatars for GitLab resources.
+// They are the same as the ones of `avatarableSchema` in the SDK, so that the state is compatible.
+func avatarableAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"avatar": schema.StringAttribute{
+			MarkdownDescription: "A local path to the avatar image to upload. **Note**: not available for imported resources.",
+			Optional:            true,
+		},
+		"avatar_hash": schema.StringAttribute{
+			MarkdownDescription: "The hash of the avatar image. Use `filesha256(\"path/to/avatar.png\")` whenever possible. **Note**: this is used to trigger an update of the avatar. If it's not given, but an avatar is given, the avatar will be updated each time.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("avatar"))},
+		},
+		"avatar_url": schema.StringAttribute{
+			MarkdownDescription: "The URL of the avatar image.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+	}
+}
+
+// modifyAvatarablePlan must be used in the ModifyPlan of a resource with the `avatarableAttributes`.
+// Without a hash, changes of the avatar image can't be detected, thus the avatar is uploaded on every apply.
+func modifyAvatarablePlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var avatar, configAvatarHash, planAvatarHash types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("avatar"), &avatar)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("avatar_hash"), &configAvatarHash)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("avatar_hash"), &planAvatarHash)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if configAvatarHash.IsNull() {
+		if avatar.IsUnknown() || avatar.ValueString() != "" {
+			planAvatarHash = types.StringUnknown()
+		} else {
+			planAvatarHash = types.StringValue("")
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("avatar_hash"), planAvatarHash)...)
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var stateAvatar, stateAvatarHash types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("avatar"), &stateAvatar)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("avatar_hash"), &stateAvatarHash)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !avatar.Equal(stateAvatar) || !planAvatarHash.Equal(stateAvatarHash) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("avatar_url"), types.StringUnknown())...)
+	}
+}
+
+// localAvatar is an avatar image read from a local file.
+type localAvatar struct {
+	Filename string
+	Image    io.Reader
+}
+
+// avatarToUpload returns the avatar to upload, if the planned avatar or its hash differs from the state.
+// The state values are null when the resource is created.
+// An empty avatar is returned if the avatar must be removed, and nil if it's unchanged.
+func avatarToUpload(planAvatar, planAvatarHash, stateAvatar, stateAvatarHash types.String) (*localAvatar, error) {
+	if planAvatar.Equal(stateAvatar) && planAvatarHash.Equal(stateAvatarHash) && !planAvatarHash.IsUnknown() {
+		return nil, nil
+	}
+
+	avatarPath := planAvatar.ValueString()
+	if avatarPath == "" {
+		if stateAvatar.ValueString() == "" {
+			return nil, nil
+		}
+		return &localAvatar{}, nil
+	}
+
+	avatarFile, err := os.Open(avatarPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open avatar file %s: %s", avatarPath, err)
+	}
+	return &localAvatar{Filename: avatarPath, Image: avatarFile}, nil
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &gitlabAPIRequestDataSource{}
+	_ datasource.DataSourceWithConfigure = &gitlabAPIRequestDataSource{}
+)
+
+func init() {
+	registerDataSource(NewGitLabAPIRequestDataSource)
+}
+
+// NewGitLabAPIRequestDataSource is a helper function to simplify the provider implementation.
+func NewGitLabAPIRequestDataSource() datasource.DataSource {
+	return &gitlabAPIRequestDataSource{}
+}
+
+// gitlabAPIRequestDataSource is the data source implementation.
+type gitlabAPIRequestDataSource struct {
+	client *gitlab.Client
+}
+
+// gitlabAPIRequestDataSourceModel describes the data source data model.
+type gitlabAPIRequestDataSourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Path     types.String `tfsdk:"path"`
+	Paginate types.Bool   `tfsdk:"paginate"`
+	Response types.String `tfsdk:"response"`
+}
+
+// Metadata returns the data source type name.
+func (d *gitlabAPIRequestDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_request"
+}
+
+// Schema defines the schema for the data source.
+func (d *gitlabAPIRequestDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_api_request`" + ` data source allows to send a GET request to an arbitrary endpoint of the GitLab REST API.
+It can be used to retrieve data which isn't available via a dedicated data source yet.
+The request is sent with the authentication, TLS and retry configuration of the provider.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/rest/)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this data source. It is the requested path.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path relative to the API base URL, e.g. `projects/42/hooks`. It may contain a query string.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"paginate": schema.BoolAttribute{
+				MarkdownDescription: "Follow the pagination of the response and return the items of all pages as a single JSON array. Defaults to `true`.",
+				Optional:            true,
+			},
+			"response": schema.StringAttribute{
+				MarkdownDescription: "The JSON-encoded response. Use `jsondecode` to access it.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *gitlabAPIRequestDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*api.ProviderData).Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *gitlabAPIRequestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state gitlabAPIRequestDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestPath := state.Path.ValueString()
+	paginate := state.Paginate.IsNull() || state.Paginate.ValueBool()
+
+	tflog.Debug(ctx, "sending GitLab API request", map[string]interface{}{"path": requestPath, "paginate": paginate})
+	var response json.RawMessage
+	var err error
+	if paginate {
+		response, err = api.PaginateRESTRequest(ctx, d.client, requestPath)
+	} else {
+		response, _, err = api.SendRESTRequest(ctx, d.client, http.MethodGet, requestPath, nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to GET %s: %s", requestPath, err.Error()))
+		return
+	}
+
+	state.Id = types.StringValue(requestPath)
+	state.Response = types.StringValue(string(response))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
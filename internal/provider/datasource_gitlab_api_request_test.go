@@ -0,0 +1,58 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAcc_GitLabAPIRequest_DataSource_Basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+	for i := 0; i < 3; i++ {
+		if _, _, err := testutil.TestGitlabClient.Projects.AddProjectHook(project.ID, &gitlab.AddProjectHookOptions{
+			URL: gitlab.String(fmt.Sprintf("https://example.com/hook-%d", i)),
+		}); err != nil {
+			t.Fatalf("failed to create project hook: %v", err)
+		}
+	}
+
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read all pages
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_api_request" "test" {
+						path = "projects/%d/hooks?per_page=2"
+					}
+
+					output "hook_count" {
+						value = length(jsondecode(data.gitlab_api_request.test.response))
+					}
+				`, project.ID),
+				Check: resource.TestCheckOutput("hook_count", "3"),
+			},
+			// Read a single page
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_api_request" "test" {
+						path     = "projects/%d/hooks?per_page=2"
+						paginate = false
+					}
+
+					output "hook_count" {
+						value = length(jsondecode(data.gitlab_api_request.test.response))
+					}
+				`, project.ID),
+				Check: resource.TestCheckOutput("hook_count", "2"),
+			},
+		},
+	})
+}
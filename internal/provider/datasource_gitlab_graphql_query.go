@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jmespath/go-jmespath"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &gitlabGraphQLQueryDataSource{}
+	_ datasource.DataSourceWithConfigure = &gitlabGraphQLQueryDataSource{}
+)
+
+func init() {
+	registerDataSource(NewGitLabGraphQLQueryDataSource)
+}
+
+// NewGitLabGraphQLQueryDataSource is a helper function to simplify the provider implementation.
+func NewGitLabGraphQLQueryDataSource() datasource.DataSource {
+	return &gitlabGraphQLQueryDataSource{}
+}
+
+// gitlabGraphQLQueryDataSource is the data source implementation.
+type gitlabGraphQLQueryDataSource struct {
+	client *gitlab.Client
+}
+
+// gitlabGraphQLQueryDataSourceModel describes the data source data model.
+type gitlabGraphQLQueryDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Query          types.String `tfsdk:"query"`
+	OperationName  types.String `tfsdk:"operation_name"`
+	Variables      types.String `tfsdk:"variables"`
+	Extract        types.String `tfsdk:"extract"`
+	Result         types.String `tfsdk:"result"`
+	ExtractedValue types.String `tfsdk:"extracted_value"`
+}
+
+// Metadata returns the data source type name.
+func (d *gitlabGraphQLQueryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graphql_query"
+}
+
+// Schema defines the schema for the data source.
+func (d *gitlabGraphQLQueryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_graphql_query`" + ` data source allows to run an arbitrary query against the GitLab GraphQL API.
+It can be used to retrieve data which isn't available via a dedicated data source yet, e.g. compliance frameworks or work items.
+
+-> The query is sent with the authentication and TLS configuration of the provider. Mutations are not prevented,
+   but they are executed on every refresh and should therefore not be used.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this data source. It is a hash of the query and the variables.",
+				Computed:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The GraphQL query document.",
+				Required:            true,
+			},
+			"operation_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the operation to execute, if the query document contains multiple operations.",
+				Optional:            true,
+			},
+			"variables": schema.StringAttribute{
+				MarkdownDescription: "The variables of the query as a JSON-encoded object, e.g. `jsonencode({ fullPath = \"foo/bar\" })`.",
+				Optional:            true,
+			},
+			"extract": schema.StringAttribute{
+				MarkdownDescription: "A [JMESPath](https://jmespath.org/) expression which is applied to the `data` of the response. The result is available in `extracted_value`.",
+				Optional:            true,
+			},
+			"result": schema.StringAttribute{
+				MarkdownDescription: "The JSON-encoded `data` of the response. Use `jsondecode` to access it.",
+				Computed:            true,
+			},
+			"extracted_value": schema.StringAttribute{
+				MarkdownDescription: "The value extracted from the response with the `extract` expression. Strings are returned as-is, all other values are JSON-encoded.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *gitlabGraphQLQueryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*api.ProviderData).Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *gitlabGraphQLQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state gitlabGraphQLQueryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := api.GraphQLRequest{
+		Query:         state.Query.ValueString(),
+		OperationName: state.OperationName.ValueString(),
+	}
+	if variables := state.Variables.ValueString(); variables != "" {
+		if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("variables"),
+				"Invalid GraphQL variables",
+				fmt.Sprintf("The variables must be a JSON-encoded object: %s", err.Error()),
+			)
+			return
+		}
+	}
+
+	var extract *jmespath.JMESPath
+	if expression := state.Extract.ValueString(); expression != "" {
+		var err error
+		if extract, err = jmespath.Compile(expression); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("extract"),
+				"Invalid JMESPath expression",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "executing GraphQL query", map[string]interface{}{
+		"operation_name": request.OperationName,
+	})
+	var data json.RawMessage
+	if err := api.SendGraphQLRequest(ctx, d.client, request, &data); err != nil {
+		var graphQLErrors api.GraphQLErrors
+		if !errors.As(err, &graphQLErrors) {
+			resp.Diagnostics.AddError("Unable to execute GraphQL query", err.Error())
+			return
+		}
+		for _, e := range graphQLErrors {
+			resp.Diagnostics.AddError("GraphQL query failed", e.Error())
+		}
+		return
+	}
+	if len(data) == 0 {
+		data = json.RawMessage("null")
+	}
+
+	// The ID must be stable for the same query, not for the same result.
+	state.Id = types.StringValue(fmt.Sprintf("%x", sha256.Sum256([]byte(request.Query+"\x00"+request.OperationName+"\x00"+state.Variables.ValueString()))))
+	state.Result = types.StringValue(string(data))
+	state.ExtractedValue = types.StringNull()
+
+	if extract != nil {
+		var decoded interface{}
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			resp.Diagnostics.AddError("Unable to decode GraphQL response", err.Error())
+			return
+		}
+		extracted, err := extract.Search(decoded)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("extract"), "Unable to evaluate JMESPath expression", err.Error())
+			return
+		}
+		extractedValue, err := graphQLQueryExtractedValue(extracted)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("extract"), "Unable to encode extracted value", err.Error())
+			return
+		}
+		state.ExtractedValue = types.StringValue(extractedValue)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// graphQLQueryExtractedValue returns strings as-is and JSON-encodes all other values.
+func graphQLQueryExtractedValue(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
@@ -0,0 +1,67 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_GitLabGraphQLQuery_DataSource_Basic(t *testing.T) {
+	//lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read the current user with variables and extract the username
+			{
+				Config: `
+					data "gitlab_graphql_query" "test" {
+						query          = "query user($username: String!) { user(username: $username) { id username } }"
+						operation_name = "user"
+						variables      = jsonencode({ username = "root" })
+						extract        = "user.username"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.gitlab_graphql_query.test", "id"),
+					resource.TestCheckResourceAttr("data.gitlab_graphql_query.test", "result", `{"user":{"id":"gid://gitlab/User/1","username":"root"}}`),
+					resource.TestCheckResourceAttr("data.gitlab_graphql_query.test", "extracted_value", "root"),
+				),
+			},
+			// Non-string values are extracted as JSON
+			{
+				Config: `
+					data "gitlab_graphql_query" "test" {
+						query   = "query { currentUser { id } }"
+						extract = "currentUser"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_graphql_query.test", "extracted_value", `{"id":"gid://gitlab/User/1"}`),
+				),
+			},
+			// GraphQL errors are surfaced as diagnostics
+			{
+				Config: `
+					data "gitlab_graphql_query" "test" {
+						query = "query { currentUser { doesNotExist } }"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`GraphQL query failed`),
+			},
+			// Invalid variables are rejected
+			{
+				Config: `
+					data "gitlab_graphql_query" "test" {
+						query     = "query { currentUser { id } }"
+						variables = "[]"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid GraphQL variables`),
+			},
+		},
+	})
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -100,7 +102,7 @@ func (d *gitlabMetadataDataSource) Configure(_ context.Context, req datasource.C
 		return
 	}
 
-	d.client = req.ProviderData.(*gitlab.Client)
+	d.client = req.ProviderData.(*api.ProviderData).Client
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -7,11 +7,16 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
 )
 
 func TestAcc_GitLabMetadata_DataSource_Basic(t *testing.T) {
+	// The interactions are recorded in testdata/cassettes, so that the test can be replayed without a GitLab instance.
+	testutil.UseCassette(t)
+
 	//lintignore:AT001
-	resource.ParallelTest(t, resource.TestCase{
+	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Read testing
@@ -0,0 +1,76 @@
+package generate
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// Run runs the `generate` command with the given command line arguments and writes the configuration to stdout,
+// unless the `--output` flag is set.
+// The GitLab API is accessed with the `GITLAB_TOKEN` and `GITLAB_BASE_URL` environment variables.
+func Run(ctx context.Context, version string, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.SetOutput(os.Stderr)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), `Usage: terraform-provider-gitlab generate --group <group> [--output <file>] [--include-sensitive-values]
+
+Generates the Terraform configuration with import blocks for the existing resources of a group,
+its subgroups and their projects. It requires Terraform 1.5 or later to import the resources with "terraform plan".
+
+The GitLab API is accessed with the GITLAB_TOKEN and GITLAB_BASE_URL environment variables.
+
+`)
+		flags.PrintDefaults()
+	}
+	group := flags.String("group", "", "The ID or full path of the group to generate the configuration for, including its subgroups and projects. Required.")
+	output := flags.String("output", "", "The file the configuration is written to. Defaults to stdout.")
+	includeSensitiveValues := flags.Bool("include-sensitive-values", false, "Write the values of sensitive attributes, e.g. of CI/CD variables, into the configuration. They are written as null otherwise.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *group == "" {
+		flags.Usage()
+		return errors.New("the --group flag is required")
+	}
+
+	config := api.Config{
+		Token:         os.Getenv("GITLAB_TOKEN"),
+		BaseURL:       os.Getenv("GITLAB_BASE_URL"),
+		EarlyAuthFail: true,
+	}
+	client, err := config.NewGitLabClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	serverFactory, err := provider.NewMuxedProviderServer(ctx, version)
+	if err != nil {
+		return err
+	}
+
+	generator, err := New(ctx, client, serverFactory(), Options{
+		Group:                  *group,
+		IncludeSensitiveValues: *includeSensitiveValues,
+	})
+	if err != nil {
+		return err
+	}
+
+	w := stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return generator.Generate(ctx, w)
+}
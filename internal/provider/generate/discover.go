@@ -0,0 +1,280 @@
+package generate
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// target is a resource which is imported, identified by the import ID of the resource type.
+type target struct {
+	resourceType string
+	// name is the name of the resource in the generated configuration, e.g. `my_org_app`.
+	name     string
+	importID string
+}
+
+// discover returns the resources of the group and all its subgroups and projects in the order they are generated.
+func (g *Generator) discover(ctx context.Context) ([]target, error) {
+	group, _, err := g.client.Groups.GetGroup(g.options.Group, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read group %q: %w", g.options.Group, err)
+	}
+
+	var targets []target
+	if err := g.discoverGroup(ctx, group, &targets); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+// discoverGroup appends the resources of the group, its projects and its subgroups to the targets.
+func (g *Generator) discoverGroup(ctx context.Context, group *gitlab.Group, targets *[]target) error {
+	groupID := fmt.Sprint(group.ID)
+	g.add(targets, "gitlab_group", group.FullPath, groupID)
+
+	members, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.GroupMember, *gitlab.Response, error) {
+		return g.client.Groups.ListGroupMembers(group.ID, &gitlab.ListGroupMembersOptions{ListOptions: options}, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the members of group %q: %w", group.FullPath, err)
+	}
+	for _, member := range members {
+		g.add(targets, "gitlab_group_membership", group.FullPath+"_"+member.Username, fmt.Sprintf("%s:%d", groupID, member.ID))
+	}
+
+	variables, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+		listOptions := gitlab.ListGroupVariablesOptions(options)
+		return g.client.GroupVariables.ListVariables(group.ID, &listOptions, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the variables of group %q: %w", group.FullPath, err)
+	}
+	for _, variable := range variables {
+		g.add(targets, "gitlab_group_variable", variableName(group.FullPath, variable.Key, variable.EnvironmentScope), fmt.Sprintf("%s:%s:%s", groupID, variable.Key, variable.EnvironmentScope))
+	}
+
+	labels, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.GroupLabel, *gitlab.Response, error) {
+		return g.client.GroupLabels.ListGroupLabels(group.ID, &gitlab.ListGroupLabelsOptions{
+			ListOptions:           options,
+			IncludeAncestorGroups: gitlab.Bool(false),
+			OnlyGroupLabels:       gitlab.Bool(true),
+		}, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the labels of group %q: %w", group.FullPath, err)
+	}
+	for _, label := range labels {
+		g.add(targets, "gitlab_group_label", group.FullPath+"_"+label.Name, fmt.Sprintf("%s:%s", groupID, label.Name))
+	}
+
+	hooks, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.GroupHook, *gitlab.Response, error) {
+		listOptions := gitlab.ListGroupHooksOptions(options)
+		return g.client.Groups.ListGroupHooks(group.ID, &listOptions, gitlab.WithContext(ctx))
+	})
+	if err := unavailableIsSkipped(err, "hooks", group.FullPath); err != nil {
+		return fmt.Errorf("unable to list the hooks of group %q: %w", group.FullPath, err)
+	}
+	for _, hook := range hooks {
+		g.add(targets, "gitlab_group_hook", fmt.Sprintf("%s_%d", group.FullPath, hook.ID), fmt.Sprintf("%s:%d", groupID, hook.ID))
+	}
+
+	badges, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.GroupBadge, *gitlab.Response, error) {
+		listOptions := gitlab.ListGroupBadgesOptions(options)
+		return g.client.GroupBadges.ListGroupBadges(group.ID, &listOptions, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the badges of group %q: %w", group.FullPath, err)
+	}
+	for _, badge := range badges {
+		g.add(targets, "gitlab_group_badge", fmt.Sprintf("%s_%d", group.FullPath, badge.ID), fmt.Sprintf("%s:%d", groupID, badge.ID))
+	}
+
+	// The projects shared with the group belong to another namespace, which may not be managed by the configuration.
+	projects, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.Project, *gitlab.Response, error) {
+		return g.client.Groups.ListGroupProjects(group.ID, &gitlab.ListGroupProjectsOptions{ListOptions: options, WithShared: gitlab.Bool(false)}, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the projects of group %q: %w", group.FullPath, err)
+	}
+	for _, project := range projects {
+		if err := g.discoverProject(ctx, project, targets); err != nil {
+			return err
+		}
+	}
+
+	subgroups, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.Group, *gitlab.Response, error) {
+		return g.client.Groups.ListSubGroups(group.ID, &gitlab.ListSubGroupsOptions{ListOptions: options}, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the subgroups of group %q: %w", group.FullPath, err)
+	}
+	for _, subgroup := range subgroups {
+		if err := g.discoverGroup(ctx, subgroup, targets); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// discoverProject appends the resources of the project to the targets.
+func (g *Generator) discoverProject(ctx context.Context, project *gitlab.Project, targets *[]target) error {
+	projectID := fmt.Sprint(project.ID)
+	fullPath := project.PathWithNamespace
+	g.add(targets, "gitlab_project", fullPath, projectID)
+
+	members, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.ProjectMember, *gitlab.Response, error) {
+		return g.client.ProjectMembers.ListProjectMembers(project.ID, &gitlab.ListProjectMembersOptions{ListOptions: options}, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the members of project %q: %w", fullPath, err)
+	}
+	for _, member := range members {
+		g.add(targets, "gitlab_project_membership", fullPath+"_"+member.Username, fmt.Sprintf("%s:%d", projectID, member.ID))
+	}
+
+	variables, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+		listOptions := gitlab.ListProjectVariablesOptions(options)
+		return g.client.ProjectVariables.ListVariables(project.ID, &listOptions, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the variables of project %q: %w", fullPath, err)
+	}
+	for _, variable := range variables {
+		g.add(targets, "gitlab_project_variable", variableName(fullPath, variable.Key, variable.EnvironmentScope), fmt.Sprintf("%s:%s:%s", projectID, variable.Key, variable.EnvironmentScope))
+	}
+
+	labels, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.Label, *gitlab.Response, error) {
+		return g.client.Labels.ListLabels(project.ID, &gitlab.ListLabelsOptions{
+			ListOptions:           options,
+			IncludeAncestorGroups: gitlab.Bool(false),
+		}, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the labels of project %q: %w", fullPath, err)
+	}
+	for _, label := range labels {
+		// The labels of the ancestor groups are managed with the groups.
+		if !label.IsProjectLabel {
+			continue
+		}
+		g.add(targets, "gitlab_label", fullPath+"_"+label.Name, fmt.Sprintf("%s:%s", projectID, label.Name))
+	}
+
+	hooks, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.ProjectHook, *gitlab.Response, error) {
+		listOptions := gitlab.ListProjectHooksOptions(options)
+		return g.client.Projects.ListProjectHooks(project.ID, &listOptions, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the hooks of project %q: %w", fullPath, err)
+	}
+	for _, hook := range hooks {
+		g.add(targets, "gitlab_project_hook", fmt.Sprintf("%s_%d", fullPath, hook.ID), fmt.Sprintf("%s:%d", projectID, hook.ID))
+	}
+
+	protectedBranches, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.ProtectedBranch, *gitlab.Response, error) {
+		listOptions := gitlab.ListProtectedBranchesOptions(options)
+		return g.client.ProtectedBranches.ListProtectedBranches(project.ID, &listOptions, gitlab.WithContext(ctx))
+	})
+	if err := unavailableIsSkipped(err, "protected branches", fullPath); err != nil {
+		return fmt.Errorf("unable to list the protected branches of project %q: %w", fullPath, err)
+	}
+	for _, protectedBranch := range protectedBranches {
+		g.add(targets, "gitlab_branch_protection", fullPath+"_"+protectedBranch.Name, fmt.Sprintf("%s:%s", projectID, protectedBranch.Name))
+	}
+
+	badges, err := listAll(func(options gitlab.ListOptions) ([]*gitlab.ProjectBadge, *gitlab.Response, error) {
+		return g.client.ProjectBadges.ListProjectBadges(project.ID, &gitlab.ListProjectBadgesOptions{ListOptions: options}, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the badges of project %q: %w", fullPath, err)
+	}
+	for _, badge := range badges {
+		// The badges of the ancestor groups are managed with the groups.
+		if badge.Kind != string(gitlab.ProjectBadgeKind) {
+			continue
+		}
+		g.add(targets, "gitlab_project_badge", fmt.Sprintf("%s_%d", fullPath, badge.ID), fmt.Sprintf("%s:%d", projectID, badge.ID))
+	}
+	return nil
+}
+
+// listAll calls the list function for all pages and returns the objects of all pages.
+func listAll[T any](list func(options gitlab.ListOptions) ([]T, *gitlab.Response, error)) ([]T, error) {
+	options := gitlab.ListOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	var all []T
+	for options.Page != 0 {
+		page, resp, err := list(options)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page...)
+		options.Page = resp.NextPage
+	}
+	return all, nil
+}
+
+// unavailableIsSkipped returns nil for errors of features which aren't available, e.g. because of the tier of the
+// GitLab instance or the features enabled in a project, and logs that the resources are skipped.
+func unavailableIsSkipped(err error, what string, parent string) error {
+	if err == nil {
+		return nil
+	}
+	isForbidden := false
+	if errResponse, ok := err.(*gitlab.ErrorResponse); ok && errResponse.Response != nil {
+		isForbidden = errResponse.Response.StatusCode == http.StatusForbidden
+	}
+	if isForbidden || api.Is404(err) {
+		log.Printf("[WARN] the %s of %q are not available, skipping them: %s", what, parent, err)
+		return nil
+	}
+	return err
+}
+
+// add appends a target with a unique resource name derived from the given name.
+func (g *Generator) add(targets *[]target, resourceType string, name string, importID string) {
+	names, ok := g.names[resourceType]
+	if !ok {
+		names = make(map[string]bool)
+		g.names[resourceType] = names
+	}
+
+	base := resourceName(name)
+	name = base
+	for i := 2; names[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	names[name] = true
+
+	*targets = append(*targets, target{resourceType: resourceType, name: name, importID: importID})
+}
+
+func variableName(parent string, key string, environmentScope string) string {
+	if environmentScope == "*" {
+		return parent + "_" + key
+	}
+	return parent + "_" + key + "_" + environmentScope
+}
+
+var invalidResourceNameCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceName returns a valid resource name for the name, e.g. `my_org_app` for `my-org/app`.
+func resourceName(name string) string {
+	name = strings.Trim(invalidResourceNameCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
@@ -0,0 +1,207 @@
+// Package generate implements the `generate` command of the provider binary, which generates the Terraform configuration
+// with `import` blocks for the existing resources of a GitLab group, its subgroups and their projects.
+//
+// The resources are discovered with the same list API calls the data sources use.
+// Their attributes are then imported and read by the provider itself, exactly like `terraform import` does,
+// so that the generated configuration matches what `Read` produces and the first plan after the import is empty.
+package generate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/xanzy/go-gitlab"
+)
+
+// Options configures the generated configuration.
+type Options struct {
+	// Group is the ID or full path of the top-level group.
+	Group string
+
+	// IncludeSensitiveValues writes the values of sensitive attributes, e.g. of CI/CD variables, into the configuration.
+	// They are written as `null` by default, like Terraform does when it generates configuration.
+	IncludeSensitiveValues bool
+}
+
+// Generator generates the configuration of the resources of a group with the resource implementations of a provider server.
+type Generator struct {
+	client  *gitlab.Client
+	server  tfprotov6.ProviderServer
+	schemas map[string]*tfprotov6.Schema
+	options Options
+
+	// names are the used resource names per resource type.
+	names map[string]map[string]bool
+}
+
+// New returns a generator, which discovers the resources with the client and reads them with the provider server.
+// The provider server is configured from the environment, i.e. with `GITLAB_TOKEN` and `GITLAB_BASE_URL`.
+func New(ctx context.Context, client *gitlab.Client, server tfprotov6.ProviderServer, options Options) (*Generator, error) {
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if err := diagnosticsError(schemaResp.Diagnostics); err != nil {
+		return nil, fmt.Errorf("unable to get the provider schema: %w", err)
+	}
+
+	config, err := tfprotov6.NewDynamicValue(schemaResp.Provider.ValueType(), emptyConfigValue(schemaResp.Provider.Block))
+	if err != nil {
+		return nil, err
+	}
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &config})
+	if err != nil {
+		return nil, err
+	}
+	if err := diagnosticsError(configureResp.Diagnostics); err != nil {
+		return nil, fmt.Errorf("unable to configure the provider: %w", err)
+	}
+
+	return &Generator{
+		client:  client,
+		server:  server,
+		schemas: schemaResp.ResourceSchemas,
+		options: options,
+		names:   make(map[string]map[string]bool),
+	}, nil
+}
+
+// Generate writes the `import` blocks and the `resource` blocks of all resources of the group to w.
+func (g *Generator) Generate(ctx context.Context, w io.Writer) error {
+	targets, err := g.discover(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		state, err := g.importAndRead(ctx, target)
+		if err != nil {
+			return fmt.Errorf("unable to read %s.%s with the import ID %q: %w", target.resourceType, target.name, target.importID, err)
+		}
+		if state.IsNull() {
+			log.Printf("[WARN] %s.%s with the import ID %q doesn't exist anymore, skipping it", target.resourceType, target.name, target.importID)
+			continue
+		}
+
+		schema := g.schemas[target.resourceType]
+		config, err := configValue(schema.Block, state, true)
+		if err != nil {
+			return fmt.Errorf("unable to generate the configuration of %s.%s: %w", target.resourceType, target.name, err)
+		}
+		config = g.validate(ctx, target, schema, config)
+
+		hcl, err := renderResource(target, schema.Block, config, g.options.IncludeSensitiveValues)
+		if err != nil {
+			return fmt.Errorf("unable to generate the configuration of %s.%s: %w", target.resourceType, target.name, err)
+		}
+		if _, err := w.Write(hcl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importAndRead imports the resource and refreshes it, like `terraform import` does, and returns its state.
+func (g *Generator) importAndRead(ctx context.Context, target target) (tftypes.Value, error) {
+	schema, ok := g.schemas[target.resourceType]
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("the resource type %s isn't supported by the provider", target.resourceType)
+	}
+
+	importResp, err := g.server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: target.resourceType,
+		ID:       target.importID,
+	})
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if err := diagnosticsError(importResp.Diagnostics); err != nil {
+		return tftypes.Value{}, err
+	}
+	if len(importResp.ImportedResources) != 1 {
+		return tftypes.Value{}, fmt.Errorf("expected exactly one imported resource, got %d", len(importResp.ImportedResources))
+	}
+	imported := importResp.ImportedResources[0]
+
+	readResp, err := g.server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     target.resourceType,
+		CurrentState: imported.State,
+		Private:      imported.Private,
+	})
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if err := diagnosticsError(readResp.Diagnostics); err != nil {
+		return tftypes.Value{}, err
+	}
+	if readResp.NewState == nil {
+		return tftypes.NewValue(schema.ValueType(), nil), nil
+	}
+	return readResp.NewState.Unmarshal(schema.ValueType())
+}
+
+// validate validates the generated configuration with the provider and returns it without the optional attributes the provider
+// rejects, e.g. the empty values GitLab returns for unset enums. The remaining diagnostics are logged as warnings,
+// e.g. if the configuration contains conflicting attributes which must be removed manually.
+func (g *Generator) validate(ctx context.Context, target target, schema *tfprotov6.Schema, config tftypes.Value) tftypes.Value {
+	for {
+		dynamicConfig, err := tfprotov6.NewDynamicValue(schema.ValueType(), config)
+		if err != nil {
+			log.Printf("[WARN] unable to validate the configuration of %s.%s: %s", target.resourceType, target.name, err)
+			return config
+		}
+		resp, err := g.server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+			TypeName: target.resourceType,
+			Config:   &dynamicConfig,
+		})
+		if err != nil {
+			log.Printf("[WARN] unable to validate the configuration of %s.%s: %s", target.resourceType, target.name, err)
+			return config
+		}
+
+		removed, err := removeRejectedAttributes(schema.Block, config, resp.Diagnostics)
+		if err != nil {
+			log.Printf("[WARN] unable to validate the configuration of %s.%s: %s", target.resourceType, target.name, err)
+			return config
+		}
+		if removed.Equal(config) {
+			for _, d := range resp.Diagnostics {
+				log.Printf("[WARN] the generated configuration of %s.%s must be reviewed: %s", target.resourceType, target.name, diagnosticString(d))
+			}
+			return config
+		}
+		config = removed
+	}
+}
+
+// diagnosticsError returns the error diagnostics as an error and logs the warnings.
+func diagnosticsError(diagnostics []*tfprotov6.Diagnostic) error {
+	var errs []string
+	for _, d := range diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			errs = append(errs, diagnosticString(d))
+		} else {
+			log.Printf("[WARN] %s", diagnosticString(d))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func diagnosticString(d *tfprotov6.Diagnostic) string {
+	s := d.Summary
+	if d.Detail != "" {
+		s += ": " + d.Detail
+	}
+	if d.Attribute != nil && len(d.Attribute.Steps()) > 0 {
+		s += fmt.Sprintf(" (%s)", d.Attribute.String())
+	}
+	return s
+}
@@ -0,0 +1,204 @@
+package generate
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil/fakegitlab"
+)
+
+// newTestGenerator returns a generator for the group `my-org` in a fake GitLab API, which contains a resource of each supported type.
+func newTestGenerator(t *testing.T, options Options) *Generator {
+	t.Helper()
+
+	server := fakegitlab.NewServer()
+	t.Cleanup(server.Close)
+	t.Setenv("GITLAB_TOKEN", fakegitlab.Token)
+	t.Setenv("GITLAB_BASE_URL", server.URL())
+
+	client, err := gitlab.NewClient(fakegitlab.Token, gitlab.WithBaseURL(server.URL()))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	group, _, err := client.Groups.CreateGroup(&gitlab.CreateGroupOptions{Name: gitlab.String("My Org"), Path: gitlab.String("my-org")})
+	if err != nil {
+		t.Fatalf("failed to create group: %v", err)
+	}
+	subgroup, _, err := client.Groups.CreateGroup(&gitlab.CreateGroupOptions{Name: gitlab.String("Team"), Path: gitlab.String("team"), ParentID: &group.ID})
+	if err != nil {
+		t.Fatalf("failed to create subgroup: %v", err)
+	}
+	project, _, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.String("app"), NamespaceID: &subgroup.ID})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+
+	for _, setup := range []func() error{
+		func() error {
+			_, _, err := client.GroupMembers.AddGroupMember(group.ID, &gitlab.AddGroupMemberOptions{UserID: gitlab.Int(42), AccessLevel: gitlab.AccessLevel(gitlab.DeveloperPermissions)})
+			return err
+		},
+		func() error {
+			_, _, err := client.GroupVariables.CreateVariable(group.ID, &gitlab.CreateGroupVariableOptions{Key: gitlab.String("GROUP_TOKEN"), Value: gitlab.String("secret")})
+			return err
+		},
+		func() error {
+			_, _, err := client.GroupLabels.CreateGroupLabel(group.ID, &gitlab.CreateGroupLabelOptions{Name: gitlab.String("team"), Color: gitlab.String("#00FF00")})
+			return err
+		},
+		func() error {
+			_, _, err := client.GroupBadges.AddGroupBadge(group.ID, &gitlab.AddGroupBadgeOptions{LinkURL: gitlab.String("https://example.com/group"), ImageURL: gitlab.String("https://example.com/group.svg")})
+			return err
+		},
+		func() error {
+			_, _, err := client.ProjectMembers.AddProjectMember(project.ID, &gitlab.AddProjectMemberOptions{UserID: 43, AccessLevel: gitlab.AccessLevel(gitlab.MaintainerPermissions)})
+			return err
+		},
+		func() error {
+			_, _, err := client.ProjectVariables.CreateVariable(project.ID, &gitlab.CreateProjectVariableOptions{Key: gitlab.String("DEPLOY_TOKEN"), Value: gitlab.String("secret"), EnvironmentScope: gitlab.String("production")})
+			return err
+		},
+		func() error {
+			_, _, err := client.Labels.CreateLabel(project.ID, &gitlab.CreateLabelOptions{Name: gitlab.String("bug"), Color: gitlab.String("#FF0000")})
+			return err
+		},
+		func() error {
+			_, _, err := client.Projects.AddProjectHook(project.ID, &gitlab.AddProjectHookOptions{URL: gitlab.String("https://hooks.example/ci")})
+			return err
+		},
+		func() error {
+			_, _, err := client.ProtectedBranches.ProtectRepositoryBranches(project.ID, &gitlab.ProtectRepositoryBranchesOptions{Name: gitlab.String("main")})
+			return err
+		},
+		func() error {
+			_, _, err := client.ProjectBadges.AddProjectBadge(project.ID, &gitlab.AddProjectBadgeOptions{LinkURL: gitlab.String("https://example.com/app"), ImageURL: gitlab.String("https://example.com/app.svg")})
+			return err
+		},
+		// A project of another group, which is shared with the group and must not be generated.
+		func() error {
+			partner, _, err := client.Groups.CreateGroup(&gitlab.CreateGroupOptions{Name: gitlab.String("Partner"), Path: gitlab.String("partner")})
+			if err != nil {
+				return err
+			}
+			shared, _, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.String("shared-lib"), NamespaceID: &partner.ID})
+			if err != nil {
+				return err
+			}
+			_, err = client.Projects.ShareProjectWithGroup(shared.ID, &gitlab.ShareWithGroupOptions{GroupID: &group.ID, GroupAccess: gitlab.AccessLevel(gitlab.DeveloperPermissions)})
+			return err
+		},
+	} {
+		if err := setup(); err != nil {
+			t.Fatalf("failed to set up the fake GitLab API: %v", err)
+		}
+	}
+
+	ctx := context.Background()
+	serverFactory, err := provider.NewMuxedProviderServer(ctx, "test")
+	if err != nil {
+		t.Fatalf("failed to create the provider server: %v", err)
+	}
+	options.Group = "my-org"
+	generator, err := New(ctx, client, serverFactory(), options)
+	if err != nil {
+		t.Fatalf("failed to create generator: %v", err)
+	}
+	return generator
+}
+
+// generate returns the generated configuration and fails the test if it isn't valid HCL or if warnings were logged.
+func generate(t *testing.T, generator *Generator) string {
+	t.Helper()
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	var out bytes.Buffer
+	if err := generator.Generate(context.Background(), &out); err != nil {
+		t.Fatalf("failed to generate the configuration: %v", err)
+	}
+	if _, diags := hclsyntax.ParseConfig(out.Bytes(), "generated.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("the generated configuration isn't valid HCL: %s\n%s", diags.Error(), out.String())
+	}
+	if strings.Contains(logs.String(), "[WARN]") {
+		t.Errorf("expected no warnings, got:\n%s", logs.String())
+	}
+	return out.String()
+}
+
+func TestGenerate(t *testing.T) {
+	out := generate(t, newTestGenerator(t, Options{}))
+
+	for _, expected := range []string{
+		"to = gitlab_group.my_org\n  id = \"2\"",
+		"to = gitlab_group_membership.my_org_user42\n  id = \"2:42\"",
+		"to = gitlab_group_variable.my_org_group_token\n  id = \"2:GROUP_TOKEN:*\"",
+		"to = gitlab_group_label.my_org_team\n  id = \"2:team\"",
+		"to = gitlab_group_badge.my_org_7\n  id = \"2:7\"",
+		"to = gitlab_group.my_org_team\n  id = \"3\"",
+		"to = gitlab_project.my_org_team_app\n  id = \"4\"",
+		"to = gitlab_project_membership.my_org_team_app_user43\n  id = \"4:43\"",
+		"to = gitlab_project_variable.my_org_team_app_deploy_token_production\n  id = \"4:DEPLOY_TOKEN:production\"",
+		"to = gitlab_label.my_org_team_app_bug\n  id = \"4:bug\"",
+		"to = gitlab_project_hook.my_org_team_app_9\n  id = \"4:9\"",
+		"to = gitlab_branch_protection.my_org_team_app_main\n  id = \"4:main\"",
+		"to = gitlab_project_badge.my_org_team_app_11\n  id = \"4:11\"",
+		`resource "gitlab_project" "my_org_team_app" {`,
+		`value             = null # sensitive`,
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected the configuration to contain %q, got:\n%s", expected, out)
+		}
+	}
+
+	for _, unexpected := range []string{
+		// the computed-only attributes are set by the import.
+		"full_path",
+		"web_url",
+		// the empty values of the enums are rejected by the validation, and are therefore removed.
+		`merge_method`,
+		`expires_at`,
+		"secret",
+		// the projects shared with the group belong to another group.
+		"shared_lib",
+	} {
+		if strings.Contains(out, unexpected) {
+			t.Errorf("expected the configuration not to contain %q, got:\n%s", unexpected, out)
+		}
+	}
+}
+
+func TestGenerate_includeSensitiveValues(t *testing.T) {
+	out := generate(t, newTestGenerator(t, Options{IncludeSensitiveValues: true}))
+
+	if strings.Contains(out, "# sensitive") {
+		t.Errorf("expected the sensitive values to be included, got:\n%s", out)
+	}
+	if !strings.Contains(out, `value             = "secret"`) {
+		t.Errorf("expected the configuration to contain the value of the variables, got:\n%s", out)
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	for value, expected := range map[string]string{
+		"my-org/team":   "my_org_team",
+		"DEPLOY_TOKEN":  "deploy_token",
+		"42":            "_42",
+		"feature/*.txt": "feature_txt",
+	} {
+		if actual := resourceName(value); actual != expected {
+			t.Errorf("expected the resource name of %q to be %q, got %q", value, expected, actual)
+		}
+	}
+}
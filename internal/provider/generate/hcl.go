@@ -0,0 +1,418 @@
+package generate
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// sensitiveTokens are written in place of the values of sensitive attributes, like Terraform does when it generates configuration.
+var sensitiveTokens = hclwrite.Tokens{
+	{Type: hclsyntax.TokenIdent, Bytes: []byte("null")},
+	{Type: hclsyntax.TokenComment, Bytes: []byte("# sensitive")},
+}
+
+// emptyConfigValue returns the value of an empty configuration of the block, i.e. without any attributes or nested blocks.
+func emptyConfigValue(block *tfprotov6.SchemaBlock) tftypes.Value {
+	values := make(map[string]tftypes.Value)
+	for _, a := range block.Attributes {
+		values[a.Name] = tftypes.NewValue(a.ValueType(), nil)
+	}
+	for _, b := range block.BlockTypes {
+		switch b.Nesting {
+		case tfprotov6.SchemaNestedBlockNestingModeList, tfprotov6.SchemaNestedBlockNestingModeSet:
+			values[b.TypeName] = tftypes.NewValue(b.ValueType(), []tftypes.Value{})
+		case tfprotov6.SchemaNestedBlockNestingModeMap:
+			values[b.TypeName] = tftypes.NewValue(b.ValueType(), map[string]tftypes.Value{})
+		default:
+			values[b.TypeName] = tftypes.NewValue(b.ValueType(), nil)
+		}
+	}
+	return tftypes.NewValue(block.ValueType(), values)
+}
+
+// configValue returns the configuration of the block which results in the given state.
+// It's the state without the attributes which can't be configured, i.e. the computed-only and the deprecated attributes.
+// The `id` attribute of the resource itself is removed, too, because it's set by the import.
+func configValue(block *tfprotov6.SchemaBlock, state tftypes.Value, isResource bool) (tftypes.Value, error) {
+	if state.IsNull() {
+		return state, nil
+	}
+
+	var values map[string]tftypes.Value
+	if err := state.As(&values); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	if err := configAttributes(block.Attributes, values, isResource); err != nil {
+		return tftypes.Value{}, err
+	}
+	for _, b := range block.BlockTypes {
+		value, err := mapElements(values[b.TypeName], func(element tftypes.Value) (tftypes.Value, error) {
+			return configValue(b.Block, element, false)
+		}, b.Nesting == tfprotov6.SchemaNestedBlockNestingModeSingle || b.Nesting == tfprotov6.SchemaNestedBlockNestingModeGroup)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		values[b.TypeName] = value
+	}
+	return tftypes.NewValue(state.Type(), values), nil
+}
+
+// configAttributes replaces the values of the attributes which can't be configured with null.
+func configAttributes(attributes []*tfprotov6.SchemaAttribute, values map[string]tftypes.Value, isResource bool) error {
+	for _, a := range attributes {
+		if (isResource && a.Name == "id") || a.Deprecated || (a.Computed && !a.Optional) {
+			values[a.Name] = tftypes.NewValue(a.ValueType(), nil)
+			continue
+		}
+		if a.NestedType == nil {
+			continue
+		}
+
+		nested := a.NestedType
+		value, err := mapElements(values[a.Name], func(element tftypes.Value) (tftypes.Value, error) {
+			if element.IsNull() {
+				return element, nil
+			}
+			var elementValues map[string]tftypes.Value
+			if err := element.As(&elementValues); err != nil {
+				return tftypes.Value{}, err
+			}
+			if err := configAttributes(nested.Attributes, elementValues, false); err != nil {
+				return tftypes.Value{}, err
+			}
+			return tftypes.NewValue(element.Type(), elementValues), nil
+		}, nested.Nesting == tfprotov6.SchemaObjectNestingModeSingle)
+		if err != nil {
+			return err
+		}
+		values[a.Name] = value
+	}
+	return nil
+}
+
+// removeRejectedAttributes replaces the values of the optional top-level attributes of the diagnostics with null.
+// Those are usually empty values returned by the API, which are equivalent to not configuring the attribute at all.
+func removeRejectedAttributes(block *tfprotov6.SchemaBlock, config tftypes.Value, diagnostics []*tfprotov6.Diagnostic) (tftypes.Value, error) {
+	var attributes map[string]tftypes.Value
+	if err := config.As(&attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+	// the map is shared with the configuration, which must not be modified
+	values := make(map[string]tftypes.Value, len(attributes))
+	for name, value := range attributes {
+		values[name] = value
+	}
+
+	for _, d := range diagnostics {
+		if d.Attribute == nil || len(d.Attribute.Steps()) != 1 {
+			continue
+		}
+		name, ok := d.Attribute.Steps()[0].(tftypes.AttributeName)
+		if !ok {
+			continue
+		}
+		for _, a := range block.Attributes {
+			if a.Name == string(name) && a.Optional && !a.Required {
+				values[a.Name] = tftypes.NewValue(a.ValueType(), nil)
+			}
+		}
+	}
+	return tftypes.NewValue(config.Type(), values), nil
+}
+
+// mapElements applies f to the value itself if single is true, otherwise to each element of the list, set or map.
+func mapElements(value tftypes.Value, f func(tftypes.Value) (tftypes.Value, error), single bool) (tftypes.Value, error) {
+	if single || value.IsNull() {
+		return f(value)
+	}
+
+	switch {
+	case value.Type().Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return tftypes.Value{}, err
+		}
+		for key, element := range elements {
+			mapped, err := f(element)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			elements[key] = mapped
+		}
+		return tftypes.NewValue(value.Type(), elements), nil
+	default:
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return tftypes.Value{}, err
+		}
+		for i, element := range elements {
+			mapped, err := f(element)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			elements[i] = mapped
+		}
+		return tftypes.NewValue(value.Type(), elements), nil
+	}
+}
+
+// renderResource returns the `import` block and the `resource` block of the configuration.
+func renderResource(target target, block *tfprotov6.SchemaBlock, config tftypes.Value, includeSensitiveValues bool) ([]byte, error) {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: target.resourceType},
+		hcl.TraverseAttr{Name: target.name},
+	})
+	importBody.SetAttributeValue("id", cty.StringVal(target.importID))
+	body.AppendNewline()
+
+	resourceBody := body.AppendNewBlock("resource", []string{target.resourceType, target.name}).Body()
+	if err := renderBlock(resourceBody, block, config, includeSensitiveValues); err != nil {
+		return nil, err
+	}
+	body.AppendNewline()
+
+	return hclwrite.Format(file.Bytes()), nil
+}
+
+// renderBlock writes the attributes and nested blocks of the value, which aren't null.
+func renderBlock(body *hclwrite.Body, block *tfprotov6.SchemaBlock, value tftypes.Value, includeSensitiveValues bool) error {
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return err
+	}
+
+	attributes := append([]*tfprotov6.SchemaAttribute{}, block.Attributes...)
+	sort.Slice(attributes, func(i, j int) bool { return attributes[i].Name < attributes[j].Name })
+	for _, a := range attributes {
+		v := values[a.Name]
+		if v.IsNull() {
+			continue
+		}
+		if a.Sensitive && !includeSensitiveValues {
+			body.SetAttributeRaw(a.Name, sensitiveTokens)
+			continue
+		}
+
+		var ctyValue cty.Value
+		var err error
+		if a.NestedType != nil {
+			ctyValue, err = nestedCtyValue(a.NestedType, v, includeSensitiveValues)
+		} else {
+			ctyValue, err = toCtyValue(v)
+		}
+		if err != nil {
+			return fmt.Errorf("attribute %q: %w", a.Name, err)
+		}
+		body.SetAttributeValue(a.Name, ctyValue)
+	}
+
+	blockTypes := append([]*tfprotov6.SchemaNestedBlock{}, block.BlockTypes...)
+	sort.Slice(blockTypes, func(i, j int) bool { return blockTypes[i].TypeName < blockTypes[j].TypeName })
+	for _, b := range blockTypes {
+		v := values[b.TypeName]
+		if v.IsNull() {
+			continue
+		}
+
+		switch b.Nesting {
+		case tfprotov6.SchemaNestedBlockNestingModeList, tfprotov6.SchemaNestedBlockNestingModeSet:
+			var elements []tftypes.Value
+			if err := v.As(&elements); err != nil {
+				return err
+			}
+			for _, element := range elements {
+				if err := renderBlock(body.AppendNewBlock(b.TypeName, nil).Body(), b.Block, element, includeSensitiveValues); err != nil {
+					return fmt.Errorf("block %q: %w", b.TypeName, err)
+				}
+			}
+		case tfprotov6.SchemaNestedBlockNestingModeMap:
+			var elements map[string]tftypes.Value
+			if err := v.As(&elements); err != nil {
+				return err
+			}
+			for _, key := range sortedKeys(elements) {
+				if err := renderBlock(body.AppendNewBlock(b.TypeName, []string{key}).Body(), b.Block, elements[key], includeSensitiveValues); err != nil {
+					return fmt.Errorf("block %q: %w", b.TypeName, err)
+				}
+			}
+		default:
+			if err := renderBlock(body.AppendNewBlock(b.TypeName, nil).Body(), b.Block, v, includeSensitiveValues); err != nil {
+				return fmt.Errorf("block %q: %w", b.TypeName, err)
+			}
+		}
+	}
+	return nil
+}
+
+// nestedCtyValue converts the value of a nested attribute. Unlike the attributes of an object type,
+// the null attributes of nested attributes are omitted and the sensitive ones are null, unless they are included.
+func nestedCtyValue(nested *tfprotov6.SchemaObject, value tftypes.Value, includeSensitiveValues bool) (cty.Value, error) {
+	if value.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	objectValue := func(v tftypes.Value) (cty.Value, error) {
+		if v.IsNull() {
+			return cty.NullVal(cty.DynamicPseudoType), nil
+		}
+		var values map[string]tftypes.Value
+		if err := v.As(&values); err != nil {
+			return cty.NilVal, err
+		}
+
+		attributes := make(map[string]cty.Value)
+		for _, a := range nested.Attributes {
+			av := values[a.Name]
+			switch {
+			case av.IsNull():
+				continue
+			case a.Sensitive && !includeSensitiveValues:
+				attributes[a.Name] = cty.NullVal(cty.DynamicPseudoType)
+			case a.NestedType != nil:
+				converted, err := nestedCtyValue(a.NestedType, av, includeSensitiveValues)
+				if err != nil {
+					return cty.NilVal, err
+				}
+				attributes[a.Name] = converted
+			default:
+				converted, err := toCtyValue(av)
+				if err != nil {
+					return cty.NilVal, err
+				}
+				attributes[a.Name] = converted
+			}
+		}
+		return objectVal(attributes), nil
+	}
+
+	switch nested.Nesting {
+	case tfprotov6.SchemaObjectNestingModeList, tfprotov6.SchemaObjectNestingModeSet:
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		converted := make([]cty.Value, 0, len(elements))
+		for _, element := range elements {
+			c, err := objectValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted = append(converted, c)
+		}
+		return tupleVal(converted), nil
+	case tfprotov6.SchemaObjectNestingModeMap:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		converted := make(map[string]cty.Value, len(elements))
+		for key, element := range elements {
+			c, err := objectValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted[key] = c
+		}
+		return objectVal(converted), nil
+	default:
+		return objectValue(value)
+	}
+}
+
+// toCtyValue converts a value to a cty.Value, which can be written with hclwrite.
+// Collections are converted to tuples and objects, because only their syntax matters.
+func toCtyValue(value tftypes.Value) (cty.Value, error) {
+	if !value.IsKnown() {
+		return cty.NilVal, fmt.Errorf("unexpected unknown value")
+	}
+	if value.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	typ := value.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.StringVal(s), nil
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := value.As(&n); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.NumberVal(n), nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.BoolVal(b), nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		converted := make([]cty.Value, 0, len(elements))
+		for _, element := range elements {
+			c, err := toCtyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted = append(converted, c)
+		}
+		return tupleVal(converted), nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		converted := make(map[string]cty.Value, len(elements))
+		for key, element := range elements {
+			c, err := toCtyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted[key] = c
+		}
+		return objectVal(converted), nil
+	}
+	return cty.NilVal, fmt.Errorf("unsupported type %s", typ)
+}
+
+func tupleVal(elements []cty.Value) cty.Value {
+	if len(elements) == 0 {
+		return cty.EmptyTupleVal
+	}
+	return cty.TupleVal(elements)
+}
+
+func objectVal(attributes map[string]cty.Value) cty.Value {
+	if len(attributes) == 0 {
+		return cty.EmptyObjectVal
+	}
+	return cty.ObjectVal(attributes)
+}
+
+func sortedKeys(m map[string]tftypes.Value) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultValuePlanModifier plans a default value for an optional and computed attribute which isn't configured.
+// It's used for the attributes which had a zero value default in the SDK, so that removing them from the configuration
+// resets them upstream.
+//
+// Nested attributes only get their default if their parent object is configured. Otherwise, the parent object isn't
+// managed and keeps its value, e.g. with the `UseStateForUnknown` plan modifier.
+type defaultValuePlanModifier struct {
+	value attr.Value
+}
+
+var (
+	_ planmodifier.Bool   = defaultValuePlanModifier{}
+	_ planmodifier.Int64  = defaultValuePlanModifier{}
+	_ planmodifier.List   = defaultValuePlanModifier{}
+	_ planmodifier.String = defaultValuePlanModifier{}
+)
+
+// defaultBool returns a plan modifier which plans the given value if the attribute isn't configured.
+func defaultBool(value bool) planmodifier.Bool {
+	return defaultValuePlanModifier{value: types.BoolValue(value)}
+}
+
+// defaultInt64 returns a plan modifier which plans the given value if the attribute isn't configured.
+func defaultInt64(value int64) planmodifier.Int64 {
+	return defaultValuePlanModifier{value: types.Int64Value(value)}
+}
+
+// defaultList returns a plan modifier which plans the given value if the attribute isn't configured.
+func defaultList(value types.List) planmodifier.List {
+	return defaultValuePlanModifier{value: value}
+}
+
+// defaultString returns a plan modifier which plans the given value if the attribute isn't configured.
+func defaultString(value string) planmodifier.String {
+	return defaultValuePlanModifier{value: types.StringValue(value)}
+}
+
+func (m defaultValuePlanModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("Defaults to %s if not configured.", m.value)
+}
+
+func (m defaultValuePlanModifier) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("Defaults to `%s` if not configured.", m.value)
+}
+
+func (m defaultValuePlanModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if m.applies(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics) {
+		resp.PlanValue = m.value.(types.Bool)
+	}
+}
+
+func (m defaultValuePlanModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if m.applies(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics) {
+		resp.PlanValue = m.value.(types.Int64)
+	}
+}
+
+func (m defaultValuePlanModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if m.applies(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics) {
+		resp.PlanValue = m.value.(types.List)
+	}
+}
+
+func (m defaultValuePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if m.applies(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics) {
+		resp.PlanValue = m.value.(types.String)
+	}
+}
+
+// applies returns true if the attribute isn't configured, but its parent object is.
+func (m defaultValuePlanModifier) applies(ctx context.Context, config tfsdk.Config, attributePath path.Path, configValue attr.Value, diags *diag.Diagnostics) bool {
+	if !configValue.IsNull() {
+		return false
+	}
+
+	parentPath := attributePath.ParentPath()
+	if len(parentPath.Steps()) == 0 {
+		return true
+	}
+
+	var parent types.Object
+	diags.Append(config.GetAttribute(ctx, parentPath, &parent)...)
+	return !diags.HasError() && !parent.IsNull() && !parent.IsUnknown()
+}
+
+// useStateForUnknownObjectModifier plans the state value for an object which isn't configured, even if it's null.
+// It's used for nested attributes which are only managed if they are configured, so that an object which doesn't
+// exist upstream doesn't become unknown with every change of the resource.
+// The `objectplanmodifier.UseStateForUnknown` plan modifier only uses known, non-null state values.
+type useStateForUnknownObjectModifier struct{}
+
+var _ planmodifier.Object = useStateForUnknownObjectModifier{}
+
+// useStateForUnknownObject returns a plan modifier which plans the state value, including null, for an unknown object.
+func useStateForUnknownObject() planmodifier.Object {
+	return useStateForUnknownObjectModifier{}
+}
+
+func (m useStateForUnknownObjectModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless it is configured."
+}
+
+func (m useStateForUnknownObjectModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForUnknownObjectModifier) PlanModifyObject(_ context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	// Do nothing on create, there is no state value yet.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a known planned value or an unknown configuration value.
+	if !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -5,11 +5,15 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
@@ -29,12 +33,52 @@ type GitLabProvider struct {
 // GitLabProviderModel describes the provider data model.
 type GitLabProviderModel struct {
 	Token          types.String `tfsdk:"token"`
+	TokenCommand   types.String `tfsdk:"token_command"`
+	TokenFile      types.String `tfsdk:"token_file"`
 	BaseUrl        types.String `tfsdk:"base_url"`
 	CACertFile     types.String `tfsdk:"cacert_file"`
 	Insecure       types.Bool   `tfsdk:"insecure"`
 	ClientCert     types.String `tfsdk:"client_cert"`
 	ClientKey      types.String `tfsdk:"client_key"`
 	EarlyAuthCheck types.Bool   `tfsdk:"early_auth_check"`
+
+	ProxyURL       types.String `tfsdk:"proxy_url"`
+	Headers        types.Map    `tfsdk:"headers"`
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	DriftReport types.Bool `tfsdk:"drift_report"`
+
+	Retry []GitLabProviderRetryModel `tfsdk:"retry"`
+	OAuth []GitLabProviderOAuthModel `tfsdk:"oauth"`
+	CIJob []GitLabProviderCIJobModel `tfsdk:"ci_job"`
+}
+
+// GitLabProviderRetryModel describes the provider retry data model.
+type GitLabProviderRetryModel struct {
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	MinBackoff  types.String `tfsdk:"min_backoff"`
+	MaxBackoff  types.String `tfsdk:"max_backoff"`
+}
+
+// GitLabProviderOAuthModel describes the provider OAuth data model.
+type GitLabProviderOAuthModel struct {
+	TokenURL     types.String `tfsdk:"token_url"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+}
+
+// GitLabProviderCIJobModel describes the provider CI job data model.
+type GitLabProviderCIJobModel struct {
+	TokenExchangeURL types.String `tfsdk:"token_exchange_url"`
+	IDTokenVariable  types.String `tfsdk:"id_token_variable"`
+	Audience         types.String `tfsdk:"audience"`
 }
 
 func (p *GitLabProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -50,6 +94,14 @@ func (p *GitLabProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"token_command": schema.StringAttribute{
+				MarkdownDescription: "A command which outputs the token used to connect to GitLab, e.g. to read it from a secrets manager. The command is run with `sh -c` (`cmd /C` on Windows) when the provider is configured and again whenever the GitLab API rejects the token with a `401`, which allows rotating the token without restarting Terraform. Leading and trailing whitespace is removed from the output. Cannot be combined with `token`, `token_file` or `oauth`, the `GITLAB_TOKEN` environment variable is ignored when this is set.",
+				Optional:            true,
+			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file which contains the token used to connect to GitLab. The file is read when the provider is configured and again whenever the GitLab API rejects the token with a `401`, which allows rotating the token without restarting Terraform. Leading and trailing whitespace is removed from the content. Cannot be combined with `token`, `token_command` or `oauth`, the `GITLAB_TOKEN` environment variable is ignored when this is set.",
+				Optional:            true,
+			},
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "This is the target GitLab base API endpoint. Providing a value is a requirement when working with GitLab CE or GitLab Enterprise e.g. `https://my.gitlab.server/api/v4/`. It is optional to provide this value and it can also be sourced from the `GITLAB_BASE_URL` environment variable. The value must end with a slash.",
 				Optional:            true,
@@ -74,6 +126,116 @@ func (p *GitLabProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				MarkdownDescription: "(Experimental) By default the provider does a dummy request to get the current user in order to verify that the provider configuration is correct and the GitLab API is reachable. Set this to `false` to skip this check. This may be useful if the GitLab instance does not yet exist and is created within the same terraform module. This is an experimental feature and may change in the future. Please make sure to always keep backups of your state.",
 				Optional:            true,
 			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the proxy used for all requests to the GitLab instance, e.g. `http://proxy.example.com:3128`. By default, the proxy is configured with the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional headers which are added to all requests to the GitLab instance, e.g. the headers required by an identity-aware proxy in front of it.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "The maximum time a single request to the GitLab instance may take, including reading the response body, given as duration string like `30s` or `1m`. Retried requests are limited individually. By default, requests don't time out.",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum number of requests per second sent to the GitLab instance. Requests exceeding the limit are delayed. This is useful to not trigger the rate limits of the GitLab instance with large configurations. By default, requests are not limited.",
+				Optional:            true,
+				Validators:          []validator.Float64{float64validator.AtLeast(0)},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of concurrent requests sent to the GitLab instance. By default, the number of concurrent requests is only limited by the parallelism of Terraform.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"drift_report": schema.BoolAttribute{
+				MarkdownDescription: "When set to true, resources which changed outside of Terraform are reported with a warning naming the author and time of the most recent change, taken from the audit events of their project or group. It's supported by `gitlab_branch_protection`, `gitlab_project_membership`, `gitlab_group_membership` and `gitlab_project_protected_environment`. The audit events API requires GitLab Premium. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ci_job": schema.ListNestedBlock{
+				MarkdownDescription: "Configures the provider to authenticate as the GitLab CI job it runs in. The `base_url` defaults to the `CI_API_V4_URL` of the job. If `token_exchange_url` is set, the OIDC id_token of the job is exchanged for a short-lived access token, otherwise the `CI_JOB_TOKEN` is used, which can only access a few API endpoints, see https://docs.gitlab.com/ee/ci/jobs/ci_job_token.html. Cannot be combined with `token`, the `GITLAB_TOKEN` environment variable is ignored when this block is set.",
+				Validators:          []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"token_exchange_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of an OAuth 2.0 Token Exchange (RFC 8693) endpoint which exchanges the OIDC id_token of the job for a short-lived GitLab access token.",
+							Optional:            true,
+						},
+						"id_token_variable": schema.StringAttribute{
+							MarkdownDescription: "The name of the environment variable which contains the OIDC id_token of the job, as configured with the `id_tokens` keyword in the CI configuration. Defaults to the deprecated `CI_JOB_JWT_V2` and `CI_JOB_JWT` variables.",
+							Optional:            true,
+						},
+						"audience": schema.StringAttribute{
+							MarkdownDescription: "The audience of the requested access token, sent to the token exchange endpoint.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"oauth": schema.ListNestedBlock{
+				MarkdownDescription: "Configures the provider to authenticate with OAuth2 access tokens obtained from the token endpoint of the GitLab instance. The access tokens are refreshed transparently when they expire. The `refresh_token` grant is used if `refresh_token` is set, the `password` grant if `username` and `password` are set and the `client_credentials` grant otherwise. Cannot be combined with `token`, the `GITLAB_TOKEN` environment variable is ignored when this block is set.",
+				Validators:          []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"token_url": schema.StringAttribute{
+							MarkdownDescription: "The OAuth2 token endpoint. Defaults to the `/oauth/token` endpoint of the GitLab instance configured with `base_url`.",
+							Optional:            true,
+						},
+						"client_id": schema.StringAttribute{
+							MarkdownDescription: "The OAuth2 client ID of the GitLab application.",
+							Required:            true,
+						},
+						"client_secret": schema.StringAttribute{
+							MarkdownDescription: "The OAuth2 client secret of the GitLab application.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"scopes": schema.ListAttribute{
+							MarkdownDescription: "The OAuth2 scopes to request for the access tokens, e.g. `api`.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+						"refresh_token": schema.StringAttribute{
+							MarkdownDescription: "The OAuth2 refresh token used to obtain access tokens with the `refresh_token` grant.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "The username used to obtain access tokens with the `password` grant.",
+							Optional:            true,
+						},
+						"password": schema.StringAttribute{
+							MarkdownDescription: "The password used to obtain access tokens with the `password` grant.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				MarkdownDescription: "Configures how requests to the GitLab API are retried on rate limiting (`429`) and transient server errors (`502`, `503` and `504`). The `Retry-After` and `RateLimit-Reset` response headers are honoured when present. If not set, the default retry behavior of the underlying GitLab client is used.",
+				Validators:          []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of attempts for a single request, including the initial one. Defaults to `5`.",
+							Optional:            true,
+							Validators:          []validator.Int64{int64validator.AtLeast(1)},
+						},
+						"min_backoff": schema.StringAttribute{
+							MarkdownDescription: "The minimum time to wait between attempts, given as duration string like `500ms` or `2s`. Defaults to `1s`.",
+							Optional:            true,
+						},
+						"max_backoff": schema.StringAttribute{
+							MarkdownDescription: "The maximum time to wait between attempts when the response does not include a `Retry-After` or `RateLimit-Reset` header, given as duration string like `30s` or `1m`. Defaults to `30s`.",
+							Optional:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -95,6 +257,22 @@ func (p *GitLabProvider) Configure(ctx context.Context, req provider.ConfigureRe
 				"Either apply the source of the value first, set the token attribute value statically in the configuration, or use the GITLAB_TOKEN environment variable.",
 		)
 	}
+	if config.TokenCommand.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_command"),
+			"Unknown GitLab Token Command",
+			"The provider cannot create the GitLab API client as there is an unknown configuration value for the GitLab Token Command. "+
+				"Either apply the source of the value first, set the token_command attribute value statically in the configuration.",
+		)
+	}
+	if config.TokenFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_file"),
+			"Unknown GitLab Token File",
+			"The provider cannot create the GitLab API client as there is an unknown configuration value for the GitLab Token File. "+
+				"Either apply the source of the value first, set the token_file attribute value statically in the configuration.",
+		)
+	}
 	if config.BaseUrl.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("base_url"),
@@ -143,6 +321,54 @@ func (p *GitLabProvider) Configure(ctx context.Context, req provider.ConfigureRe
 				"Either apply the source of the value first, set the token attribute value statically in the configuration.",
 		)
 	}
+	if config.ProxyURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"Unknown GitLab Proxy URL",
+			"The provider cannot create the GitLab API client as there is an unknown configuration value for the GitLab Proxy URL. "+
+				"Either apply the source of the value first, set the proxy_url attribute value statically in the configuration.",
+		)
+	}
+	if config.Headers.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("headers"),
+			"Unknown GitLab Headers",
+			"The provider cannot create the GitLab API client as there is an unknown configuration value for the GitLab Headers. "+
+				"Either apply the source of the value first, set the headers attribute value statically in the configuration.",
+		)
+	}
+	if config.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Unknown GitLab Request Timeout",
+			"The provider cannot create the GitLab API client as there is an unknown configuration value for the GitLab Request Timeout. "+
+				"Either apply the source of the value first, set the request_timeout attribute value statically in the configuration.",
+		)
+	}
+	if config.RequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Unknown GitLab Requests Per Second Value",
+			"The provider cannot create the GitLab API client as there is an unknown configuration value for the GitLab Requests Per Second limit. "+
+				"Either apply the source of the value first, set the requests_per_second attribute value statically in the configuration.",
+		)
+	}
+	if config.MaxConcurrentRequests.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown GitLab Max Concurrent Requests Value",
+			"The provider cannot create the GitLab API client as there is an unknown configuration value for the GitLab Max Concurrent Requests limit. "+
+				"Either apply the source of the value first, set the max_concurrent_requests attribute value statically in the configuration.",
+		)
+	}
+	if config.DriftReport.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("drift_report"),
+			"Unknown GitLab Drift Report Flag Value",
+			"The provider cannot create the GitLab API client as there is an unknown configuration value for the GitLab Drift Report flag. "+
+				"Either apply the source of the value first, set the drift_report attribute value statically in the configuration.",
+		)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -163,6 +389,15 @@ func (p *GitLabProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	if !config.Token.IsNull() {
 		evaluatedConfig.Token = config.Token.ValueString()
 	}
+	if !config.TokenCommand.IsNull() || !config.TokenFile.IsNull() {
+		evaluatedConfig.TokenCommand = config.TokenCommand.ValueString()
+		evaluatedConfig.TokenFile = config.TokenFile.ValueString()
+
+		// The GITLAB_TOKEN environment variable is ignored when the token is read from a command or file.
+		if config.Token.IsNull() {
+			evaluatedConfig.Token = ""
+		}
+	}
 	if !config.BaseUrl.IsNull() {
 		evaluatedConfig.BaseURL = config.BaseUrl.ValueString()
 	}
@@ -182,21 +417,127 @@ func (p *GitLabProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		evaluatedConfig.EarlyAuthFail = config.EarlyAuthCheck.ValueBool()
 	}
 
+	if !config.ProxyURL.IsNull() {
+		evaluatedConfig.ProxyURL = config.ProxyURL.ValueString()
+	}
+	if !config.Headers.IsNull() {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &evaluatedConfig.Headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout, err := api.ParseRequestTimeout(config.RequestTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid GitLab Request Timeout", err.Error())
+			return
+		}
+		evaluatedConfig.RequestTimeout = requestTimeout
+	}
+
+	if !config.RequestsPerSecond.IsNull() {
+		evaluatedConfig.RequestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	}
+	if !config.MaxConcurrentRequests.IsNull() {
+		evaluatedConfig.MaxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt64())
+	}
+	if !config.DriftReport.IsNull() {
+		evaluatedConfig.DriftReport = config.DriftReport.ValueBool()
+	}
+	if len(config.Retry) > 0 {
+		retry := config.Retry[0]
+		if retry.MaxAttempts.IsUnknown() || retry.MinBackoff.IsUnknown() || retry.MaxBackoff.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry"),
+				"Unknown GitLab Retry Configuration",
+				"The provider cannot create the GitLab API client as there is an unknown configuration value for the GitLab Retry Configuration. "+
+					"Either apply the source of the value first, set the retry attribute values statically in the configuration.",
+			)
+			return
+		}
+
+		retryConfig, err := api.NewRetryConfig(int(retry.MaxAttempts.ValueInt64()), retry.MinBackoff.ValueString(), retry.MaxBackoff.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("retry"), "Invalid GitLab Retry Configuration", err.Error())
+			return
+		}
+		evaluatedConfig.Retry = retryConfig
+	}
+
+	if len(config.OAuth) > 0 {
+		oauth := config.OAuth[0]
+		if oauth.TokenURL.IsUnknown() || oauth.ClientID.IsUnknown() || oauth.ClientSecret.IsUnknown() || oauth.Scopes.IsUnknown() ||
+			oauth.RefreshToken.IsUnknown() || oauth.Username.IsUnknown() || oauth.Password.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("oauth"),
+				"Unknown GitLab OAuth Configuration",
+				"The provider cannot create the GitLab API client as there is an unknown configuration value for the GitLab OAuth Configuration. "+
+					"Either apply the source of the value first, set the oauth attribute values statically in the configuration.",
+			)
+			return
+		}
+
+		var scopes []string
+		resp.Diagnostics.Append(oauth.Scopes.ElementsAs(ctx, &scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		evaluatedConfig.OAuth = &api.OAuthConfig{
+			TokenURL:     oauth.TokenURL.ValueString(),
+			ClientID:     oauth.ClientID.ValueString(),
+			ClientSecret: oauth.ClientSecret.ValueString(),
+			Scopes:       scopes,
+			RefreshToken: oauth.RefreshToken.ValueString(),
+			Username:     oauth.Username.ValueString(),
+			Password:     oauth.Password.ValueString(),
+		}
+
+		// The GITLAB_TOKEN environment variable is ignored when OAuth is configured.
+		if config.Token.IsNull() {
+			evaluatedConfig.Token = ""
+		}
+	}
+
+	if len(config.CIJob) > 0 {
+		ciJob := config.CIJob[0]
+		if ciJob.TokenExchangeURL.IsUnknown() || ciJob.IDTokenVariable.IsUnknown() || ciJob.Audience.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ci_job"),
+				"Unknown GitLab CI Job Configuration",
+				"The provider cannot create the GitLab API client as there is an unknown configuration value for the GitLab CI Job Configuration. "+
+					"Either apply the source of the value first, set the ci_job attribute values statically in the configuration.",
+			)
+			return
+		}
+
+		evaluatedConfig.CIJob = &api.CIJobConfig{
+			TokenExchangeURL: ciJob.TokenExchangeURL.ValueString(),
+			IDTokenVariable:  ciJob.IDTokenVariable.ValueString(),
+			Audience:         ciJob.Audience.ValueString(),
+		}
+
+		// The GITLAB_TOKEN environment variable is ignored when authenticating as the CI job.
+		if config.Token.IsNull() {
+			evaluatedConfig.Token = ""
+		}
+	}
+
 	// TODO(@timofurrer): validate configuration values
 
 	// Configure our logger masking
 	ctx = utils.ApplyLogMaskingToContext(ctx)
 
 	// Creating a new GitLab Client from the provider configuration
-	gitlabClient, err := evaluatedConfig.NewGitLabClient(ctx)
+	providerData, err := evaluatedConfig.NewProviderData(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create GitLab Client from provider configuration", fmt.Sprintf("The provider failed to create a new GitLab Client from the given configuration: %+v", err))
 		return
 	}
 
-	// Attach the client to the response so that it will be available for the Data Sources and Resources
-	resp.DataSourceData = gitlabClient
-	resp.ResourceData = gitlabClient
+	// Attach the client and its capabilities to the response so that they will be available for the Data Sources and Resources
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *GitLabProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -27,5 +27,6 @@ func NewMuxedProviderServer(ctx context.Context, version string) (func() tfproto
 	if err != nil {
 		return nil, err
 	}
+
 	return muxServer.ProviderServer, nil
 }
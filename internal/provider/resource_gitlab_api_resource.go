@@ -0,0 +1,385 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jmespath/go-jmespath"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &gitlabAPIResource{}
+var _ resource.ResourceWithConfigure = &gitlabAPIResource{}
+
+func init() {
+	registerResource(NewGitLabAPIResource)
+}
+
+// NewGitLabAPIResource is a helper function to simplify the provider implementation.
+func NewGitLabAPIResource() resource.Resource {
+	return &gitlabAPIResource{}
+}
+
+// gitlabAPIResource defines the resource implementation.
+type gitlabAPIResource struct {
+	client *gitlab.Client
+}
+
+// gitlabAPIResourceModel describes the resource data model.
+type gitlabAPIResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	CreateMethod    types.String `tfsdk:"create_method"`
+	CreatePath      types.String `tfsdk:"create_path"`
+	ReadPath        types.String `tfsdk:"read_path"`
+	UpdateMethod    types.String `tfsdk:"update_method"`
+	UpdatePath      types.String `tfsdk:"update_path"`
+	DeleteMethod    types.String `tfsdk:"delete_method"`
+	DeletePath      types.String `tfsdk:"delete_path"`
+	Body            types.String `tfsdk:"body"`
+	IdAttribute     types.String `tfsdk:"id_attribute"`
+	DriftAttributes types.List   `tfsdk:"drift_attributes"`
+	Response        types.String `tfsdk:"response"`
+}
+
+func (r *gitlabAPIResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_resource"
+}
+
+func (r *gitlabAPIResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	pathDescription := "The path is relative to the API base URL, e.g. `projects/42/hooks/{id}`, and may contain a query string. `{id}` is replaced with the URL-encoded ID of the resource."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_api_resource`" + ` resource allows to manage an object of the GitLab REST API, which isn't modelled by a dedicated resource yet.
+The requests are sent with the authentication, TLS and retry configuration of the provider.
+
+~> This resource is an escape hatch. It doesn't know anything about the managed object,
+   therefore only the attributes listed in ` + "`drift_attributes`" + ` are checked for drift.
+   Prefer a dedicated resource whenever one exists.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/rest/)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the object, as extracted from the create response with `id_attribute`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"create_method": schema.StringAttribute{
+				MarkdownDescription: "The HTTP method to create the object. Valid values are `POST`, `PUT` and `PATCH`. Defaults to `POST`.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(http.MethodPost, http.MethodPut, http.MethodPatch)},
+			},
+			"create_path": schema.StringAttribute{
+				MarkdownDescription: "The path to create the object. " + pathDescription,
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"read_path": schema.StringAttribute{
+				MarkdownDescription: "The path to read the object. " + pathDescription,
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"update_method": schema.StringAttribute{
+				MarkdownDescription: "The HTTP method to update the object. Valid values are `POST`, `PUT` and `PATCH`. Defaults to `PUT`.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(http.MethodPost, http.MethodPut, http.MethodPatch)},
+			},
+			"update_path": schema.StringAttribute{
+				MarkdownDescription: "The path to update the object. " + pathDescription + " If not set, a change of the `body` re-creates the object.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"delete_method": schema.StringAttribute{
+				MarkdownDescription: "The HTTP method to delete the object. Valid values are `DELETE`, `POST`, `PUT` and `PATCH`. Defaults to `DELETE`.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(http.MethodDelete, http.MethodPost, http.MethodPut, http.MethodPatch)},
+			},
+			"delete_path": schema.StringAttribute{
+				MarkdownDescription: "The path to delete the object. " + pathDescription + " Defaults to the `read_path`.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "The JSON-encoded object which is sent as body of the create and update requests, e.g. `jsonencode({ name = \"foo\" })`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							var updatePath types.String
+							resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("update_path"), &updatePath)...)
+							resp.RequiresReplace = updatePath.IsNull()
+						},
+						"Requires replacement if `update_path` is not set.",
+						"Requires replacement if `update_path` is not set.",
+					),
+				},
+			},
+			"id_attribute": schema.StringAttribute{
+				MarkdownDescription: "A [JMESPath](https://jmespath.org/) expression to extract the ID from the create response. Defaults to `id`.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"drift_attributes": schema.ListAttribute{
+				MarkdownDescription: "The top-level attributes of the `body` which are compared with the read response to detect drift.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"response": schema.StringAttribute{
+				MarkdownDescription: "The JSON-encoded response of the last read request. Use `jsondecode` to access it.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabAPIResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*api.ProviderData).Client
+}
+
+func (r *gitlabAPIResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabAPIResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := data.requestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idAttribute := "id"
+	if !data.IdAttribute.IsNull() {
+		idAttribute = data.IdAttribute.ValueString()
+	}
+	idExpression, err := jmespath.Compile(idAttribute)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id_attribute"), "Invalid JMESPath expression", err.Error())
+		return
+	}
+
+	method := stringValueOrDefault(data.CreateMethod, http.MethodPost)
+	createPath := data.CreatePath.ValueString()
+	tflog.Debug(ctx, "creating GitLab API object", map[string]interface{}{"method": method, "path": createPath})
+	response, _, err := api.SendRESTRequest(ctx, r.client, method, createPath, body)
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to create object with %s %s: %s", method, createPath, err.Error()))
+		return
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(response, &decoded); err != nil {
+		resp.Diagnostics.AddError("Unable to decode create response", err.Error())
+		return
+	}
+	id, err := idExpression.Search(decoded)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id_attribute"), "Unable to evaluate JMESPath expression", err.Error())
+		return
+	}
+	switch v := id.(type) {
+	case string:
+		data.Id = types.StringValue(v)
+	case float64:
+		data.Id = types.StringValue(strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id_attribute"),
+			"Unable to extract ID from create response",
+			fmt.Sprintf("The expression %q must evaluate to a string or number, got %v in response %s", idAttribute, id, string(response)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.readAfterWrite(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *gitlabAPIResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabAPIResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		tflog.Debug(ctx, "GitLab API object not found, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *gitlabAPIResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabAPIResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without an update path, changes to the body require a replacement, all other attributes are only stored in the state.
+	if !data.UpdatePath.IsNull() {
+		body, diags := data.requestBody()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		method := stringValueOrDefault(data.UpdateMethod, http.MethodPut)
+		updatePath := expandAPIResourcePath(data.UpdatePath.ValueString(), data.Id.ValueString())
+		tflog.Debug(ctx, "updating GitLab API object", map[string]interface{}{"method": method, "path": updatePath})
+		if _, _, err := api.SendRESTRequest(ctx, r.client, method, updatePath, body); err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update object with %s %s: %s", method, updatePath, err.Error()))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.readAfterWrite(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *gitlabAPIResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabAPIResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	method := stringValueOrDefault(data.DeleteMethod, http.MethodDelete)
+	deletePath := expandAPIResourcePath(stringValueOrDefault(data.DeletePath, data.ReadPath.ValueString()), data.Id.ValueString())
+	tflog.Debug(ctx, "deleting GitLab API object", map[string]interface{}{"method": method, "path": deletePath})
+	if _, _, err := api.SendRESTRequest(ctx, r.client, method, deletePath, nil); err != nil && !api.Is404(err) {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete object with %s %s: %s", method, deletePath, err.Error()))
+	}
+}
+
+// readAfterWrite reads the object after it has been created or updated, so that the response is always the one of the read request.
+// The body must be kept as planned, otherwise Terraform rejects the result of the apply.
+func (r *gitlabAPIResource) readAfterWrite(ctx context.Context, data *gitlabAPIResourceModel) diag.Diagnostics {
+	body := data.Body
+	found, diags := r.read(ctx, data)
+	if !diags.HasError() && !found {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("The object %q was not found at its read path after it has been written", data.Id.ValueString()))
+	}
+	data.Body = body
+	return diags
+}
+
+// read reads the object into the response and patches the drifted attributes into the body.
+// The body is only re-encoded if an attribute drifted, so that the formatting of the configuration is kept otherwise.
+func (r *gitlabAPIResource) read(ctx context.Context, data *gitlabAPIResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	readPath := expandAPIResourcePath(data.ReadPath.ValueString(), data.Id.ValueString())
+	response, _, err := api.SendRESTRequest(ctx, r.client, http.MethodGet, readPath, nil)
+	if err != nil {
+		if api.Is404(err) {
+			return false, diags
+		}
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read object with GET %s: %s", readPath, err.Error()))
+		return false, diags
+	}
+	data.Response = types.StringValue(string(response))
+
+	var driftAttributes []string
+	diags.Append(data.DriftAttributes.ElementsAs(ctx, &driftAttributes, false)...)
+	if diags.HasError() || len(driftAttributes) == 0 || data.Body.IsNull() {
+		return true, diags
+	}
+
+	var body, remote map[string]interface{}
+	if err := json.Unmarshal([]byte(data.Body.ValueString()), &body); err != nil {
+		diags.AddAttributeError(path.Root("body"), "Invalid body", fmt.Sprintf("The body must be a JSON-encoded object: %s", err.Error()))
+		return true, diags
+	}
+	if err := json.Unmarshal(response, &remote); err != nil {
+		diags.AddError("Unable to decode read response", fmt.Sprintf("The response of GET %s must be a JSON object to detect drift: %s", readPath, err.Error()))
+		return true, diags
+	}
+
+	drifted := false
+	for _, attribute := range driftAttributes {
+		value, ok := remote[attribute]
+		if !ok || reflect.DeepEqual(body[attribute], value) {
+			continue
+		}
+		tflog.Debug(ctx, "GitLab API object attribute drifted", map[string]interface{}{"attribute": attribute})
+		body[attribute] = value
+		drifted = true
+	}
+	if drifted {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			diags.AddError("Unable to encode body", err.Error())
+			return true, diags
+		}
+		data.Body = types.StringValue(string(encoded))
+	}
+	return true, diags
+}
+
+// requestBody returns the body for create and update requests.
+func (m *gitlabAPIResourceModel) requestBody() (json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if m.Body.IsNull() {
+		return nil, diags
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(m.Body.ValueString()), &body); err != nil {
+		diags.AddAttributeError(path.Root("body"), "Invalid body", fmt.Sprintf("The body must be a JSON-encoded object: %s", err.Error()))
+		return nil, diags
+	}
+	return json.RawMessage(m.Body.ValueString()), diags
+}
+
+// expandAPIResourcePath replaces the `{id}` placeholder of the path template with the URL-encoded ID.
+func expandAPIResourcePath(template string, id string) string {
+	return strings.ReplaceAll(template, "{id}", url.PathEscape(id))
+}
+
+// stringValueOrDefault returns the value of the string or the default value if it is null.
+func stringValueOrDefault(value types.String, defaultValue string) string {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultValue
+	}
+	return value.ValueString()
+}
@@ -0,0 +1,109 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAcc_GitlabAPIResource_basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+
+	config := func(url string) string {
+		return fmt.Sprintf(`
+		resource "gitlab_api_resource" "this" {
+			create_path = "projects/%[1]d/hooks"
+			read_path   = "projects/%[1]d/hooks/{id}"
+			update_path = "projects/%[1]d/hooks/{id}"
+
+			body = jsonencode({
+				url         = %[2]q
+				push_events = true
+			})
+
+			drift_attributes = ["url", "push_events"]
+		}
+		`, project.ID, url)
+	}
+
+	var hookID int
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			_, _, err := testutil.TestGitlabClient.Projects.GetProjectHook(project.ID, hookID)
+			if err == nil {
+				return fmt.Errorf("project hook %d still exists", hookID)
+			}
+			if !api.Is404(err) {
+				return err
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create the hook
+			{
+				Config: config("https://example.com/hook"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_api_resource.this", "id"),
+					resource.TestCheckResourceAttrSet("gitlab_api_resource.this", "response"),
+					func(s *terraform.State) error {
+						id, err := strconv.Atoi(s.RootModule().Resources["gitlab_api_resource.this"].Primary.ID)
+						if err != nil {
+							return err
+						}
+						hookID = id
+						hook, _, err := testutil.TestGitlabClient.Projects.GetProjectHook(project.ID, hookID)
+						if err != nil {
+							return err
+						}
+						if hook.URL != "https://example.com/hook" {
+							return fmt.Errorf("got hook url %q", hook.URL)
+						}
+						return nil
+					},
+				),
+			},
+			// Detect drift of a drift attribute
+			{
+				PreConfig: func() {
+					if _, _, err := testutil.TestGitlabClient.Projects.EditProjectHook(project.ID, hookID, &gitlab.EditProjectHookOptions{
+						URL: gitlab.String("https://example.com/drifted"),
+					}); err != nil {
+						t.Fatalf("failed to modify project hook: %v", err)
+					}
+				},
+				Config:             config("https://example.com/hook"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Reconcile the drift and update the hook in-place
+			{
+				Config: config("https://example.com/updated"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["gitlab_api_resource.this"].Primary.ID; id != strconv.Itoa(hookID) {
+							return fmt.Errorf("expected the hook to be updated in-place, got new id %s", id)
+						}
+						hook, _, err := testutil.TestGitlabClient.Projects.GetProjectHook(project.ID, hookID)
+						if err != nil {
+							return err
+						}
+						if hook.URL != "https://example.com/updated" {
+							return fmt.Errorf("got hook url %q", hook.URL)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
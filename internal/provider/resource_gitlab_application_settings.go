@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &gitlabApplicationSettingsResource{}
+var _ resource.ResourceWithConfigure = &gitlabApplicationSettingsResource{}
+
+func init() {
+	registerResource(NewGitLabApplicationSettingsResource)
+}
+
+const applicationSettingsID = "gitlab"
+
+// NewGitLabApplicationSettingsResource is a helper function to simplify the provider implementation.
+func NewGitLabApplicationSettingsResource() resource.Resource {
+	return &gitlabApplicationSettingsResource{}
+}
+
+// gitlabApplicationSettingsResource defines the resource implementation.
+type gitlabApplicationSettingsResource struct {
+	client *gitlab.Client
+}
+
+func (r *gitlabApplicationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_settings"
+}
+
+func (r *gitlabApplicationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`" + `gitlab_application_settings` + "`" + ` resource allows to manage the GitLab application settings.
+
+~> This is an **experimental resource**. By nature it doesn't properly fit into how Terraform resources are meant to work.
+   Feel free to join the [discussion](https://gitlab.com/gitlab-org/terraform-provider-gitlab/issues/957) if you have any
+   ideas or questions regarding this resource.
+
+~> All ` + "`" + `gitlab_application_settings` + "`" + ` use the same ID ` + "`" + `gitlab` + "`" + `.
+
+!> This resource does not implement any destroy logic, it's a no-op at this point.
+   It's also not possible to revert to the previous settings.
+
+-> Requires at administrative privileges on GitLab.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/settings.html)`,
+		Attributes: gitlabApplicationSettingsAttributes(),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabApplicationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*api.ProviderData).Client
+}
+
+// Create updates the configured application settings and adds them into the Terraform state.
+func (r *gitlabApplicationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *gitlabApplicationSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no prior state, thus every configured setting is updated.
+	resp.Diagnostics.Append(r.updateSettings(ctx, plan, &gitlabApplicationSettingsResourceModel{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabApplicationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabApplicationSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.ValueString() != applicationSettingsID {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			fmt.Sprintf("The `gitlab_application_settings` resource can only exist once and requires the id to be `%s`", applicationSettingsID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.readIntoModel(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the application settings which are planned to change.
+func (r *gitlabApplicationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *gitlabApplicationSettingsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateSettings(ctx, plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the Terraform state.
+// The application settings can't be deleted nor reverted to their previous values.
+func (r *gitlabApplicationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "destroying the application settings does not yet do anything")
+}
+
+// updateSettings updates the settings which differ between the plan and the state and reads the result into the plan.
+func (r *gitlabApplicationSettingsResource) updateSettings(ctx context.Context, plan *gitlabApplicationSettingsResourceModel, state *gitlabApplicationSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	options, d := expandUpdateSettingsOptions(ctx, plan, state)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if (gitlab.UpdateSettingsOptions{}) != *options {
+		tflog.Debug(ctx, "update GitLab application settings")
+		if _, _, err := r.client.Settings.UpdateSettings(options, gitlab.WithContext(ctx)); err != nil {
+			diags.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update application settings: %s", err.Error()))
+			return diags
+		}
+	}
+
+	plan.Id = types.StringValue(applicationSettingsID)
+	diags.Append(r.readIntoModel(ctx, plan)...)
+	return diags
+}
+
+func (r *gitlabApplicationSettingsResource) readIntoModel(ctx context.Context, data *gitlabApplicationSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "read GitLab application settings")
+	settings, _, err := r.client.Settings.GetSettings(gitlab.WithContext(ctx))
+	if err != nil {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read application settings: %s", err.Error()))
+		return diags
+	}
+
+	diags.Append(applicationSettingsToStateModel(ctx, settings, data)...)
+	return diags
+}
@@ -0,0 +1,67 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGitlabApplicationSettings_basic(t *testing.T) {
+	// lintignore:AT001
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify empty application settings
+			{
+				Config: `
+					resource "gitlab_application_settings" "this" {}
+				`,
+			},
+			// Verify changing some application settings
+			{
+				Config: `
+					resource "gitlab_application_settings" "this" {
+						after_sign_up_text = "Welcome to GitLab!"
+					}
+				`,
+			},
+			// Verify that settings which are no longer configured keep their value
+			{
+				Config: `
+					resource "gitlab_application_settings" "this" {}
+				`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAcc_GitlabApplicationSettings_UpgradeFromSDKToFramework(t *testing.T) {
+	config := `
+	resource "gitlab_application_settings" "this" {
+		after_sign_up_text = "Welcome to GitLab!"
+	}`
+
+	// lintignore:AT001
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"gitlab": {
+						VersionConstraint: "~> 15.7.1",
+						Source:            "gitlabhq/gitlab",
+					},
+				},
+				Config: config,
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config:                   config,
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
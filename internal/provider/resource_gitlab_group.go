@@ -0,0 +1,726 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &gitlabGroupResource{}
+var _ resource.ResourceWithConfigure = &gitlabGroupResource{}
+var _ resource.ResourceWithImportState = &gitlabGroupResource{}
+var _ resource.ResourceWithModifyPlan = &gitlabGroupResource{}
+
+func init() {
+	registerResource(NewGitLabGroupResource)
+}
+
+var (
+	validGroupVisibilityLevels = []string{
+		"private",
+		"internal",
+		"public",
+	}
+	validGroupProjectCreationLevels = []string{
+		"noone",
+		"maintainer",
+		"developer",
+	}
+	validGroupSubGroupCreationLevels = []string{
+		"owner",
+		"maintainer",
+	}
+)
+
+// groupDependentAttributes are attributes which are changed upstream when another attribute is changed.
+// If such an attribute isn't configured, its planned value is unknown instead of the value in the state.
+var groupDependentAttributes = map[string][]string{
+	"name":      {"full_name"},
+	"path":      {"full_path", "web_url"},
+	"parent_id": {"full_name", "full_path", "web_url", "visibility_level"},
+}
+
+// NewGitLabGroupResource is a helper function to simplify the provider implementation.
+func NewGitLabGroupResource() resource.Resource {
+	return &gitlabGroupResource{}
+}
+
+// gitlabGroupResource defines the resource implementation.
+type gitlabGroupResource struct {
+	client *gitlab.Client
+}
+
+// gitlabGroupResourceModel describes the resource data model.
+type gitlabGroupResourceModel struct {
+	Id                             types.String `tfsdk:"id"`
+	Name                           types.String `tfsdk:"name"`
+	Path                           types.String `tfsdk:"path"`
+	FullPath                       types.String `tfsdk:"full_path"`
+	FullName                       types.String `tfsdk:"full_name"`
+	WebUrl                         types.String `tfsdk:"web_url"`
+	Description                    types.String `tfsdk:"description"`
+	LfsEnabled                     types.Bool   `tfsdk:"lfs_enabled"`
+	DefaultBranchProtection        types.Int64  `tfsdk:"default_branch_protection"`
+	RequestAccessEnabled           types.Bool   `tfsdk:"request_access_enabled"`
+	VisibilityLevel                types.String `tfsdk:"visibility_level"`
+	ShareWithGroupLock             types.Bool   `tfsdk:"share_with_group_lock"`
+	ProjectCreationLevel           types.String `tfsdk:"project_creation_level"`
+	AutoDevopsEnabled              types.Bool   `tfsdk:"auto_devops_enabled"`
+	EmailsDisabled                 types.Bool   `tfsdk:"emails_disabled"`
+	MentionsDisabled               types.Bool   `tfsdk:"mentions_disabled"`
+	SubgroupCreationLevel          types.String `tfsdk:"subgroup_creation_level"`
+	RequireTwoFactorAuthentication types.Bool   `tfsdk:"require_two_factor_authentication"`
+	TwoFactorGracePeriod           types.Int64  `tfsdk:"two_factor_grace_period"`
+	ParentId                       types.Int64  `tfsdk:"parent_id"`
+	RunnersToken                   types.String `tfsdk:"runners_token"`
+	PreventForkingOutsideGroup     types.Bool   `tfsdk:"prevent_forking_outside_group"`
+	MembershipLock                 types.Bool   `tfsdk:"membership_lock"`
+	ExtraSharedRunnersMinutesLimit types.Int64  `tfsdk:"extra_shared_runners_minutes_limit"`
+	SharedRunnersMinutesLimit      types.Int64  `tfsdk:"shared_runners_minutes_limit"`
+	IpRestrictionRanges            types.List   `tfsdk:"ip_restriction_ranges"`
+	Avatar                         types.String `tfsdk:"avatar"`
+	AvatarHash                     types.String `tfsdk:"avatar_hash"`
+	AvatarUrl                      types.String `tfsdk:"avatar_url"`
+}
+
+func (r *gitlabGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *gitlabGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the group.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of this group.",
+			Required:            true,
+		},
+		"path": schema.StringAttribute{
+			MarkdownDescription: "The path of the group.",
+			Required:            true,
+		},
+		"full_path": schema.StringAttribute{
+			MarkdownDescription: "The full path of the group.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"full_name": schema.StringAttribute{
+			MarkdownDescription: "The full name of the group.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"web_url": schema.StringAttribute{
+			MarkdownDescription: "Web URL of the group.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the group.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"lfs_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Defaults to true. Enable/disable Large File Storage (LFS) for the projects in this group.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(true)},
+		},
+		"default_branch_protection": schema.Int64Attribute{
+			MarkdownDescription: "Defaults to 2. See https://docs.gitlab.com/ee/api/groups.html#options-for-default_branch_protection",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{defaultInt64(2)},
+			Validators:          []validator.Int64{int64validator.OneOf(0, 1, 2, 3)},
+		},
+		"request_access_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Defaults to false. Allow users to request member access.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"visibility_level": schema.StringAttribute{
+			MarkdownDescription: "The group's visibility. Can be `private`, `internal`, or `public`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validGroupVisibilityLevels...)},
+		},
+		"share_with_group_lock": schema.BoolAttribute{
+			MarkdownDescription: "Defaults to false. Prevent sharing a project with another group within this group.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"project_creation_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Defaults to maintainer. Determine if developers can create projects in the group. Valid values are %s.", utils.RenderValueListForDocs(validGroupProjectCreationLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("maintainer")},
+			Validators:          []validator.String{stringvalidator.OneOf(validGroupProjectCreationLevels...)},
+		},
+		"auto_devops_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Defaults to false. Default to Auto DevOps pipeline for all projects within this group.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"emails_disabled": schema.BoolAttribute{
+			MarkdownDescription: "Defaults to false. Disable email notifications.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"mentions_disabled": schema.BoolAttribute{
+			MarkdownDescription: "Defaults to false. Disable the capability of a group from getting mentioned.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"subgroup_creation_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Defaults to owner. Allowed to create subgroups. Valid values are %s.", utils.RenderValueListForDocs(validGroupSubGroupCreationLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("owner")},
+			Validators:          []validator.String{stringvalidator.OneOf(validGroupSubGroupCreationLevels...)},
+		},
+		"require_two_factor_authentication": schema.BoolAttribute{
+			MarkdownDescription: "Defaults to false. Require all users in this group to setup Two-factor authentication.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"two_factor_grace_period": schema.Int64Attribute{
+			MarkdownDescription: "Defaults to 48. Time before Two-factor authentication is enforced (in hours).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{defaultInt64(48)},
+		},
+		"parent_id": schema.Int64Attribute{
+			MarkdownDescription: "Id of the parent group (creates a nested group).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{defaultInt64(0)},
+		},
+		"runners_token": schema.StringAttribute{
+			MarkdownDescription: "The group level registration token to use during runner setup.",
+			Computed:            true,
+			Sensitive:           true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"prevent_forking_outside_group": schema.BoolAttribute{
+			MarkdownDescription: "Defaults to false. When enabled, users can not fork projects from this group to external namespaces.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"membership_lock": schema.BoolAttribute{
+			MarkdownDescription: "Users cannot be added to projects in this group.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"extra_shared_runners_minutes_limit": schema.Int64Attribute{
+			MarkdownDescription: "Can be set by administrators only. Additional CI/CD minutes for this group.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"shared_runners_minutes_limit": schema.Int64Attribute{
+			MarkdownDescription: "Can be set by administrators only. Maximum number of monthly CI/CD minutes for this group. Can be nil (default; inherit system default), 0 (unlimited), or > 0.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"ip_restriction_ranges": schema.ListAttribute{
+			MarkdownDescription: "A list of IP addresses or subnet masks to restrict group access. Will be concatenated together into a comma separated string. Only allowed on top level groups.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{defaultList(types.ListValueMust(types.StringType, nil))},
+		},
+	}
+	for name, attribute := range avatarableAttributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_group`" + ` resource allows to manage the lifecycle of a group.
+
+-> On GitLab SaaS, you must use the GitLab UI to create groups without a parent group. You cannot use this provider nor the API to do this.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html)`,
+		Attributes: attributes,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*api.ProviderData).Client
+}
+
+// ModifyPlan marks the attributes as unknown which are changed upstream as a side effect of another change.
+func (r *gitlabGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyDependentAttributesPlan(ctx, groupDependentAttributes, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifyAvatarablePlan(ctx, req, resp)
+}
+
+// Create creates a new upstream resources and adds it into the Terraform state.
+func (r *gitlabGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *gitlabGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, diags := expandCreateGroupOptions(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "create gitlab group", map[string]interface{}{"name": *options.Name})
+	group, _, err := r.client.Groups.CreateGroup(options, gitlab.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to create group: %s", err.Error()))
+		return
+	}
+
+	// from this point onwards no matter how we return, resource creation
+	// is committed to state since we set its ID
+	groupID := strconv.Itoa(group.ID)
+	plan.Id = types.StringValue(groupID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Some attributes can only be set on update.
+	var updateOptions gitlab.UpdateGroupOptions
+	if attributeConfigured(plan.PreventForkingOutsideGroup) {
+		updateOptions.PreventForkingOutsideGroup = gitlab.Bool(plan.PreventForkingOutsideGroup.ValueBool())
+	}
+	if attributeConfigured(plan.IpRestrictionRanges) && len(plan.IpRestrictionRanges.Elements()) > 0 {
+		ipRestrictionRanges, diags := ipRestrictionRangesValue(ctx, plan.IpRestrictionRanges)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateOptions.IPRestrictionRanges = ipRestrictionRanges
+	}
+	if (updateOptions != gitlab.UpdateGroupOptions{}) {
+		if _, _, err := r.client.Groups.UpdateGroup(groupID, &updateOptions, gitlab.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update group %q after creation: %s", groupID, err.Error()))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.readIntoModel(ctx, groupID, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := data.Id.ValueString()
+	tflog.Debug(ctx, "read gitlab group", map[string]interface{}{"group": groupID})
+	group, _, err := r.client.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "group does not exist, removing from state", map[string]interface{}{"group": groupID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read group details: %s", err.Error()))
+		return
+	}
+	if group.MarkedForDeletionOn != nil {
+		tflog.Debug(ctx, "group is marked for deletion, removing from state", map[string]interface{}{"group": groupID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(groupToStateModel(ctx, group, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource in-place.
+func (r *gitlabGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *gitlabGroupResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := state.Id.ValueString()
+
+	options, diags := expandUpdateGroupOptions(ctx, plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	avatar, err := avatarToUpload(plan.Avatar, plan.AvatarHash, state.Avatar, state.AvatarHash)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read avatar", err.Error())
+		return
+	}
+	if avatar != nil {
+		options.Avatar = &gitlab.GroupAvatar{Filename: avatar.Filename, Image: avatar.Image}
+	}
+
+	tflog.Debug(ctx, "update gitlab group", map[string]interface{}{"group": groupID})
+	if _, _, err := r.client.Groups.UpdateGroup(groupID, options, gitlab.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update group %q: %s", groupID, err.Error()))
+		return
+	}
+
+	if attributeChanged(plan.ParentId, state.ParentId) {
+		parentID := int(plan.ParentId.ValueInt64())
+		transferOptions := &gitlab.TransferSubGroupOptions{}
+		if parentID != 0 {
+			tflog.Debug(ctx, "transfer gitlab group to new parent group", map[string]interface{}{"group": groupID, "parent_id": parentID})
+			transferOptions.GroupID = gitlab.Int(parentID)
+		} else {
+			tflog.Debug(ctx, "turn gitlab group into a top-level group", map[string]interface{}{"group": groupID})
+		}
+
+		if _, _, err := r.client.Groups.TransferSubGroup(groupID, transferOptions, gitlab.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to transfer group %q to new parent group %d: %s", groupID, parentID, err.Error()))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.readIntoModel(ctx, groupID, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource.
+func (r *gitlabGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := data.Id.ValueString()
+
+	tflog.Debug(ctx, "delete gitlab group", map[string]interface{}{"group": groupID})
+	if _, err := r.client.Groups.DeleteGroup(groupID, gitlab.WithContext(ctx)); err != nil && !strings.Contains(err.Error(), "Group has been already marked for deletion") {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete group %q: %s", groupID, err.Error()))
+		return
+	}
+
+	// Wait for the group to be deleted.
+	// Deleting a group in gitlab is async.
+	err := waitFor(ctx, 10*time.Minute, 3*time.Second, func() (bool, error) {
+		group, _, err := r.client.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			if api.Is404(err) {
+				return true, nil
+			}
+			return false, err
+		}
+
+		// Represents a Gitlab EE soft-delete
+		return group.MarkedForDeletionOn != nil, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Error waiting for group %q to become deleted: %s", groupID, err.Error()))
+	}
+}
+
+// ImportState imports the resource into the Terraform state.
+// The group can be imported by its ID or its full path, which is resolved to the ID.
+func (r *gitlabGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, err := api.LookupGroupID(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to import group %q: %s", req.ID, err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), groupID)...)
+}
+
+func (r *gitlabGroupResource) readIntoModel(ctx context.Context, groupID string, data *gitlabGroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	group, _, err := r.client.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read group details: %s", err.Error()))
+		return diags
+	}
+
+	diags.Append(groupToStateModel(ctx, group, data)...)
+	return diags
+}
+
+// groupToStateModel sets the attributes of the group in the model.
+// Attributes which aren't returned by the API are kept, unless they are unknown.
+func groupToStateModel(ctx context.Context, group *gitlab.Group, data *gitlabGroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(strconv.Itoa(group.ID))
+	data.Name = types.StringValue(group.Name)
+	data.Path = types.StringValue(group.Path)
+	data.FullPath = types.StringValue(group.FullPath)
+	data.FullName = types.StringValue(group.FullName)
+	data.WebUrl = types.StringValue(group.WebURL)
+	data.Description = types.StringValue(group.Description)
+	data.LfsEnabled = types.BoolValue(group.LFSEnabled)
+	data.DefaultBranchProtection = types.Int64Value(int64(group.DefaultBranchProtection))
+	data.RequestAccessEnabled = types.BoolValue(group.RequestAccessEnabled)
+	data.VisibilityLevel = types.StringValue(string(group.Visibility))
+	data.ShareWithGroupLock = types.BoolValue(group.ShareWithGroupLock)
+	data.ProjectCreationLevel = types.StringValue(string(group.ProjectCreationLevel))
+	data.AutoDevopsEnabled = types.BoolValue(group.AutoDevopsEnabled)
+	data.EmailsDisabled = types.BoolValue(group.EmailsDisabled)
+	data.MentionsDisabled = types.BoolValue(group.MentionsDisabled)
+	data.SubgroupCreationLevel = types.StringValue(string(group.SubGroupCreationLevel))
+	data.RequireTwoFactorAuthentication = types.BoolValue(group.RequireTwoFactorAuth)
+	data.TwoFactorGracePeriod = types.Int64Value(int64(group.TwoFactorGracePeriod))
+	data.ParentId = types.Int64Value(int64(group.ParentID))
+	data.RunnersToken = types.StringValue(group.RunnersToken)
+	data.PreventForkingOutsideGroup = types.BoolValue(group.PreventForkingOutsideGroup)
+	data.MembershipLock = types.BoolValue(group.MembershipLock)
+	data.ExtraSharedRunnersMinutesLimit = types.Int64Value(int64(group.ExtraSharedRunnersMinutesLimit))
+	data.SharedRunnersMinutesLimit = types.Int64Value(int64(group.SharedRunnersMinutesLimit))
+	data.AvatarUrl = types.StringValue(group.AvatarURL)
+
+	// The value comes back from the API as a comma separated string.
+	// An empty string must result in an empty list, not in a list with an empty string.
+	ipRestrictionRanges := []string{}
+	if group.IPRestrictionRanges != "" {
+		ipRestrictionRanges = strings.Split(group.IPRestrictionRanges, ",")
+	}
+	var d diag.Diagnostics
+	data.IpRestrictionRanges, d = types.ListValueFrom(ctx, types.StringType, ipRestrictionRanges)
+	diags.Append(d...)
+
+	// The avatar hash isn't returned by the API.
+	// It has a default value, so that it doesn't produce a diff after an import.
+	if data.AvatarHash.IsNull() || data.AvatarHash.IsUnknown() {
+		data.AvatarHash = types.StringValue("")
+	}
+
+	return diags
+}
+
+// expandCreateGroupOptions returns the options to create the group with the planned attributes.
+// The attributes with a default value are always sent, so that the instance defaults don't apply.
+func expandCreateGroupOptions(ctx context.Context, plan *gitlabGroupResourceModel) (*gitlab.CreateGroupOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	options := &gitlab.CreateGroupOptions{
+		Name: gitlab.String(plan.Name.ValueString()),
+		Path: gitlab.String(plan.Path.ValueString()),
+	}
+
+	if attributeConfigured(plan.Description) {
+		options.Description = gitlab.String(plan.Description.ValueString())
+	}
+	if attributeConfigured(plan.VisibilityLevel) {
+		options.Visibility = gitlab.Visibility(gitlab.VisibilityValue(plan.VisibilityLevel.ValueString()))
+	}
+	if attributeConfigured(plan.ShareWithGroupLock) {
+		options.ShareWithGroupLock = gitlab.Bool(plan.ShareWithGroupLock.ValueBool())
+	}
+	if attributeConfigured(plan.LfsEnabled) {
+		options.LFSEnabled = gitlab.Bool(plan.LfsEnabled.ValueBool())
+	}
+	if attributeConfigured(plan.RequestAccessEnabled) {
+		options.RequestAccessEnabled = gitlab.Bool(plan.RequestAccessEnabled.ValueBool())
+	}
+	if attributeConfigured(plan.RequireTwoFactorAuthentication) {
+		options.RequireTwoFactorAuth = gitlab.Bool(plan.RequireTwoFactorAuthentication.ValueBool())
+	}
+	if attributeConfigured(plan.TwoFactorGracePeriod) {
+		options.TwoFactorGracePeriod = gitlab.Int(int(plan.TwoFactorGracePeriod.ValueInt64()))
+	}
+	if attributeConfigured(plan.ProjectCreationLevel) {
+		options.ProjectCreationLevel = gitlab.ProjectCreationLevel(gitlab.ProjectCreationLevelValue(plan.ProjectCreationLevel.ValueString()))
+	}
+	if attributeConfigured(plan.AutoDevopsEnabled) {
+		options.AutoDevopsEnabled = gitlab.Bool(plan.AutoDevopsEnabled.ValueBool())
+	}
+	if attributeConfigured(plan.SubgroupCreationLevel) {
+		options.SubGroupCreationLevel = gitlab.SubGroupCreationLevel(gitlab.SubGroupCreationLevelValue(plan.SubgroupCreationLevel.ValueString()))
+	}
+	if attributeConfigured(plan.EmailsDisabled) {
+		options.EmailsDisabled = gitlab.Bool(plan.EmailsDisabled.ValueBool())
+	}
+	if attributeConfigured(plan.MentionsDisabled) {
+		options.MentionsDisabled = gitlab.Bool(plan.MentionsDisabled.ValueBool())
+	}
+	if attributeConfigured(plan.ParentId) && plan.ParentId.ValueInt64() != 0 {
+		options.ParentID = gitlab.Int(int(plan.ParentId.ValueInt64()))
+	}
+	if attributeConfigured(plan.DefaultBranchProtection) {
+		options.DefaultBranchProtection = gitlab.Int(int(plan.DefaultBranchProtection.ValueInt64()))
+	}
+	if attributeConfigured(plan.MembershipLock) {
+		options.MembershipLock = gitlab.Bool(plan.MembershipLock.ValueBool())
+	}
+	if attributeConfigured(plan.ExtraSharedRunnersMinutesLimit) {
+		options.ExtraSharedRunnersMinutesLimit = gitlab.Int(int(plan.ExtraSharedRunnersMinutesLimit.ValueInt64()))
+	}
+	if attributeConfigured(plan.SharedRunnersMinutesLimit) {
+		options.SharedRunnersMinutesLimit = gitlab.Int(int(plan.SharedRunnersMinutesLimit.ValueInt64()))
+	}
+
+	avatar, err := avatarToUpload(plan.Avatar, plan.AvatarHash, types.StringNull(), types.StringNull())
+	if err != nil {
+		diags.AddError("Unable to read avatar", err.Error())
+		return nil, diags
+	}
+	if avatar != nil {
+		options.Avatar = &gitlab.GroupAvatar{Filename: avatar.Filename, Image: avatar.Image}
+	}
+
+	return options, diags
+}
+
+// expandUpdateGroupOptions returns the options to update the attributes of the group which are planned to change.
+// The avatar and the parent group are updated separately.
+func expandUpdateGroupOptions(ctx context.Context, plan *gitlabGroupResourceModel, state *gitlabGroupResourceModel) (*gitlab.UpdateGroupOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	options := &gitlab.UpdateGroupOptions{}
+
+	if attributeChanged(plan.Name, state.Name) {
+		options.Name = gitlab.String(plan.Name.ValueString())
+	}
+	if attributeChanged(plan.Path, state.Path) {
+		options.Path = gitlab.String(plan.Path.ValueString())
+	}
+	if attributeChanged(plan.Description, state.Description) {
+		options.Description = gitlab.String(plan.Description.ValueString())
+	}
+	if attributeChanged(plan.LfsEnabled, state.LfsEnabled) {
+		options.LFSEnabled = gitlab.Bool(plan.LfsEnabled.ValueBool())
+	}
+	if attributeChanged(plan.RequestAccessEnabled, state.RequestAccessEnabled) {
+		options.RequestAccessEnabled = gitlab.Bool(plan.RequestAccessEnabled.ValueBool())
+	}
+	// Always set visibility ; workaround for
+	// https://gitlab.com/gitlab-org/gitlab-ce/issues/38459
+	if attributeConfigured(plan.VisibilityLevel) {
+		options.Visibility = gitlab.Visibility(gitlab.VisibilityValue(plan.VisibilityLevel.ValueString()))
+	}
+	if attributeChanged(plan.ProjectCreationLevel, state.ProjectCreationLevel) {
+		options.ProjectCreationLevel = gitlab.ProjectCreationLevel(gitlab.ProjectCreationLevelValue(plan.ProjectCreationLevel.ValueString()))
+	}
+	if attributeChanged(plan.SubgroupCreationLevel, state.SubgroupCreationLevel) {
+		options.SubGroupCreationLevel = gitlab.SubGroupCreationLevel(gitlab.SubGroupCreationLevelValue(plan.SubgroupCreationLevel.ValueString()))
+	}
+	if attributeChanged(plan.RequireTwoFactorAuthentication, state.RequireTwoFactorAuthentication) {
+		options.RequireTwoFactorAuth = gitlab.Bool(plan.RequireTwoFactorAuthentication.ValueBool())
+	}
+	if attributeChanged(plan.TwoFactorGracePeriod, state.TwoFactorGracePeriod) {
+		options.TwoFactorGracePeriod = gitlab.Int(int(plan.TwoFactorGracePeriod.ValueInt64()))
+	}
+	if attributeChanged(plan.AutoDevopsEnabled, state.AutoDevopsEnabled) {
+		options.AutoDevopsEnabled = gitlab.Bool(plan.AutoDevopsEnabled.ValueBool())
+	}
+	if attributeChanged(plan.EmailsDisabled, state.EmailsDisabled) {
+		options.EmailsDisabled = gitlab.Bool(plan.EmailsDisabled.ValueBool())
+	}
+	if attributeChanged(plan.MentionsDisabled, state.MentionsDisabled) {
+		options.MentionsDisabled = gitlab.Bool(plan.MentionsDisabled.ValueBool())
+	}
+	if attributeChanged(plan.ShareWithGroupLock, state.ShareWithGroupLock) {
+		options.ShareWithGroupLock = gitlab.Bool(plan.ShareWithGroupLock.ValueBool())
+	}
+	if attributeChanged(plan.DefaultBranchProtection, state.DefaultBranchProtection) {
+		options.DefaultBranchProtection = gitlab.Int(int(plan.DefaultBranchProtection.ValueInt64()))
+	}
+	if attributeChanged(plan.PreventForkingOutsideGroup, state.PreventForkingOutsideGroup) {
+		options.PreventForkingOutsideGroup = gitlab.Bool(plan.PreventForkingOutsideGroup.ValueBool())
+	}
+	if attributeChanged(plan.MembershipLock, state.MembershipLock) {
+		options.MembershipLock = gitlab.Bool(plan.MembershipLock.ValueBool())
+	}
+	if attributeChanged(plan.ExtraSharedRunnersMinutesLimit, state.ExtraSharedRunnersMinutesLimit) {
+		options.ExtraSharedRunnersMinutesLimit = gitlab.Int(int(plan.ExtraSharedRunnersMinutesLimit.ValueInt64()))
+	}
+	if attributeChanged(plan.SharedRunnersMinutesLimit, state.SharedRunnersMinutesLimit) {
+		options.SharedRunnersMinutesLimit = gitlab.Int(int(plan.SharedRunnersMinutesLimit.ValueInt64()))
+	}
+	if attributeChanged(plan.IpRestrictionRanges, state.IpRestrictionRanges) {
+		var d diag.Diagnostics
+		options.IPRestrictionRanges, d = ipRestrictionRangesValue(ctx, plan.IpRestrictionRanges)
+		diags.Append(d...)
+	}
+
+	return options, diags
+}
+
+// ipRestrictionRangesValue returns the IP restriction ranges as the comma separated string expected by the API.
+func ipRestrictionRangesValue(ctx context.Context, list types.List) (*string, diag.Diagnostics) {
+	ranges := make([]string, 0, len(list.Elements()))
+	diags := list.ElementsAs(ctx, &ranges, false)
+	return gitlab.String(strings.Join(ranges, ",")), diags
+}
@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &gitlabGroupProtectedEnvironmentResource{}
+var _ resource.ResourceWithConfigure = &gitlabGroupProtectedEnvironmentResource{}
+var _ resource.ResourceWithImportState = &gitlabGroupProtectedEnvironmentResource{}
+var _ resource.ResourceWithModifyPlan = &gitlabGroupProtectedEnvironmentResource{}
+
+// validGroupProtectedEnvironmentTiers are the deployment tiers of the environments, which are protected at group level.
+var validGroupProtectedEnvironmentTiers = []string{"production", "staging", "testing", "development", "other"}
+
+func init() {
+	registerResource(NewGitLabGroupProtectedEnvironmentResource)
+}
+
+// NewGitLabGroupProtectedEnvironmentResource is a helper function to simplify the provider implementation.
+func NewGitLabGroupProtectedEnvironmentResource() resource.Resource {
+	return &gitlabGroupProtectedEnvironmentResource{}
+}
+
+// gitlabGroupProtectedEnvironmentResource defines the resource implementation.
+type gitlabGroupProtectedEnvironmentResource struct {
+	client       *gitlab.Client
+	providerData *api.ProviderData
+}
+
+// gitlabGroupProtectedEnvironmentResourceModel describes the resource data model.
+type gitlabGroupProtectedEnvironmentResourceModel struct {
+	Id                    types.String                                              `tfsdk:"id"`
+	Group                 types.String                                              `tfsdk:"group"`
+	Environment           types.String                                              `tfsdk:"environment"`
+	RequiredApprovalCount types.Int64                                               `tfsdk:"required_approval_count"`
+	DeployAccessLevels    []gitlabProjectProtectedEnvironmentDeployAccessLevelModel `tfsdk:"deploy_access_levels"`
+}
+
+func (r *gitlabGroupProtectedEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_protected_environment"
+}
+
+func (r *gitlabGroupProtectedEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_group_protected_environment`" + ` resource allows to manage the lifecycle of a protected environment in a group.
+The protection applies to the environments of the deployment tier in all projects of the group and its subgroups.
+
+-> This resource requires a GitLab Premium or Ultimate instance and a top-level group.
+
+~> In order to use a group in the ` + "`deploy_access_levels`" + ` configuration, it must be a subgroup of the group.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_protected_environments.html)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of this Terraform resource. In the format of `<group>:<environment-name>`.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The ID or full path of the group which the protected environment is created against.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The deployment tier of the environments. Valid values are %s.", utils.RenderValueListForDocs(validGroupProtectedEnvironmentTiers)),
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.OneOf(validGroupProtectedEnvironmentTiers...)},
+			},
+			"required_approval_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of approvals required to deploy to the environments.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"deploy_access_levels": schema.SetNestedBlock{
+				MarkdownDescription: "Array of access levels allowed to deploy, with each described by a hash.",
+				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace(), setplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"access_level": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("Levels of access required to deploy to the protected environments. Valid values are %s.", utils.RenderValueListForDocs(api.ValidProtectedEnvironmentDeploymentLevelNames)),
+							Optional:            true,
+							PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("user_id"), path.MatchRelative().AtParent().AtName("group_id")),
+								stringvalidator.OneOfCaseInsensitive(api.ValidProtectedEnvironmentDeploymentLevelNames...),
+							},
+						},
+						"access_level_description": schema.StringAttribute{
+							MarkdownDescription: "Readable description of level of access.",
+							Computed:            true,
+							PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						},
+						"user_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the user allowed to deploy to the protected environments. The user must be a member of the group.",
+							Optional:            true,
+							PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+							Validators:          []validator.Int64{int64validator.AtLeast(1)},
+						},
+						"group_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the subgroup allowed to deploy to the protected environments.",
+							Optional:            true,
+							PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+							Validators:          []validator.Int64{int64validator.AtLeast(1)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabGroupProtectedEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.providerData = req.ProviderData.(*api.ProviderData)
+	r.client = r.providerData.Client
+}
+
+// ModifyPlan fails the plan if the GitLab instance doesn't support the resource.
+func (r *gitlabGroupProtectedEnvironmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRequireFeature(ctx, r.providerData, req, resp, "group_protected_environments", "gitlab_group_protected_environment")
+}
+
+// Create creates a new upstream resources and adds it into the Terraform state.
+func (r *gitlabGroupProtectedEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabGroupProtectedEnvironmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// local copies of plan arguments
+	groupID := data.Group.ValueString()
+	environmentName := data.Environment.ValueString()
+
+	// configure GitLab API call
+	options := &api.ProtectEnvironmentOptions{
+		Name: gitlab.String(environmentName),
+	}
+
+	if !data.RequiredApprovalCount.IsNull() {
+		options.RequiredApprovalCount = gitlab.Int(int(data.RequiredApprovalCount.ValueInt64()))
+	}
+
+	// deploy access levels
+	deployAccessLevelsOption := expandProtectedEnvironmentDeployAccessLevels(data.DeployAccessLevels)
+	options.DeployAccessLevels = &deployAccessLevelsOption
+
+	// Protect environment
+	protectedEnvironment, _, err := api.ProtectGroupEnvironment(ctx, r.client, groupID, options)
+	if err != nil {
+		if api.Is404(err) {
+			resp.Diagnostics.AddError(
+				"GitLab Feature not available",
+				fmt.Sprintf("The protected environment feature is not available on this group. Make sure it's a top-level group and part of an enterprise plan. Error: %s", err.Error()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to protect environment: %s", err.Error()))
+		return
+	}
+
+	// Create resource ID and persist in state model
+	data.Id = types.StringValue(utils.BuildTwoPartID(&groupID, &protectedEnvironment.Name))
+
+	// persist API response in state model
+	r.protectedEnvironmentToStateModel(groupID, protectedEnvironment, data)
+
+	// Log the creation of the resource
+	tflog.Debug(ctx, "created a group protected environment", map[string]interface{}{
+		"group": groupID, "environment": environmentName,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabGroupProtectedEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabGroupProtectedEnvironmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// read all information for refresh from resource id
+	groupID, environmentName, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<group>:<environment-name>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Read environment protection
+	protectedEnvironment, _, err := api.GetGroupProtectedEnvironment(ctx, r.client, groupID, environmentName)
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "group protected environment does not exist, removing from state", map[string]interface{}{
+				"group": groupID, "environment": environmentName,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read group protected environment details: %s", err.Error()))
+		return
+	}
+
+	// persist API response in state model
+	r.protectedEnvironmentToStateModel(groupID, protectedEnvironment, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Report who changed the protected environment outside of Terraform
+	resp.Diagnostics.Append(driftReportDiagnostics(ctx, r.providerData, req, resp, "gitlab_group_protected_environment", api.AuditedResource{
+		Group:         groupID,
+		TargetType:    "ProtectedEnvironment",
+		TargetDetails: environmentName,
+	}, "required_approval_count", "deploy_access_levels")...)
+}
+
+// Updates updates the resource in-place.
+func (r *gitlabGroupProtectedEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Provider Error, report upstream", "Somehow the resource was requested to perform an in-place upgrade which is not possible.")
+}
+
+// Deletes removes the resource.
+func (r *gitlabGroupProtectedEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabGroupProtectedEnvironmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// read all information for refresh from resource id
+	groupID, environmentName, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<group>:<environment-name>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if _, err = api.UnprotectGroupEnvironment(ctx, r.client, groupID, environmentName); err != nil && !api.Is404(err) {
+		resp.Diagnostics.AddError(
+			"GitLab API Error occurred",
+			fmt.Sprintf("Unable to delete group protected environment: %s", err.Error()),
+		)
+	}
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *gitlabGroupProtectedEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *gitlabGroupProtectedEnvironmentResource) protectedEnvironmentToStateModel(groupID string, protectedEnvironment *api.ProtectedEnvironment, data *gitlabGroupProtectedEnvironmentResourceModel) {
+	data.Group = types.StringValue(groupID)
+	data.Environment = types.StringValue(protectedEnvironment.Name)
+	data.RequiredApprovalCount = types.Int64Value(int64(protectedEnvironment.RequiredApprovalCount))
+	data.DeployAccessLevels = flattenProtectedEnvironmentDeployAccessLevels(protectedEnvironment.DeployAccessLevels)
+}
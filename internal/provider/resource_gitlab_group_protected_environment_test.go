@@ -0,0 +1,93 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAcc_GitlabGroupProtectedEnvironment_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	// Set up a top-level group with a subgroup allowed to deploy.
+	group := testutil.CreateGroups(t, 1)[0]
+	subgroup := testutil.CreateSubGroups(t, group, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabGroupProtectedEnvironment_CheckDestroy(group.ID, "production"),
+		Steps: []resource.TestStep{
+			// Create a basic protected environment.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_group_protected_environment" "this" {
+					group       = %d
+					environment = "production"
+
+					deploy_access_levels {
+						access_level = "developer"
+					}
+				}`, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_group_protected_environment.this", "deploy_access_levels.0.access_level_description"),
+					resource.TestCheckResourceAttr("gitlab_group_protected_environment.this", "required_approval_count", "0"),
+				),
+			},
+			// Verify upstream attributes with an import.
+			{
+				ResourceName:      "gitlab_group_protected_environment.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Replace the deploy access levels
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_group_protected_environment" "this" {
+					group                   = %d
+					environment             = "production"
+					required_approval_count = 1
+
+					deploy_access_levels {
+						access_level = "maintainer"
+					}
+
+					deploy_access_levels {
+						group_id = %d
+					}
+				}`, group.ID, subgroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_protected_environment.this", "deploy_access_levels.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_group_protected_environment.this", "required_approval_count", "1"),
+				),
+			},
+			// Verify upstream attributes with an import.
+			{
+				ResourceName:      "gitlab_group_protected_environment.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAcc_GitlabGroupProtectedEnvironment_CheckDestroy(groupID int, environmentName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, _, err := api.GetGroupProtectedEnvironment(context.Background(), testutil.TestGitlabClient, fmt.Sprint(groupID), environmentName)
+		if err == nil {
+			return errors.New("environment is still protected")
+		}
+		if !api.Is404(err) {
+			return fmt.Errorf("unable to get group protected environment: %w", err)
+		}
+		return nil
+	}
+}
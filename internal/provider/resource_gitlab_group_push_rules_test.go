@@ -0,0 +1,104 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAcc_GitlabGroupPushRules_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	group := testutil.CreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabGroupPushRules_CheckDestroy(group.ID),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_push_rules" "this" {
+						group              = %d
+						author_email_regex = "@example\\.com$"
+						member_check       = true
+					}`, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_push_rules.this", "id", fmt.Sprintf("%d", group.ID)),
+					resource.TestCheckResourceAttr("gitlab_group_push_rules.this", "author_email_regex", "@example\\.com$"),
+					resource.TestCheckResourceAttr("gitlab_group_push_rules.this", "member_check", "true"),
+					resource.TestCheckResourceAttr("gitlab_group_push_rules.this", "prevent_secrets", "false"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_push_rules.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Unconfigured attributes are reset to their defaults
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_push_rules" "this" {
+						group           = %d
+						prevent_secrets = true
+					}`, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_push_rules.this", "author_email_regex", ""),
+					resource.TestCheckResourceAttr("gitlab_group_push_rules.this", "member_check", "false"),
+					resource.TestCheckResourceAttr("gitlab_group_push_rules.this", "prevent_secrets", "true"),
+				),
+			},
+			// Import by the full path of the group
+			{
+				ResourceName:            "gitlab_group_push_rules.this",
+				ImportState:             true,
+				ImportStateId:           group.FullPath,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"group"},
+			},
+		},
+	})
+}
+
+func testAcc_GitlabGroupPushRules_CheckDestroy(groupID int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		pushRules, _, err := testutil.TestGitlabClient.Groups.GetGroupPushRules(groupID)
+		if api.Is404(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if pushRules != nil && pushRules.ID != 0 {
+			return fmt.Errorf("the push rules of group %d still exist", groupID)
+		}
+		return nil
+	}
+}
+
+func TestAcc_GitlabGroupPushRules_requiresPremium(t *testing.T) {
+	group := testutil.CreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: testutil.IsRunningInEE,
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_push_rules" "this" {
+						group        = %d
+						member_check = true
+					}`, group.ID),
+				ExpectError: regexp.MustCompile(`resource "gitlab_group_push_rules" requires GitLab >= 13.4 / Premium`),
+			},
+		},
+	})
+}
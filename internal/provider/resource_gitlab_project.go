@@ -0,0 +1,2178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &gitlabProjectResource{}
+var _ resource.ResourceWithConfigure = &gitlabProjectResource{}
+var _ resource.ResourceWithImportState = &gitlabProjectResource{}
+var _ resource.ResourceWithModifyPlan = &gitlabProjectResource{}
+var _ resource.ResourceWithUpgradeState = &gitlabProjectResource{}
+
+func init() {
+	registerResource(NewGitLabProjectResource)
+}
+
+var (
+	validProjectAccessLevels = []string{
+		"disabled",
+		"private",
+		"enabled",
+	}
+	validProjectAutoCancelPendingPipelinesValues = []string{
+		"enabled",
+		"disabled",
+	}
+	validProjectBuildGitStrategyValues = []string{
+		"clone",
+		"fetch",
+	}
+	validProjectAutoDevOpsDeployStrategyValues = []string{
+		"continuous",
+		"manual",
+		"timed_incremental",
+	}
+	validProjectMergeMethods = []string{
+		"merge",
+		"rebase_merge",
+		"ff",
+	}
+	validProjectVisibilityLevels = []string{
+		"private",
+		"internal",
+		"public",
+	}
+	validProjectSquashOptions = []string{
+		"never",
+		"default_on",
+		"always",
+		"default_off",
+	}
+	validProjectPagesAccessLevels = []string{
+		"public",
+		"private",
+		"enabled",
+		"disabled",
+	}
+	validContainerExpirationPolicyAttributesCadenceValues = []string{
+		"1d", "7d", "14d", "1month", "3month",
+	}
+)
+
+// projectDependentAttributes are attributes which are changed upstream when another attribute is changed.
+// If such an attribute isn't configured, its planned value is unknown instead of the value in the state.
+var projectDependentAttributes = map[string][]string{
+	"path":                            {"path_with_namespace", "ssh_url_to_repo", "http_url_to_repo", "web_url"},
+	"namespace_id":                    {"path_with_namespace", "ssh_url_to_repo", "http_url_to_repo", "web_url", "visibility_level"},
+	"visibility_level":                {"pages_access_level"},
+	"issues_enabled":                  {"issues_access_level"},
+	"issues_access_level":             {"issues_enabled"},
+	"merge_requests_enabled":          {"merge_requests_access_level"},
+	"merge_requests_access_level":     {"merge_requests_enabled"},
+	"pipelines_enabled":               {"builds_access_level"},
+	"builds_access_level":             {"pipelines_enabled"},
+	"wiki_enabled":                    {"wiki_access_level"},
+	"wiki_access_level":               {"wiki_enabled"},
+	"snippets_enabled":                {"snippets_access_level"},
+	"snippets_access_level":           {"snippets_enabled"},
+	"container_registry_enabled":      {"container_registry_access_level"},
+	"container_registry_access_level": {"container_registry_enabled"},
+	"tags":                            {"topics"},
+	"topics":                          {"tags"},
+	"mirror":                          {"mirror_trigger_builds", "mirror_overwrites_diverged_branches", "only_mirror_protected_branches"},
+}
+
+// NewGitLabProjectResource is a helper function to simplify the provider implementation.
+func NewGitLabProjectResource() resource.Resource {
+	return &gitlabProjectResource{}
+}
+
+// gitlabProjectResource defines the resource implementation.
+type gitlabProjectResource struct {
+	client       *gitlab.Client
+	providerData *api.ProviderData
+}
+
+// gitlabProjectResourceModel describes the resource data model.
+type gitlabProjectResourceModel struct {
+	Id                                        types.String `tfsdk:"id"`
+	Name                                      types.String `tfsdk:"name"`
+	Path                                      types.String `tfsdk:"path"`
+	PathWithNamespace                         types.String `tfsdk:"path_with_namespace"`
+	NamespaceId                               types.Int64  `tfsdk:"namespace_id"`
+	Description                               types.String `tfsdk:"description"`
+	DefaultBranch                             types.String `tfsdk:"default_branch"`
+	ImportUrl                                 types.String `tfsdk:"import_url"`
+	RequestAccessEnabled                      types.Bool   `tfsdk:"request_access_enabled"`
+	IssuesEnabled                             types.Bool   `tfsdk:"issues_enabled"`
+	MergeRequestsEnabled                      types.Bool   `tfsdk:"merge_requests_enabled"`
+	PipelinesEnabled                          types.Bool   `tfsdk:"pipelines_enabled"`
+	ApprovalsBeforeMerge                      types.Int64  `tfsdk:"approvals_before_merge"`
+	WikiEnabled                               types.Bool   `tfsdk:"wiki_enabled"`
+	SnippetsEnabled                           types.Bool   `tfsdk:"snippets_enabled"`
+	ContainerRegistryEnabled                  types.Bool   `tfsdk:"container_registry_enabled"`
+	LfsEnabled                                types.Bool   `tfsdk:"lfs_enabled"`
+	VisibilityLevel                           types.String `tfsdk:"visibility_level"`
+	MergeMethod                               types.String `tfsdk:"merge_method"`
+	OnlyAllowMergeIfPipelineSucceeds          types.Bool   `tfsdk:"only_allow_merge_if_pipeline_succeeds"`
+	OnlyAllowMergeIfAllDiscussionsAreResolved types.Bool   `tfsdk:"only_allow_merge_if_all_discussions_are_resolved"`
+	AllowMergeOnSkippedPipeline               types.Bool   `tfsdk:"allow_merge_on_skipped_pipeline"`
+	RestrictUserDefinedVariables              types.Bool   `tfsdk:"restrict_user_defined_variables"`
+	SshUrlToRepo                              types.String `tfsdk:"ssh_url_to_repo"`
+	HttpUrlToRepo                             types.String `tfsdk:"http_url_to_repo"`
+	WebUrl                                    types.String `tfsdk:"web_url"`
+	RunnersToken                              types.String `tfsdk:"runners_token"`
+	SharedRunnersEnabled                      types.Bool   `tfsdk:"shared_runners_enabled"`
+	Tags                                      types.Set    `tfsdk:"tags"`
+	Archived                                  types.Bool   `tfsdk:"archived"`
+	InitializeWithReadme                      types.Bool   `tfsdk:"initialize_with_readme"`
+	SquashOption                              types.String `tfsdk:"squash_option"`
+	RemoveSourceBranchAfterMerge              types.Bool   `tfsdk:"remove_source_branch_after_merge"`
+	PrintingMergeRequestLinkEnabled           types.Bool   `tfsdk:"printing_merge_request_link_enabled"`
+	PackagesEnabled                           types.Bool   `tfsdk:"packages_enabled"`
+	PushRules                                 types.Object `tfsdk:"push_rules"`
+	TemplateName                              types.String `tfsdk:"template_name"`
+	TemplateProjectId                         types.Int64  `tfsdk:"template_project_id"`
+	UseCustomTemplate                         types.Bool   `tfsdk:"use_custom_template"`
+	GroupWithProjectTemplatesId               types.Int64  `tfsdk:"group_with_project_templates_id"`
+	PagesAccessLevel                          types.String `tfsdk:"pages_access_level"`
+	Mirror                                    types.Bool   `tfsdk:"mirror"`
+	MirrorTriggerBuilds                       types.Bool   `tfsdk:"mirror_trigger_builds"`
+	MirrorOverwritesDivergedBranches          types.Bool   `tfsdk:"mirror_overwrites_diverged_branches"`
+	OnlyMirrorProtectedBranches               types.Bool   `tfsdk:"only_mirror_protected_branches"`
+	BuildCoverageRegex                        types.String `tfsdk:"build_coverage_regex"`
+	IssuesTemplate                            types.String `tfsdk:"issues_template"`
+	MergeRequestsTemplate                     types.String `tfsdk:"merge_requests_template"`
+	CiConfigPath                              types.String `tfsdk:"ci_config_path"`
+	ArchiveOnDestroy                          types.Bool   `tfsdk:"archive_on_destroy"`
+	CiForwardDeploymentEnabled                types.Bool   `tfsdk:"ci_forward_deployment_enabled"`
+	CiSeparatedCaches                         types.Bool   `tfsdk:"ci_separated_caches"`
+	MergePipelinesEnabled                     types.Bool   `tfsdk:"merge_pipelines_enabled"`
+	MergeTrainsEnabled                        types.Bool   `tfsdk:"merge_trains_enabled"`
+	ResolveOutdatedDiffDiscussions            types.Bool   `tfsdk:"resolve_outdated_diff_discussions"`
+	AnalyticsAccessLevel                      types.String `tfsdk:"analytics_access_level"`
+	AutoCancelPendingPipelines                types.String `tfsdk:"auto_cancel_pending_pipelines"`
+	AutoDevopsDeployStrategy                  types.String `tfsdk:"auto_devops_deploy_strategy"`
+	AutoDevopsEnabled                         types.Bool   `tfsdk:"auto_devops_enabled"`
+	AutocloseReferencedIssues                 types.Bool   `tfsdk:"autoclose_referenced_issues"`
+	BuildGitStrategy                          types.String `tfsdk:"build_git_strategy"`
+	BuildTimeout                              types.Int64  `tfsdk:"build_timeout"`
+	BuildsAccessLevel                         types.String `tfsdk:"builds_access_level"`
+	ContainerExpirationPolicy                 types.Object `tfsdk:"container_expiration_policy"`
+	ContainerRegistryAccessLevel              types.String `tfsdk:"container_registry_access_level"`
+	EmailsDisabled                            types.Bool   `tfsdk:"emails_disabled"`
+	ExternalAuthorizationClassificationLabel  types.String `tfsdk:"external_authorization_classification_label"`
+	ForkingAccessLevel                        types.String `tfsdk:"forking_access_level"`
+	IssuesAccessLevel                         types.String `tfsdk:"issues_access_level"`
+	MergeRequestsAccessLevel                  types.String `tfsdk:"merge_requests_access_level"`
+	OperationsAccessLevel                     types.String `tfsdk:"operations_access_level"`
+	PublicBuilds                              types.Bool   `tfsdk:"public_builds"`
+	RepositoryAccessLevel                     types.String `tfsdk:"repository_access_level"`
+	RepositoryStorage                         types.String `tfsdk:"repository_storage"`
+	RequirementsAccessLevel                   types.String `tfsdk:"requirements_access_level"`
+	SecurityAndComplianceAccessLevel          types.String `tfsdk:"security_and_compliance_access_level"`
+	SnippetsAccessLevel                       types.String `tfsdk:"snippets_access_level"`
+	SuggestionCommitMessage                   types.String `tfsdk:"suggestion_commit_message"`
+	Topics                                    types.Set    `tfsdk:"topics"`
+	WikiAccessLevel                           types.String `tfsdk:"wiki_access_level"`
+	SquashCommitTemplate                      types.String `tfsdk:"squash_commit_template"`
+	MergeCommitTemplate                       types.String `tfsdk:"merge_commit_template"`
+	CiDefaultGitDepth                         types.Int64  `tfsdk:"ci_default_git_depth"`
+	ForkedFromProjectId                       types.Int64  `tfsdk:"forked_from_project_id"`
+	MrDefaultTargetSelf                       types.Bool   `tfsdk:"mr_default_target_self"`
+	Avatar                                    types.String `tfsdk:"avatar"`
+	AvatarHash                                types.String `tfsdk:"avatar_hash"`
+	AvatarUrl                                 types.String `tfsdk:"avatar_url"`
+	SkipWaitForDefaultBranchProtection        types.Bool   `tfsdk:"skip_wait_for_default_branch_protection"`
+}
+
+// gitlabProjectContainerExpirationPolicyModel describes the data model of the `container_expiration_policy` attribute.
+type gitlabProjectContainerExpirationPolicyModel struct {
+	Cadence         types.String `tfsdk:"cadence"`
+	KeepN           types.Int64  `tfsdk:"keep_n"`
+	OlderThan       types.String `tfsdk:"older_than"`
+	NameRegexDelete types.String `tfsdk:"name_regex_delete"`
+	NameRegexKeep   types.String `tfsdk:"name_regex_keep"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	NextRunAt       types.String `tfsdk:"next_run_at"`
+}
+
+var gitlabProjectContainerExpirationPolicyAttributeTypes = map[string]attr.Type{
+	"cadence":           types.StringType,
+	"keep_n":            types.Int64Type,
+	"older_than":        types.StringType,
+	"name_regex_delete": types.StringType,
+	"name_regex_keep":   types.StringType,
+	"enabled":           types.BoolType,
+	"next_run_at":       types.StringType,
+}
+
+func (r *gitlabProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *gitlabProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the project.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the project.",
+			Required:            true,
+		},
+		"path": schema.StringAttribute{
+			MarkdownDescription: "The path of the repository.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"path_with_namespace": schema.StringAttribute{
+			MarkdownDescription: "The path of the repository with namespace.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"namespace_id": schema.Int64Attribute{
+			MarkdownDescription: "The namespace (group or user) of the project. Defaults to your user.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A description of the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"default_branch": schema.StringAttribute{
+			MarkdownDescription: "The default branch for the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"import_url": schema.StringAttribute{
+			MarkdownDescription: "Git URL to a repository to be imported. Together with `mirror = true` it will setup a Pull Mirror. This can also be used together with `forked_from_project_id` to setup a Pull Mirror for a fork. The fork takes precedence over the import. This field cannot be imported via `terraform import`.",
+			Optional:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:          []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("initialize_with_readme"))},
+		},
+		"request_access_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Allow users to request member access.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"issues_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable issue tracking for the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"merge_requests_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable merge requests for the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"pipelines_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable pipelines for the project. The `pipelines_enabled` field is being sent as `jobs_enabled` in the GitLab API calls.",
+			Optional:            true,
+			Computed:            true,
+			DeprecationMessage:  "Deprecated in favor of `builds_access_level`",
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"approvals_before_merge": schema.Int64Attribute{
+			MarkdownDescription: `Number of merge request approvals required for merging. Default is 0.
+  This field **does not** work well in combination with the ` + "`gitlab_project_approval_rule`" + ` resource
+  and is most likely gonna be deprecated in a future GitLab version (see [this upstream epic](https://gitlab.com/groups/gitlab-org/-/epics/7572)).
+  In the meantime we recommend against using this attribute and use ` + "`gitlab_project_approval_rule`" + ` instead.
+`,
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.Int64{defaultInt64(0)},
+		},
+		"wiki_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable wiki for the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"snippets_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable snippets for the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"container_registry_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable container registry for the project.",
+			Optional:            true,
+			Computed:            true,
+			DeprecationMessage:  "Use `container_registry_access_level` instead.",
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"lfs_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable LFS for the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"visibility_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set to `public` to create a public project. Valid values are %s.", utils.RenderValueListForDocs(validProjectVisibilityLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectVisibilityLevels...)},
+		},
+		"merge_method": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the merge method. Valid values are %s.", utils.RenderValueListForDocs(validProjectMergeMethods)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectMergeMethods...)},
+		},
+		"only_allow_merge_if_pipeline_succeeds": schema.BoolAttribute{
+			MarkdownDescription: "Set to true if you want allow merges only if a pipeline succeeds.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"only_allow_merge_if_all_discussions_are_resolved": schema.BoolAttribute{
+			MarkdownDescription: "Set to true if you want allow merges only if all discussions are resolved.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"allow_merge_on_skipped_pipeline": schema.BoolAttribute{
+			MarkdownDescription: "Set to true if you want to treat skipped pipelines as if they finished with success.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"restrict_user_defined_variables": schema.BoolAttribute{
+			MarkdownDescription: "Allow only users with the Maintainer role to pass user-defined variables when triggering a pipeline.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"ssh_url_to_repo": schema.StringAttribute{
+			MarkdownDescription: "URL that can be provided to `git clone` to clone the",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"http_url_to_repo": schema.StringAttribute{
+			MarkdownDescription: "URL that can be provided to `git clone` to clone the",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"web_url": schema.StringAttribute{
+			MarkdownDescription: "URL that can be used to find the project in a browser.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"runners_token": schema.StringAttribute{
+			MarkdownDescription: "Registration token to use during runner setup.",
+			Computed:            true,
+			Sensitive:           true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"shared_runners_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable shared runners for this project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"tags": schema.SetAttribute{
+			MarkdownDescription: "The list of tags for a project; put array of tags, that should be finally assigned to a project. Use topics instead.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+		},
+		"archived": schema.BoolAttribute{
+			MarkdownDescription: "Whether the project is in read-only mode (archived). Repositories can be archived/unarchived by toggling this parameter.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"initialize_with_readme": schema.BoolAttribute{
+			MarkdownDescription: "Create main branch with first commit containing a README.md file.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+			Validators: []validator.Bool{
+				boolvalidator.ConflictsWith(path.MatchRoot("import_url"), path.MatchRoot("forked_from_project_id")),
+			},
+		},
+		"squash_option": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Squash commits when merge request. Valid values are %s. The default value is `default_off`. [GitLab >= 14.1]", utils.RenderValueListForDocs(validProjectSquashOptions)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectSquashOptions...)},
+		},
+		"remove_source_branch_after_merge": schema.BoolAttribute{
+			MarkdownDescription: "Enable `Delete source branch` option by default for all new merge requests.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"printing_merge_request_link_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Show link to create/view merge request when pushing from the command line",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"packages_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable packages repository for the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"push_rules": schema.SingleNestedAttribute{
+			MarkdownDescription: "Push rules for the project. If not configured, the push rules aren't managed, e.g. to manage them with the `gitlab_project_push_rules` resource instead. Attributes which aren't configured are reset to their defaults.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Object{useStateForUnknownObject()},
+			Attributes:          gitlabPushRulesAttributes(),
+		},
+		"template_name": schema.StringAttribute{
+			MarkdownDescription: "When used without use_custom_template, name of a built-in project template. When used with use_custom_template, name of a custom project template. This option is mutually exclusive with `template_project_id`.",
+			Optional:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:          []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("template_project_id"))},
+		},
+		"template_project_id": schema.Int64Attribute{
+			MarkdownDescription: "When used with use_custom_template, project ID of a custom project template. This is preferable to using template_name since template_name may be ambiguous (enterprise edition). This option is mutually exclusive with `template_name`. See `gitlab_group_project_file_template` to set a project as a template project. If a project has not been set as a template, using it here will result in an error.",
+			Optional:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			Validators:          []validator.Int64{int64validator.ConflictsWith(path.MatchRoot("template_name"))},
+		},
+		"use_custom_template": schema.BoolAttribute{
+			MarkdownDescription: "Use either custom instance or group (with group_with_project_templates_id) project template (enterprise edition).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"group_with_project_templates_id": schema.Int64Attribute{
+			MarkdownDescription: "For group-level custom templates, specifies ID of group from which all the custom project templates are sourced. Leave empty for instance-level templates. Requires use_custom_template to be true (enterprise edition).",
+			Optional:            true,
+		},
+		"pages_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Enable pages access control. Valid values are %s.", utils.RenderValueListForDocs(validProjectPagesAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectPagesAccessLevels...)},
+		},
+		// The GitLab API requires that import_url is also set when mirror options are used
+		// Ref: https://gitlab.com/gitlab-org/terraform-provider-gitlab/pull/449#discussion_r549729230
+		"mirror": schema.BoolAttribute{
+			MarkdownDescription: "Enable project pull mirror.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+			Validators:          []validator.Bool{boolvalidator.AlsoRequires(path.MatchRoot("import_url"))},
+		},
+		"mirror_trigger_builds": schema.BoolAttribute{
+			MarkdownDescription: "Enable trigger builds on pushes for a mirrored project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.Bool{boolvalidator.AlsoRequires(path.MatchRoot("import_url"))},
+		},
+		"mirror_overwrites_diverged_branches": schema.BoolAttribute{
+			MarkdownDescription: "Enable overwrite diverged branches for a mirrored project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.Bool{boolvalidator.AlsoRequires(path.MatchRoot("import_url"))},
+		},
+		"only_mirror_protected_branches": schema.BoolAttribute{
+			MarkdownDescription: "Enable only mirror protected branches for a mirrored project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.Bool{boolvalidator.AlsoRequires(path.MatchRoot("import_url"))},
+		},
+		"build_coverage_regex": schema.StringAttribute{
+			MarkdownDescription: "Test coverage parsing for the project. This is deprecated feature in GitLab 15.0.",
+			Optional:            true,
+			Computed:            true,
+			DeprecationMessage:  "build_coverage_regex is removed in GitLab 15.0.",
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"issues_template": schema.StringAttribute{
+			MarkdownDescription: "Sets the template for new issues in the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"merge_requests_template": schema.StringAttribute{
+			MarkdownDescription: "Sets the template for new merge requests in the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"ci_config_path": schema.StringAttribute{
+			MarkdownDescription: "Custom Path to CI config file.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"archive_on_destroy": schema.BoolAttribute{
+			MarkdownDescription: "Set to `true` to archive the project instead of deleting on destroy. If set to `true` it will entire omit the `DELETE` operation.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"ci_forward_deployment_enabled": schema.BoolAttribute{
+			MarkdownDescription: "When a new deployment job starts, skip older deployment jobs that are still pending.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"ci_separated_caches": schema.BoolAttribute{
+			MarkdownDescription: "Use separate caches for protected branches.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"merge_pipelines_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable or disable merge pipelines.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"merge_trains_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable or disable merge trains. Requires `merge_pipelines_enabled` to be set to `true` to take effect.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"resolve_outdated_diff_discussions": schema.BoolAttribute{
+			MarkdownDescription: "Automatically resolve merge request diffs discussions on lines changed with a push.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"analytics_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the analytics access level. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"auto_cancel_pending_pipelines": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Auto-cancel pending pipelines. This isn’t a boolean, but enabled/disabled. Valid values are %s.", utils.RenderValueListForDocs(validProjectAutoCancelPendingPipelinesValues)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAutoCancelPendingPipelinesValues...)},
+		},
+		"auto_devops_deploy_strategy": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Auto Deploy strategy. Valid values are %s.", utils.RenderValueListForDocs(validProjectAutoDevOpsDeployStrategyValues)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAutoDevOpsDeployStrategyValues...)},
+		},
+		"auto_devops_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable Auto DevOps for this project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"autoclose_referenced_issues": schema.BoolAttribute{
+			MarkdownDescription: "Set whether auto-closing referenced issues on default branch.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"build_git_strategy": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The Git strategy. Defaults to fetch. Valid values are %s.", utils.RenderValueListForDocs(validProjectBuildGitStrategyValues)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectBuildGitStrategyValues...)},
+		},
+		"build_timeout": schema.Int64Attribute{
+			MarkdownDescription: "The maximum amount of time, in seconds, that a job can run.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"builds_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the builds access level. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"container_expiration_policy": schema.SingleNestedAttribute{
+			MarkdownDescription: "Set the image cleanup policy for this project. **Note**: this field is sometimes named `container_expiration_policy_attributes` in the GitLab Upstream API.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Object{useStateForUnknownObject()},
+			Attributes:          gitlabProjectContainerExpirationPolicyAttributes(),
+		},
+		"container_registry_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set visibility of container registry, for this project. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"emails_disabled": schema.BoolAttribute{
+			MarkdownDescription: "Disable email notifications.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"external_authorization_classification_label": schema.StringAttribute{
+			MarkdownDescription: "The classification label for the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"forking_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the forking access level. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"issues_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the issues access level. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"merge_requests_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the merge requests access level. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"operations_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the operations access level. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"public_builds": schema.BoolAttribute{
+			MarkdownDescription: "If true, jobs can be viewed by non-project members.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"repository_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the repository access level. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"repository_storage": schema.StringAttribute{
+			MarkdownDescription: "Which storage shard the repository is on. (administrator only)",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"requirements_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the requirements access level. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"security_and_compliance_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the security and compliance access level. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"snippets_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the snippets access level. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"suggestion_commit_message": schema.StringAttribute{
+			MarkdownDescription: "The commit message used to apply merge request suggestions.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"topics": schema.SetAttribute{
+			MarkdownDescription: "The list of topics for the project.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+		},
+		"wiki_access_level": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Set the wiki access level. Valid values are %s.", utils.RenderValueListForDocs(validProjectAccessLevels)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validProjectAccessLevels...)},
+		},
+		"squash_commit_template": schema.StringAttribute{
+			MarkdownDescription: "Template used to create squash commit message in merge requests. (Introduced in GitLab 14.6.)",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"merge_commit_template": schema.StringAttribute{
+			MarkdownDescription: "Template used to create merge commit message in merge requests. (Introduced in GitLab 14.5.)",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"ci_default_git_depth": schema.Int64Attribute{
+			MarkdownDescription: "Default number of revisions for shallow cloning.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"forked_from_project_id": schema.Int64Attribute{
+			MarkdownDescription: "The id of the project to fork. During create the project is forked and during an update the fork relation is changed.",
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.ConflictsWith(path.MatchRoot("initialize_with_readme"))},
+		},
+		"mr_default_target_self": schema.BoolAttribute{
+			MarkdownDescription: "For forked projects, target merge requests to this project. If false, the target will be the upstream project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+			Validators:          []validator.Bool{boolvalidator.AlsoRequires(path.MatchRoot("forked_from_project_id"))},
+		},
+		"skip_wait_for_default_branch_protection": schema.BoolAttribute{
+			MarkdownDescription: `If ` + "`true`" + `, the default behavior to wait for the default branch protection to be created is skipped.
+This is necessary if the current user is not an admin and the default branch protection is disabled on an instance-level.
+There is currently no known way to determine if the default branch protection is disabled on an instance-level for non-admin users.
+This attribute is only used during resource creation, thus changes don't have any effect and the attribute cannot be imported.
+`,
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.Bool{defaultBool(false)},
+		},
+	}
+	for name, attribute := range avatarableAttributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Version: 1,
+		MarkdownDescription: `The ` + "`gitlab_project`" + ` resource allows to manage the lifecycle of a project.
+
+A project can either be created in a group or user namespace.
+
+-> **Default Branch Protection Workaround** Projects are created with default branch protection.
+Since this default branch protection is not currently managed via Terraform, to workaround this limitation,
+you can remove the default branch protection via the API and create your desired Terraform managed branch protection.
+In the ` + "`gitlab_project`" + ` resource, define a ` + "`local-exec`" + ` provisioner which invokes
+the ` + "`/projects/:id/protected_branches/:name`" + ` API via curl to delete the branch protection on the default
+branch using a ` + "`DELETE`" + ` request. Then define the desired branch protection using the ` + "`gitlab_branch_protection`" + ` resource.
+
+-> **Nested attributes** The ` + "`push_rules`" + ` and ` + "`container_expiration_policy`" + ` attributes are only managed if they are configured.
+Configuring them as an empty object, e.g. ` + "`push_rules = {}`" + `, resets the push rules to their defaults.
+They were blocks before version 15.9, see the [upgrade guide](https://registry.terraform.io/providers/gitlabhq/gitlab/latest/docs/guides/version-15.9-upgrade).
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ce/api/projects.html)`,
+		Attributes: attributes,
+	}
+}
+
+func gitlabProjectContainerExpirationPolicyAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cadence": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The cadence of the policy. Valid values are: %s.", utils.RenderValueListForDocs(validContainerExpirationPolicyAttributesCadenceValues)),
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Validators:          []validator.String{stringvalidator.OneOf(validContainerExpirationPolicyAttributesCadenceValues...)},
+		},
+		"keep_n": schema.Int64Attribute{
+			MarkdownDescription: "The number of images to keep.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			Validators:          []validator.Int64{int64validator.AtLeast(0)},
+		},
+		"older_than": schema.StringAttribute{
+			MarkdownDescription: "The number of days to keep images.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"name_regex_delete": schema.StringAttribute{
+			MarkdownDescription: "The regular expression to match image names to delete. **Note**: the upstream API has some inconsistencies with the `name_regex` field here. It's basically unusable at the moment.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"name_regex_keep": schema.StringAttribute{
+			MarkdownDescription: "The regular expression to match image names to keep.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "If true, the policy is enabled.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+		"next_run_at": schema.StringAttribute{
+			MarkdownDescription: "The next time the policy will run.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.providerData = req.ProviderData.(*api.ProviderData)
+	r.client = r.providerData.Client
+}
+
+// ModifyPlan marks the attributes as unknown which are changed upstream as a side effect of another change.
+func (r *gitlabProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The attributes are processed in order, so that changes are propagated, e.g. from `namespace_id` over
+	// `visibility_level` to `pages_access_level`.
+	modifyDependentAttributesPlan(ctx, projectDependentAttributes, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifyAvatarablePlan(ctx, req, resp)
+}
+
+// Create creates a new upstream resources and adds it into the Terraform state.
+func (r *gitlabProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config *gitlabProjectResourceModel
+
+	// Read Terraform plan and config data into the model.
+	// The config is used to only send the configured attributes during the creation.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	supportsSquashOption, err := r.providerData.Capabilities.IsVersionAtLeast(ctx, "14.1")
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to determine the GitLab version: %s", err.Error()))
+		return
+	}
+
+	// Project that has either been created or forked
+	var project *gitlab.Project
+
+	if config.ForkedFromProjectId.IsNull() {
+		options, diags := r.expandCreateProjectOptions(ctx, config, supportsSquashOption)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "create gitlab project", map[string]interface{}{"name": *options.Name})
+		project, _, err = r.client.Projects.CreateProject(options, gitlab.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to create project: %s", err.Error()))
+			return
+		}
+	} else {
+		forkedFromProjectID := int(config.ForkedFromProjectId.ValueInt64())
+		tflog.Debug(ctx, "forking project", map[string]interface{}{"forked_from_project_id": forkedFromProjectID})
+
+		options := &gitlab.ForkProjectOptions{
+			Name: gitlab.String(config.Name.ValueString()),
+		}
+		if !config.Description.IsNull() {
+			options.Description = gitlab.String(config.Description.ValueString())
+		}
+		if !config.Path.IsNull() {
+			options.Path = gitlab.String(config.Path.ValueString())
+		}
+		if !config.NamespaceId.IsNull() {
+			options.NamespaceID = gitlab.Int(int(config.NamespaceId.ValueInt64()))
+		}
+		if !config.VisibilityLevel.IsNull() {
+			options.Visibility = gitlab.Visibility(gitlab.VisibilityValue(config.VisibilityLevel.ValueString()))
+		}
+		if !config.MrDefaultTargetSelf.IsNull() {
+			options.MergeRequestDefaultTargetSelf = gitlab.Bool(config.MrDefaultTargetSelf.ValueBool())
+		}
+
+		project, _, err = r.client.Projects.ForkProject(forkedFromProjectID, options, gitlab.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to fork project %d: %s", forkedFromProjectID, err.Error()))
+			return
+		}
+	}
+
+	// from this point onwards no matter how we return, resource creation
+	// is committed to state since we set its ID
+	projectID := strconv.Itoa(project.ID)
+	plan.Id = types.StringValue(projectID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An import can be triggered by import_url or by creating the project from a template or from a fork.
+	if project.ImportStatus != "none" {
+		tflog.Debug(ctx, "waiting for project import to finish", map[string]interface{}{"project": projectID})
+
+		err := waitFor(ctx, 10*time.Minute, 3*time.Second, func() (bool, error) {
+			status, _, err := r.client.ProjectImportExport.ImportStatus(projectID, gitlab.WithContext(ctx))
+			if err != nil {
+				return false, err
+			}
+
+			switch status.ImportStatus {
+			case "finished":
+				return true, nil
+			case "scheduled", "started":
+				return false, nil
+			default:
+				return false, fmt.Errorf("unexpected import status %q", status.ImportStatus)
+			}
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Error while waiting for project %q import to finish: %s", project.Name, err.Error()))
+			return
+		}
+
+		// Read the project again, so that we can detect the default branch.
+		project, _, err = r.client.Projects.GetProject(project.ID, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Failed to get project %q after completing import: %s", projectID, err.Error()))
+			return
+		}
+	}
+
+	if plan.Archived.ValueBool() {
+		// strange as it may seem, this project is created in archived state...
+		if _, _, err := r.client.Projects.ArchiveProject(projectID, gitlab.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("New project %q could not be archived: %s", projectID, err.Error()))
+			return
+		}
+	}
+
+	if !config.PushRules.IsNull() {
+		resp.Diagnostics.Append(r.editOrAddPushRules(ctx, projectID, plan.PushRules)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// see: https://gitlab.com/gitlab-org/gitlab/-/issues/333426
+	noDefaultBranchAPISupport, err := api.IsGitLabVersionLessThan(ctx, r.client, "14.10")()
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to get information if `default_branch` handling is supported in the GitLab instance: %s", err.Error()))
+		return
+	}
+
+	if noDefaultBranchAPISupport && !config.DefaultBranch.IsNull() && project.DefaultBranch != "" && project.DefaultBranch != config.DefaultBranch.ValueString() {
+		// default_branch cannot always be set during creation.
+		// If the branch does not exist, the update will fail, so we also create it here.
+		// This logic may be removed when the above issue is resolved.
+		if err := r.replaceDefaultBranch(ctx, project, config.DefaultBranch.ValueString()); err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", err.Error())
+			return
+		}
+	}
+
+	if !plan.SkipWaitForDefaultBranchProtection.ValueBool() {
+		// If the project is assigned to a group namespace and the group has *default branch protection*
+		// disabled (`default_branch_protection = 0`) then we don't have to wait for one.
+		waitForDefaultBranchProtection, err := r.expectDefaultBranchProtection(ctx, project)
+		if err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Failed to discover if branch protection is enabled by default or not for project %d: %s", project.ID, err.Error()))
+			return
+		}
+
+		if waitForDefaultBranchProtection {
+			// Branch protection for a newly created branch is an async action, so wait to ensure it's protected
+			// before we continue. Note this check should only be required when there is a custom default branch set
+			// See issue 800: https://gitlab.com/gitlab-org/terraform-provider-gitlab/issues/800
+			// The async action usually completes very quickly, within seconds. Don't wait too long.
+			err := waitFor(ctx, 2*time.Minute, 1*time.Second, func() (bool, error) {
+				branch, _, err := r.client.Branches.GetBranch(project.ID, project.DefaultBranch, gitlab.WithContext(ctx))
+				if err != nil {
+					if api.Is404(err) {
+						// When we hit a 404 here, it means the default branch wasn't created at all as part of the project
+						// this will happen when "default_branch" isn't set, or "initialize_with_readme" is set to false.
+						// We don't need to wait anymore.
+						return true, nil
+					}
+
+					return false, err
+				}
+
+				return branch.Protected, nil
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Error while waiting for branch %s to reach 'protected' status: %s", project.DefaultBranch, err.Error()))
+				return
+			}
+		}
+	}
+
+	// Not all attributes can be set during the creation of a project, e.g. when it's forked, thus they are updated now.
+	var created gitlabProjectResourceModel
+	resp.Diagnostics.Append(r.projectToStateModel(ctx, project, supportsSquashOption, &created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	editOptions, diags := r.expandEditProjectOptions(ctx, config, &created, supportsSquashOption)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if project.ForkedFromProject == nil {
+		// the default branch has already been set during the creation.
+		editOptions.DefaultBranch = nil
+	}
+	if project.ForkedFromProject != nil {
+		avatar, err := avatarToUpload(plan.Avatar, plan.AvatarHash, types.StringNull(), types.StringNull())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read avatar", err.Error())
+			return
+		}
+		if avatar != nil {
+			editOptions.Avatar = &gitlab.ProjectAvatar{Filename: avatar.Filename, Image: avatar.Image}
+		}
+	}
+
+	if (*editOptions != gitlab.EditProjectOptions{}) {
+		if _, _, err := r.client.Projects.EditProject(projectID, editOptions, gitlab.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Could not update project %q: %s", projectID, err.Error()))
+			return
+		}
+	}
+
+	// Log the creation of the resource
+	tflog.Debug(ctx, "created a project", map[string]interface{}{"project": projectID})
+
+	resp.Diagnostics.Append(r.readIntoModel(ctx, projectID, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabProjectResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Id.ValueString()
+	project, _, err := r.client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if api.Is404(err) {
+			tflog.Debug(ctx, "project does not exist, removing from state", map[string]interface{}{"project": projectID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read project details: %s", err.Error()))
+		return
+	}
+	if project.MarkedForDeletionAt != nil {
+		tflog.Debug(ctx, "project is marked for deletion, removing from state", map[string]interface{}{"project": projectID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(r.readProjectIntoModel(ctx, project, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource in-place.
+func (r *gitlabProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *gitlabProjectResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.Id.ValueString()
+
+	supportsSquashOption, err := r.providerData.Capabilities.IsVersionAtLeast(ctx, "14.1")
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to determine the GitLab version: %s", err.Error()))
+		return
+	}
+
+	options, diags := r.expandEditProjectOptions(ctx, plan, state, supportsSquashOption)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Always send the name field, to satisfy the requirement of having one
+	// of the project attributes listed below in the update call
+	// https://gitlab.com/gitlab-org/gitlab-foss/-/blob/master/lib/api/helpers/projects_helpers.rb#L120-188
+	options.Name = gitlab.String(plan.Name.ValueString())
+
+	avatar, err := avatarToUpload(plan.Avatar, plan.AvatarHash, state.Avatar, state.AvatarHash)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read avatar", err.Error())
+		return
+	}
+	if avatar != nil {
+		options.Avatar = &gitlab.ProjectAvatar{Filename: avatar.Filename, Image: avatar.Image}
+	}
+
+	tflog.Debug(ctx, "update gitlab project", map[string]interface{}{"project": projectID})
+	if _, _, err := r.client.Projects.EditProject(projectID, options, gitlab.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	if !plan.ForkedFromProjectId.Equal(state.ForkedFromProjectId) {
+		// change, create or remove the fork relation
+		if !state.ForkedFromProjectId.IsNull() {
+			if _, err := r.client.Projects.DeleteProjectForkRelation(projectID, gitlab.WithContext(ctx)); err != nil {
+				resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to remove fork relation from project %q: %s", projectID, err.Error()))
+				return
+			}
+		}
+
+		if !plan.ForkedFromProjectId.IsNull() {
+			forkedFromProjectID := int(plan.ForkedFromProjectId.ValueInt64())
+			if _, _, err := r.client.Projects.CreateProjectForkRelation(projectID, forkedFromProjectID, gitlab.WithContext(ctx)); err != nil {
+				resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to add fork relation to project %q (to project %d): %s", projectID, forkedFromProjectID, err.Error()))
+				return
+			}
+		}
+	}
+
+	if attributeChanged(plan.NamespaceId, state.NamespaceId) {
+		namespaceID := int(plan.NamespaceId.ValueInt64())
+		tflog.Debug(ctx, "transferring project to namespace", map[string]interface{}{"project": projectID, "namespace_id": namespaceID})
+		if _, _, err := r.client.Projects.TransferProject(projectID, &gitlab.TransferProjectOptions{Namespace: namespaceID}, gitlab.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to transfer project %q to namespace %d: %s", projectID, namespaceID, err.Error()))
+			return
+		}
+	}
+
+	if attributeChanged(plan.Archived, state.Archived) {
+		if plan.Archived.ValueBool() {
+			if _, _, err := r.client.Projects.ArchiveProject(projectID, gitlab.WithContext(ctx)); err != nil {
+				resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Project %q could not be archived: %s", projectID, err.Error()))
+				return
+			}
+		} else {
+			if _, _, err := r.client.Projects.UnarchiveProject(projectID, gitlab.WithContext(ctx)); err != nil {
+				resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Project %q could not be unarchived: %s", projectID, err.Error()))
+				return
+			}
+		}
+	}
+
+	if attributeChanged(plan.PushRules, state.PushRules) {
+		resp.Diagnostics.Append(r.editOrAddPushRules(ctx, projectID, plan.PushRules)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.readIntoModel(ctx, projectID, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource.
+func (r *gitlabProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabProjectResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.Id.ValueString()
+
+	if data.ArchiveOnDestroy.ValueBool() {
+		tflog.Debug(ctx, "archive gitlab project", map[string]interface{}{"project": projectID})
+		if _, _, err := r.client.Projects.ArchiveProject(projectID, gitlab.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to archive project %q: %s", projectID, err.Error()))
+		}
+		return
+	}
+
+	tflog.Debug(ctx, "delete gitlab project", map[string]interface{}{"project": projectID})
+	if _, err := r.client.Projects.DeleteProject(projectID, gitlab.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete project %q: %s", projectID, err.Error()))
+		return
+	}
+
+	// Wait for the project to be deleted.
+	// Deleting a project in gitlab is async.
+	err := waitFor(ctx, 10*time.Minute, 3*time.Second, func() (bool, error) {
+		project, _, err := r.client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			if api.Is404(err) {
+				return true, nil
+			}
+			return false, err
+		}
+
+		// Represents a Gitlab EE soft-delete
+		return project.MarkedForDeletionAt != nil, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Error waiting for project %q to become deleted: %s", projectID, err.Error()))
+	}
+}
+
+// ImportState imports the resource into the Terraform state.
+// The project can be imported by its ID or its full path, which is resolved to the ID.
+func (r *gitlabProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, err := api.LookupProjectID(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to import project %q: %s", req.ID, err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectID)...)
+}
+
+// UpgradeState upgrades the state of the SDK implementation of the resource, which used blocks for the nested attributes.
+func (r *gitlabProjectResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var current resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+
+	priorAttributes := make(map[string]schema.Attribute, len(current.Schema.Attributes))
+	for name, attribute := range current.Schema.Attributes {
+		priorAttributes[name] = attribute
+	}
+	priorAttributes["push_rules"] = schema.ListNestedAttribute{
+		Optional:     true,
+		Computed:     true,
+		NestedObject: schema.NestedAttributeObject{Attributes: gitlabPushRulesAttributes()},
+	}
+	priorAttributes["container_expiration_policy"] = schema.ListNestedAttribute{
+		Optional:     true,
+		Computed:     true,
+		NestedObject: schema.NestedAttributeObject{Attributes: gitlabProjectContainerExpirationPolicyAttributes()},
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schema.Schema{Attributes: priorAttributes},
+			StateUpgrader: upgradeGitlabProjectStateV0ToV1,
+		},
+	}
+}
+
+// upgradeGitlabProjectStateV0ToV1 migrates the state of the SDK implementation:
+//   - the `push_rules` and `container_expiration_policy` blocks were lists with at most one element
+//   - the SDK stored zero values for attributes which weren't configured and aren't read from the API
+func upgradeGitlabProjectStateV0ToV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var values map[string]tftypes.Value
+	if err := req.State.Raw.As(&values); err != nil {
+		resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("Unable to read the prior state: %s", err.Error()))
+		return
+	}
+
+	stateType, ok := resp.State.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		resp.Diagnostics.AddError("Provider Error, report upstream", "The state of the resource isn't an object.")
+		return
+	}
+
+	for _, name := range []string{"push_rules", "container_expiration_policy"} {
+		var elements []tftypes.Value
+		if err := values[name].As(&elements); err != nil {
+			resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("Unable to read the prior `%s` attribute: %s", name, err.Error()))
+			return
+		}
+		if len(elements) > 0 {
+			values[name] = elements[0]
+		} else {
+			values[name] = tftypes.NewValue(stateType.AttributeTypes[name], nil)
+		}
+	}
+
+	for name, zeroValue := range map[string]tftypes.Value{
+		"import_url":                      tftypes.NewValue(tftypes.String, ""),
+		"template_name":                   tftypes.NewValue(tftypes.String, ""),
+		"template_project_id":             tftypes.NewValue(tftypes.Number, 0),
+		"group_with_project_templates_id": tftypes.NewValue(tftypes.Number, 0),
+		"forked_from_project_id":          tftypes.NewValue(tftypes.Number, 0),
+		"avatar":                          tftypes.NewValue(tftypes.String, ""),
+	} {
+		if values[name].Equal(zeroValue) {
+			values[name] = tftypes.NewValue(zeroValue.Type(), nil)
+		}
+	}
+
+	for _, name := range []string{"initialize_with_readme", "use_custom_template", "archive_on_destroy", "skip_wait_for_default_branch_protection"} {
+		if values[name].IsNull() {
+			values[name] = tftypes.NewValue(tftypes.Bool, false)
+		}
+	}
+
+	resp.State.Raw = tftypes.NewValue(stateType, values)
+}
+
+// readIntoModel reads the project and its push rules into the model.
+func (r *gitlabProjectResource) readIntoModel(ctx context.Context, projectID string, data *gitlabProjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	project, _, err := r.client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read project details: %s", err.Error()))
+		return diags
+	}
+
+	diags.Append(r.readProjectIntoModel(ctx, project, data)...)
+	return diags
+}
+
+// readProjectIntoModel sets the attributes of the project and its push rules in the model.
+// The unknown values which aren't returned by the API are resolved.
+func (r *gitlabProjectResource) readProjectIntoModel(ctx context.Context, project *gitlab.Project, data *gitlabProjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	supportsSquashOption, err := r.providerData.Capabilities.IsVersionAtLeast(ctx, "14.1")
+	if err != nil {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Unable to determine the GitLab version: %s", err.Error()))
+		return diags
+	}
+
+	diags.Append(r.projectToStateModel(ctx, project, supportsSquashOption, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, "read gitlab project push rules", map[string]interface{}{"project": project.ID})
+	pushRules, _, err := r.client.Projects.GetProjectPushRules(project.ID, gitlab.WithContext(ctx))
+	if err != nil && !api.Is404(err) {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Failed to get push rules for project %d: %s", project.ID, err.Error()))
+		return diags
+	}
+	var d diag.Diagnostics
+	data.PushRules, d = pushRulesToStateModel(ctx, pushRules)
+	diags.Append(d...)
+	return diags
+}
+
+// projectToStateModel sets the attributes of the project in the model.
+// Attributes which aren't returned by the API are kept, unless they are unknown.
+func (r *gitlabProjectResource) projectToStateModel(ctx context.Context, project *gitlab.Project, supportsSquashOption bool, data *gitlabProjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(strconv.Itoa(project.ID))
+	data.Name = types.StringValue(project.Name)
+	data.Path = types.StringValue(project.Path)
+	data.PathWithNamespace = types.StringValue(project.PathWithNamespace)
+	if project.Namespace != nil {
+		data.NamespaceId = types.Int64Value(int64(project.Namespace.ID))
+	}
+	data.Description = types.StringValue(project.Description)
+	data.DefaultBranch = types.StringValue(project.DefaultBranch)
+	data.RequestAccessEnabled = types.BoolValue(project.RequestAccessEnabled)
+	data.IssuesEnabled = types.BoolValue(project.IssuesEnabled)
+	data.MergeRequestsEnabled = types.BoolValue(project.MergeRequestsEnabled)
+	data.PipelinesEnabled = types.BoolValue(project.JobsEnabled)
+	data.ApprovalsBeforeMerge = types.Int64Value(int64(project.ApprovalsBeforeMerge))
+	data.WikiEnabled = types.BoolValue(project.WikiEnabled)
+	data.SnippetsEnabled = types.BoolValue(project.SnippetsEnabled)
+	data.ContainerRegistryEnabled = types.BoolValue(project.ContainerRegistryEnabled)
+	data.LfsEnabled = types.BoolValue(project.LFSEnabled)
+	data.VisibilityLevel = types.StringValue(string(project.Visibility))
+	data.MergeMethod = types.StringValue(string(project.MergeMethod))
+	data.OnlyAllowMergeIfPipelineSucceeds = types.BoolValue(project.OnlyAllowMergeIfPipelineSucceeds)
+	data.OnlyAllowMergeIfAllDiscussionsAreResolved = types.BoolValue(project.OnlyAllowMergeIfAllDiscussionsAreResolved)
+	data.AllowMergeOnSkippedPipeline = types.BoolValue(project.AllowMergeOnSkippedPipeline)
+	data.RestrictUserDefinedVariables = types.BoolValue(project.RestrictUserDefinedVariables)
+	data.SshUrlToRepo = types.StringValue(project.SSHURLToRepo)
+	data.HttpUrlToRepo = types.StringValue(project.HTTPURLToRepo)
+	data.WebUrl = types.StringValue(project.WebURL)
+	data.RunnersToken = types.StringValue(project.RunnersToken)
+	data.SharedRunnersEnabled = types.BoolValue(project.SharedRunnersEnabled)
+	data.Archived = types.BoolValue(project.Archived)
+	if supportsSquashOption {
+		data.SquashOption = types.StringValue(string(project.SquashOption))
+	} else if data.SquashOption.IsUnknown() {
+		data.SquashOption = types.StringNull()
+	}
+	data.RemoveSourceBranchAfterMerge = types.BoolValue(project.RemoveSourceBranchAfterMerge)
+	data.PrintingMergeRequestLinkEnabled = types.BoolValue(project.PrintingMergeRequestLinkEnabled)
+	data.PackagesEnabled = types.BoolValue(project.PackagesEnabled)
+	data.PagesAccessLevel = types.StringValue(string(project.PagesAccessLevel))
+	data.Mirror = types.BoolValue(project.Mirror)
+	data.MirrorTriggerBuilds = types.BoolValue(project.MirrorTriggerBuilds)
+	data.MirrorOverwritesDivergedBranches = types.BoolValue(project.MirrorOverwritesDivergedBranches)
+	data.OnlyMirrorProtectedBranches = types.BoolValue(project.OnlyMirrorProtectedBranches)
+	data.IssuesTemplate = types.StringValue(project.IssuesTemplate)
+	data.MergeRequestsTemplate = types.StringValue(project.MergeRequestsTemplate)
+	data.CiConfigPath = types.StringValue(project.CIConfigPath)
+	data.CiForwardDeploymentEnabled = types.BoolValue(project.CIForwardDeploymentEnabled)
+	data.CiSeparatedCaches = types.BoolValue(project.CISeperateCache)
+	data.MergePipelinesEnabled = types.BoolValue(project.MergePipelinesEnabled)
+	data.MergeTrainsEnabled = types.BoolValue(project.MergeTrainsEnabled)
+	data.ResolveOutdatedDiffDiscussions = types.BoolValue(project.ResolveOutdatedDiffDiscussions)
+	data.AnalyticsAccessLevel = types.StringValue(string(project.AnalyticsAccessLevel))
+	data.AutoCancelPendingPipelines = types.StringValue(project.AutoCancelPendingPipelines)
+	data.AutoDevopsDeployStrategy = types.StringValue(project.AutoDevopsDeployStrategy)
+	data.AutoDevopsEnabled = types.BoolValue(project.AutoDevopsEnabled)
+	data.AutocloseReferencedIssues = types.BoolValue(project.AutocloseReferencedIssues)
+	data.BuildGitStrategy = types.StringValue(project.BuildGitStrategy)
+	data.BuildTimeout = types.Int64Value(int64(project.BuildTimeout))
+	data.BuildsAccessLevel = types.StringValue(string(project.BuildsAccessLevel))
+	data.ContainerRegistryAccessLevel = types.StringValue(string(project.ContainerRegistryAccessLevel))
+	data.EmailsDisabled = types.BoolValue(project.EmailsDisabled)
+	data.ExternalAuthorizationClassificationLabel = types.StringValue(project.ExternalAuthorizationClassificationLabel)
+	data.ForkingAccessLevel = types.StringValue(string(project.ForkingAccessLevel))
+	data.IssuesAccessLevel = types.StringValue(string(project.IssuesAccessLevel))
+	data.MergeRequestsAccessLevel = types.StringValue(string(project.MergeRequestsAccessLevel))
+	data.OperationsAccessLevel = types.StringValue(string(project.OperationsAccessLevel))
+
+	//(PatrickRice): In 16.0, we need to rename this to "public_jobs"
+	data.PublicBuilds = types.BoolValue(project.PublicJobs)
+
+	data.RepositoryAccessLevel = types.StringValue(string(project.RepositoryAccessLevel))
+	data.RepositoryStorage = types.StringValue(project.RepositoryStorage)
+	data.RequirementsAccessLevel = types.StringValue(string(project.RequirementsAccessLevel))
+	data.SecurityAndComplianceAccessLevel = types.StringValue(string(project.SecurityAndComplianceAccessLevel))
+	data.SnippetsAccessLevel = types.StringValue(string(project.SnippetsAccessLevel))
+	data.SuggestionCommitMessage = types.StringValue(project.SuggestionCommitMessage)
+	data.WikiAccessLevel = types.StringValue(string(project.WikiAccessLevel))
+	data.SquashCommitTemplate = types.StringValue(project.SquashCommitTemplate)
+	data.MergeCommitTemplate = types.StringValue(project.MergeCommitTemplate)
+
+	//Note: This field is deprecated and will always be an empty string starting in GitLab 15.0.
+	data.BuildCoverageRegex = types.StringValue(project.BuildCoverageRegex)
+
+	data.CiDefaultGitDepth = types.Int64Value(int64(project.CIDefaultGitDepth))
+	data.AvatarUrl = types.StringValue(project.AvatarURL)
+
+	if project.ForkedFromProject != nil {
+		data.ForkedFromProjectId = types.Int64Value(int64(project.ForkedFromProject.ID))
+	} else {
+		data.ForkedFromProjectId = types.Int64Null()
+	}
+	data.MrDefaultTargetSelf = types.BoolValue(project.MergeRequestDefaultTargetSelf)
+
+	// tags and topics are never null, so that an empty set can be configured
+	tags := project.TagList
+	if tags == nil {
+		tags = []string{}
+	}
+	var d diag.Diagnostics
+	data.Tags, d = types.SetValueFrom(ctx, types.StringType, tags)
+	diags.Append(d...)
+	topics := project.Topics
+	if topics == nil {
+		topics = []string{}
+	}
+	data.Topics, d = types.SetValueFrom(ctx, types.StringType, topics)
+	diags.Append(d...)
+
+	data.ContainerExpirationPolicy, d = containerExpirationPolicyToStateModel(ctx, project.ContainerExpirationPolicy)
+	diags.Append(d...)
+
+	// The attributes which are only used when the project is created or destroyed aren't returned by the API.
+	// They have a default value, so that they don't produce a diff after an import.
+	for _, value := range []*types.Bool{&data.InitializeWithReadme, &data.UseCustomTemplate, &data.ArchiveOnDestroy, &data.SkipWaitForDefaultBranchProtection} {
+		if value.IsNull() || value.IsUnknown() {
+			*value = types.BoolValue(false)
+		}
+	}
+	if data.AvatarHash.IsNull() || data.AvatarHash.IsUnknown() {
+		data.AvatarHash = types.StringValue("")
+	}
+
+	return diags
+}
+
+func pushRulesToStateModel(ctx context.Context, pushRules *gitlab.ProjectPushRules) (types.Object, diag.Diagnostics) {
+	// NOTE: push rules id `0` indicates that there haven't been any push rules set.
+	if pushRules == nil || pushRules.ID == 0 {
+		return types.ObjectNull(gitlabPushRulesAttributeTypes), nil
+	}
+
+	return types.ObjectValueFrom(ctx, gitlabPushRulesAttributeTypes, projectPushRulesToModel(pushRules))
+}
+
+func containerExpirationPolicyToStateModel(ctx context.Context, policy *gitlab.ContainerExpirationPolicy) (types.Object, diag.Diagnostics) {
+	if policy == nil {
+		return types.ObjectNull(gitlabProjectContainerExpirationPolicyAttributeTypes), nil
+	}
+
+	data := gitlabProjectContainerExpirationPolicyModel{
+		Cadence:         types.StringValue(policy.Cadence),
+		KeepN:           types.Int64Value(int64(policy.KeepN)),
+		OlderThan:       types.StringValue(policy.OlderThan),
+		NameRegexDelete: types.StringValue(policy.NameRegexDelete),
+		NameRegexKeep:   types.StringValue(policy.NameRegexKeep),
+		Enabled:         types.BoolValue(policy.Enabled),
+		NextRunAt:       types.StringNull(),
+	}
+	if policy.NextRunAt != nil {
+		data.NextRunAt = types.StringValue(policy.NextRunAt.Format(time.RFC3339))
+	}
+	return types.ObjectValueFrom(ctx, gitlabProjectContainerExpirationPolicyAttributeTypes, data)
+}
+
+// expandContainerExpirationPolicyAttributes returns the known values of the configured policy.
+func expandContainerExpirationPolicyAttributes(ctx context.Context, value types.Object) (*gitlab.ContainerExpirationPolicyAttributes, diag.Diagnostics) {
+	var data gitlabProjectContainerExpirationPolicyModel
+	if diags := value.As(ctx, &data, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}); diags.HasError() {
+		return nil, diags
+	}
+
+	policy := &gitlab.ContainerExpirationPolicyAttributes{}
+	if data.Cadence.ValueString() != "" {
+		policy.Cadence = gitlab.String(data.Cadence.ValueString())
+	}
+	if data.KeepN.ValueInt64() != 0 {
+		policy.KeepN = gitlab.Int(int(data.KeepN.ValueInt64()))
+	}
+	if data.OlderThan.ValueString() != "" {
+		policy.OlderThan = gitlab.String(data.OlderThan.ValueString())
+	}
+	if data.NameRegexDelete.ValueString() != "" {
+		policy.NameRegexDelete = gitlab.String(data.NameRegexDelete.ValueString())
+	}
+	if data.NameRegexKeep.ValueString() != "" {
+		policy.NameRegexKeep = gitlab.String(data.NameRegexKeep.ValueString())
+	}
+	if attributeConfigured(data.Enabled) {
+		policy.Enabled = gitlab.Bool(data.Enabled.ValueBool())
+	}
+	return policy, nil
+}
+
+// expandCreateProjectOptions returns the options to create a project with the configured attributes.
+func (r *gitlabProjectResource) expandCreateProjectOptions(ctx context.Context, config *gitlabProjectResourceModel, supportsSquashOption bool) (*gitlab.CreateProjectOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	options := &gitlab.CreateProjectOptions{
+		Name: gitlab.String(config.Name.ValueString()),
+	}
+
+	if !config.BuildCoverageRegex.IsNull() {
+		options.BuildCoverageRegex = gitlab.String(config.BuildCoverageRegex.ValueString())
+	}
+	if !config.Path.IsNull() {
+		options.Path = gitlab.String(config.Path.ValueString())
+	}
+	if !config.NamespaceId.IsNull() {
+		options.NamespaceID = gitlab.Int(int(config.NamespaceId.ValueInt64()))
+	}
+	if !config.Description.IsNull() {
+		options.Description = gitlab.String(config.Description.ValueString())
+	}
+	if !config.DefaultBranch.IsNull() {
+		options.DefaultBranch = gitlab.String(config.DefaultBranch.ValueString())
+	}
+	if !config.Tags.IsNull() {
+		tags, err := stringSetValue(ctx, config.Tags)
+		if err != nil {
+			diags.AddError("Invalid tags", err.Error())
+			return nil, diags
+		}
+		// TODO: Remove TagList on next breaking change. TagList and Topics aren't completely synonymous.
+		// nolint:staticcheck // SA1019
+		options.TagList = tags
+	}
+	if !config.ContainerRegistryEnabled.IsNull() {
+		// nolint:staticcheck // SA1019
+		options.ContainerRegistryEnabled = gitlab.Bool(config.ContainerRegistryEnabled.ValueBool())
+	}
+	if !config.InitializeWithReadme.IsNull() {
+		options.InitializeWithReadme = gitlab.Bool(config.InitializeWithReadme.ValueBool())
+	}
+	if !config.PipelinesEnabled.IsNull() {
+		// nolint:staticcheck // SA1019
+		options.JobsEnabled = gitlab.Bool(config.PipelinesEnabled.ValueBool())
+	}
+	if !config.ImportUrl.IsNull() {
+		options.ImportURL = gitlab.String(config.ImportUrl.ValueString())
+	}
+	if !config.TemplateName.IsNull() {
+		options.TemplateName = gitlab.String(config.TemplateName.ValueString())
+	}
+	if !config.TemplateProjectId.IsNull() {
+		options.TemplateProjectID = gitlab.Int(int(config.TemplateProjectId.ValueInt64()))
+	}
+	if !config.UseCustomTemplate.IsNull() {
+		options.UseCustomTemplate = gitlab.Bool(config.UseCustomTemplate.ValueBool())
+	}
+	if !config.GroupWithProjectTemplatesId.IsNull() {
+		options.GroupWithProjectTemplatesID = gitlab.Int(int(config.GroupWithProjectTemplatesId.ValueInt64()))
+	}
+	if !config.PagesAccessLevel.IsNull() {
+		options.PagesAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.PagesAccessLevel.ValueString()))
+	}
+	if !config.CiConfigPath.IsNull() {
+		options.CIConfigPath = gitlab.String(config.CiConfigPath.ValueString())
+	}
+	if !config.ResolveOutdatedDiffDiscussions.IsNull() {
+		options.ResolveOutdatedDiffDiscussions = gitlab.Bool(config.ResolveOutdatedDiffDiscussions.ValueBool())
+	}
+	if !config.AnalyticsAccessLevel.IsNull() {
+		options.AnalyticsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.AnalyticsAccessLevel.ValueString()))
+	}
+	if !config.AutoCancelPendingPipelines.IsNull() {
+		options.AutoCancelPendingPipelines = gitlab.String(config.AutoCancelPendingPipelines.ValueString())
+	}
+	if !config.AutoDevopsDeployStrategy.IsNull() {
+		options.AutoDevopsDeployStrategy = gitlab.String(config.AutoDevopsDeployStrategy.ValueString())
+	}
+	if !config.AutoDevopsEnabled.IsNull() {
+		options.AutoDevopsEnabled = gitlab.Bool(config.AutoDevopsEnabled.ValueBool())
+	}
+	if !config.AutocloseReferencedIssues.IsNull() {
+		options.AutocloseReferencedIssues = gitlab.Bool(config.AutocloseReferencedIssues.ValueBool())
+	}
+	if !config.BuildGitStrategy.IsNull() {
+		options.BuildGitStrategy = gitlab.String(config.BuildGitStrategy.ValueString())
+	}
+	if !config.BuildTimeout.IsNull() {
+		options.BuildTimeout = gitlab.Int(int(config.BuildTimeout.ValueInt64()))
+	}
+	if !config.BuildsAccessLevel.IsNull() {
+		options.BuildsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.BuildsAccessLevel.ValueString()))
+	}
+	if !config.ContainerExpirationPolicy.IsNull() {
+		policy, d := expandContainerExpirationPolicyAttributes(ctx, config.ContainerExpirationPolicy)
+		diags.Append(d...)
+		options.ContainerExpirationPolicyAttributes = policy
+	}
+	if !config.ContainerRegistryAccessLevel.IsNull() {
+		options.ContainerRegistryAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.ContainerRegistryAccessLevel.ValueString()))
+	}
+	if !config.EmailsDisabled.IsNull() {
+		options.EmailsDisabled = gitlab.Bool(config.EmailsDisabled.ValueBool())
+	}
+	if !config.ExternalAuthorizationClassificationLabel.IsNull() {
+		options.ExternalAuthorizationClassificationLabel = gitlab.String(config.ExternalAuthorizationClassificationLabel.ValueString())
+	}
+	if !config.ForkingAccessLevel.IsNull() {
+		options.ForkingAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.ForkingAccessLevel.ValueString()))
+	}
+	if !config.IssuesAccessLevel.IsNull() {
+		options.IssuesAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.IssuesAccessLevel.ValueString()))
+	}
+	if !config.MergeRequestsAccessLevel.IsNull() {
+		options.MergeRequestsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.MergeRequestsAccessLevel.ValueString()))
+	}
+	if !config.OperationsAccessLevel.IsNull() {
+		options.OperationsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.OperationsAccessLevel.ValueString()))
+	}
+	if !config.PublicBuilds.IsNull() {
+		options.PublicBuilds = gitlab.Bool(config.PublicBuilds.ValueBool())
+	}
+	if !config.RepositoryAccessLevel.IsNull() {
+		options.RepositoryAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.RepositoryAccessLevel.ValueString()))
+	}
+	if !config.RepositoryStorage.IsNull() {
+		options.RepositoryStorage = gitlab.String(config.RepositoryStorage.ValueString())
+	}
+	if !config.RequirementsAccessLevel.IsNull() {
+		options.RequirementsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.RequirementsAccessLevel.ValueString()))
+	}
+	if !config.SecurityAndComplianceAccessLevel.IsNull() {
+		options.SecurityAndComplianceAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.SecurityAndComplianceAccessLevel.ValueString()))
+	}
+	if !config.SnippetsAccessLevel.IsNull() {
+		options.SnippetsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.SnippetsAccessLevel.ValueString()))
+	}
+	if !config.SuggestionCommitMessage.IsNull() {
+		options.SuggestionCommitMessage = gitlab.String(config.SuggestionCommitMessage.ValueString())
+	}
+	if !config.Topics.IsNull() {
+		topics, err := stringSetValue(ctx, config.Topics)
+		if err != nil {
+			diags.AddError("Invalid topics", err.Error())
+			return nil, diags
+		}
+		options.Topics = topics
+	}
+	if !config.WikiAccessLevel.IsNull() {
+		options.WikiAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(config.WikiAccessLevel.ValueString()))
+	}
+	if !config.SquashCommitTemplate.IsNull() {
+		options.SquashCommitTemplate = gitlab.String(config.SquashCommitTemplate.ValueString())
+	}
+	if !config.MergeCommitTemplate.IsNull() {
+		options.MergeCommitTemplate = gitlab.String(config.MergeCommitTemplate.ValueString())
+	}
+	if !config.RequestAccessEnabled.IsNull() {
+		options.RequestAccessEnabled = gitlab.Bool(config.RequestAccessEnabled.ValueBool())
+	}
+	if !config.IssuesEnabled.IsNull() {
+		// nolint:staticcheck // SA1019
+		options.IssuesEnabled = gitlab.Bool(config.IssuesEnabled.ValueBool())
+	}
+	if !config.MergeRequestsEnabled.IsNull() {
+		// nolint:staticcheck // SA1019
+		options.MergeRequestsEnabled = gitlab.Bool(config.MergeRequestsEnabled.ValueBool())
+	}
+	if !config.ApprovalsBeforeMerge.IsNull() {
+		options.ApprovalsBeforeMerge = gitlab.Int(int(config.ApprovalsBeforeMerge.ValueInt64()))
+	}
+	if !config.WikiEnabled.IsNull() {
+		// nolint:staticcheck // SA1019
+		options.WikiEnabled = gitlab.Bool(config.WikiEnabled.ValueBool())
+	}
+	if !config.SnippetsEnabled.IsNull() {
+		// nolint:staticcheck // SA1019
+		options.SnippetsEnabled = gitlab.Bool(config.SnippetsEnabled.ValueBool())
+	}
+	if !config.LfsEnabled.IsNull() {
+		options.LFSEnabled = gitlab.Bool(config.LfsEnabled.ValueBool())
+	}
+	if !config.VisibilityLevel.IsNull() {
+		options.Visibility = gitlab.Visibility(gitlab.VisibilityValue(config.VisibilityLevel.ValueString()))
+	}
+	if !config.MergeMethod.IsNull() {
+		options.MergeMethod = gitlab.MergeMethod(gitlab.MergeMethodValue(config.MergeMethod.ValueString()))
+	}
+	if !config.OnlyAllowMergeIfPipelineSucceeds.IsNull() {
+		options.OnlyAllowMergeIfPipelineSucceeds = gitlab.Bool(config.OnlyAllowMergeIfPipelineSucceeds.ValueBool())
+	}
+	if !config.OnlyAllowMergeIfAllDiscussionsAreResolved.IsNull() {
+		options.OnlyAllowMergeIfAllDiscussionsAreResolved = gitlab.Bool(config.OnlyAllowMergeIfAllDiscussionsAreResolved.ValueBool())
+	}
+	if !config.AllowMergeOnSkippedPipeline.IsNull() {
+		options.AllowMergeOnSkippedPipeline = gitlab.Bool(config.AllowMergeOnSkippedPipeline.ValueBool())
+	}
+	if !config.SharedRunnersEnabled.IsNull() {
+		options.SharedRunnersEnabled = gitlab.Bool(config.SharedRunnersEnabled.ValueBool())
+	}
+	if !config.RemoveSourceBranchAfterMerge.IsNull() {
+		options.RemoveSourceBranchAfterMerge = gitlab.Bool(config.RemoveSourceBranchAfterMerge.ValueBool())
+	}
+	if !config.PackagesEnabled.IsNull() {
+		options.PackagesEnabled = gitlab.Bool(config.PackagesEnabled.ValueBool())
+	}
+	if !config.PrintingMergeRequestLinkEnabled.IsNull() {
+		options.PrintingMergeRequestLinkEnabled = gitlab.Bool(config.PrintingMergeRequestLinkEnabled.ValueBool())
+	}
+	if !config.Mirror.IsNull() {
+		options.Mirror = gitlab.Bool(config.Mirror.ValueBool())
+	}
+	if !config.MirrorTriggerBuilds.IsNull() {
+		options.MirrorTriggerBuilds = gitlab.Bool(config.MirrorTriggerBuilds.ValueBool())
+	}
+	if supportsSquashOption && !config.SquashOption.IsNull() {
+		options.SquashOption = gitlab.SquashOption(gitlab.SquashOptionValue(config.SquashOption.ValueString()))
+	}
+
+	avatar, err := avatarToUpload(config.Avatar, config.AvatarHash, types.StringNull(), types.StringNull())
+	if err != nil {
+		diags.AddError("Unable to read avatar", err.Error())
+		return nil, diags
+	}
+	if avatar != nil {
+		options.Avatar = &gitlab.ProjectAvatar{Filename: avatar.Filename, Image: avatar.Image}
+	}
+
+	return options, diags
+}
+
+// expandEditProjectOptions returns the options to update the attributes of the project which are planned to change.
+// The avatar, the archived state, the namespace, the fork relation and the push rules are updated separately.
+func (r *gitlabProjectResource) expandEditProjectOptions(ctx context.Context, plan *gitlabProjectResourceModel, state *gitlabProjectResourceModel, supportsSquashOption bool) (*gitlab.EditProjectOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	options := &gitlab.EditProjectOptions{}
+
+	if attributeChanged(plan.Name, state.Name) {
+		options.Name = gitlab.String(plan.Name.ValueString())
+	}
+	if attributeChanged(plan.Path, state.Path) {
+		options.Path = gitlab.String(plan.Path.ValueString())
+	}
+	if attributeChanged(plan.Description, state.Description) {
+		options.Description = gitlab.String(plan.Description.ValueString())
+	}
+	if attributeChanged(plan.DefaultBranch, state.DefaultBranch) {
+		options.DefaultBranch = gitlab.String(plan.DefaultBranch.ValueString())
+	}
+	if attributeChanged(plan.VisibilityLevel, state.VisibilityLevel) {
+		options.Visibility = gitlab.Visibility(gitlab.VisibilityValue(plan.VisibilityLevel.ValueString()))
+	}
+	if attributeChanged(plan.MergeMethod, state.MergeMethod) {
+		options.MergeMethod = gitlab.MergeMethod(gitlab.MergeMethodValue(plan.MergeMethod.ValueString()))
+	}
+	if attributeChanged(plan.OnlyAllowMergeIfPipelineSucceeds, state.OnlyAllowMergeIfPipelineSucceeds) {
+		options.OnlyAllowMergeIfPipelineSucceeds = gitlab.Bool(plan.OnlyAllowMergeIfPipelineSucceeds.ValueBool())
+	}
+	if attributeChanged(plan.OnlyAllowMergeIfAllDiscussionsAreResolved, state.OnlyAllowMergeIfAllDiscussionsAreResolved) {
+		options.OnlyAllowMergeIfAllDiscussionsAreResolved = gitlab.Bool(plan.OnlyAllowMergeIfAllDiscussionsAreResolved.ValueBool())
+	}
+	if attributeChanged(plan.AllowMergeOnSkippedPipeline, state.AllowMergeOnSkippedPipeline) {
+		options.AllowMergeOnSkippedPipeline = gitlab.Bool(plan.AllowMergeOnSkippedPipeline.ValueBool())
+	}
+	if attributeChanged(plan.RestrictUserDefinedVariables, state.RestrictUserDefinedVariables) {
+		options.RestrictUserDefinedVariables = gitlab.Bool(plan.RestrictUserDefinedVariables.ValueBool())
+	}
+	if attributeChanged(plan.RequestAccessEnabled, state.RequestAccessEnabled) {
+		options.RequestAccessEnabled = gitlab.Bool(plan.RequestAccessEnabled.ValueBool())
+	}
+	if attributeChanged(plan.IssuesEnabled, state.IssuesEnabled) {
+		// nolint:staticcheck // SA1019
+		options.IssuesEnabled = gitlab.Bool(plan.IssuesEnabled.ValueBool())
+	}
+	if attributeChanged(plan.MergeRequestsEnabled, state.MergeRequestsEnabled) {
+		// nolint:staticcheck // SA1019
+		options.MergeRequestsEnabled = gitlab.Bool(plan.MergeRequestsEnabled.ValueBool())
+	}
+	if attributeChanged(plan.PipelinesEnabled, state.PipelinesEnabled) {
+		// nolint:staticcheck // SA1019
+		options.JobsEnabled = gitlab.Bool(plan.PipelinesEnabled.ValueBool())
+	}
+	if attributeChanged(plan.ApprovalsBeforeMerge, state.ApprovalsBeforeMerge) {
+		options.ApprovalsBeforeMerge = gitlab.Int(int(plan.ApprovalsBeforeMerge.ValueInt64()))
+	}
+	if attributeChanged(plan.WikiEnabled, state.WikiEnabled) {
+		// nolint:staticcheck // SA1019
+		options.WikiEnabled = gitlab.Bool(plan.WikiEnabled.ValueBool())
+	}
+	if attributeChanged(plan.SnippetsEnabled, state.SnippetsEnabled) {
+		// nolint:staticcheck // SA1019
+		options.SnippetsEnabled = gitlab.Bool(plan.SnippetsEnabled.ValueBool())
+	}
+	if attributeChanged(plan.SharedRunnersEnabled, state.SharedRunnersEnabled) {
+		options.SharedRunnersEnabled = gitlab.Bool(plan.SharedRunnersEnabled.ValueBool())
+	}
+	if attributeChanged(plan.Tags, state.Tags) {
+		tags, err := stringSetValue(ctx, plan.Tags)
+		if err != nil {
+			diags.AddError("Invalid tags", err.Error())
+			return nil, diags
+		}
+		// nolint:staticcheck // SA1019
+		options.TagList = tags
+	}
+	if attributeChanged(plan.ContainerRegistryEnabled, state.ContainerRegistryEnabled) {
+		// nolint:staticcheck // SA1019
+		options.ContainerRegistryEnabled = gitlab.Bool(plan.ContainerRegistryEnabled.ValueBool())
+	}
+	if attributeChanged(plan.LfsEnabled, state.LfsEnabled) {
+		options.LFSEnabled = gitlab.Bool(plan.LfsEnabled.ValueBool())
+	}
+	if supportsSquashOption && attributeChanged(plan.SquashOption, state.SquashOption) {
+		options.SquashOption = gitlab.SquashOption(gitlab.SquashOptionValue(plan.SquashOption.ValueString()))
+	}
+	if attributeChanged(plan.RemoveSourceBranchAfterMerge, state.RemoveSourceBranchAfterMerge) {
+		options.RemoveSourceBranchAfterMerge = gitlab.Bool(plan.RemoveSourceBranchAfterMerge.ValueBool())
+	}
+	if attributeChanged(plan.PrintingMergeRequestLinkEnabled, state.PrintingMergeRequestLinkEnabled) {
+		options.PrintingMergeRequestLinkEnabled = gitlab.Bool(plan.PrintingMergeRequestLinkEnabled.ValueBool())
+	}
+	if attributeChanged(plan.PackagesEnabled, state.PackagesEnabled) {
+		options.PackagesEnabled = gitlab.Bool(plan.PackagesEnabled.ValueBool())
+	}
+	if attributeChanged(plan.PagesAccessLevel, state.PagesAccessLevel) {
+		options.PagesAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.PagesAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.Mirror, state.Mirror) {
+		options.Mirror = gitlab.Bool(plan.Mirror.ValueBool())
+	}
+	if attributeChanged(plan.MirrorTriggerBuilds, state.MirrorTriggerBuilds) {
+		options.MirrorTriggerBuilds = gitlab.Bool(plan.MirrorTriggerBuilds.ValueBool())
+	}
+	if attributeChanged(plan.OnlyMirrorProtectedBranches, state.OnlyMirrorProtectedBranches) {
+		options.OnlyMirrorProtectedBranches = gitlab.Bool(plan.OnlyMirrorProtectedBranches.ValueBool())
+	}
+	if attributeChanged(plan.MirrorOverwritesDivergedBranches, state.MirrorOverwritesDivergedBranches) {
+		options.MirrorOverwritesDivergedBranches = gitlab.Bool(plan.MirrorOverwritesDivergedBranches.ValueBool())
+	}
+	// The GitLab API requires the import_url if the mirror options are changed.
+	if options.Mirror != nil || options.MirrorTriggerBuilds != nil || options.OnlyMirrorProtectedBranches != nil || options.MirrorOverwritesDivergedBranches != nil {
+		options.ImportURL = gitlab.String(plan.ImportUrl.ValueString())
+	}
+	if attributeChanged(plan.BuildCoverageRegex, state.BuildCoverageRegex) {
+		options.BuildCoverageRegex = gitlab.String(plan.BuildCoverageRegex.ValueString())
+	}
+	if attributeChanged(plan.IssuesTemplate, state.IssuesTemplate) {
+		options.IssuesTemplate = gitlab.String(plan.IssuesTemplate.ValueString())
+	}
+	if attributeChanged(plan.MergeRequestsTemplate, state.MergeRequestsTemplate) {
+		options.MergeRequestsTemplate = gitlab.String(plan.MergeRequestsTemplate.ValueString())
+	}
+	if attributeChanged(plan.CiConfigPath, state.CiConfigPath) {
+		options.CIConfigPath = gitlab.String(plan.CiConfigPath.ValueString())
+	}
+	if attributeChanged(plan.CiForwardDeploymentEnabled, state.CiForwardDeploymentEnabled) {
+		options.CIForwardDeploymentEnabled = gitlab.Bool(plan.CiForwardDeploymentEnabled.ValueBool())
+	}
+	if attributeChanged(plan.MergePipelinesEnabled, state.MergePipelinesEnabled) {
+		options.MergePipelinesEnabled = gitlab.Bool(plan.MergePipelinesEnabled.ValueBool())
+	}
+	if attributeChanged(plan.MergeTrainsEnabled, state.MergeTrainsEnabled) {
+		options.MergeTrainsEnabled = gitlab.Bool(plan.MergeTrainsEnabled.ValueBool())
+	}
+	if attributeChanged(plan.ResolveOutdatedDiffDiscussions, state.ResolveOutdatedDiffDiscussions) {
+		options.ResolveOutdatedDiffDiscussions = gitlab.Bool(plan.ResolveOutdatedDiffDiscussions.ValueBool())
+	}
+	if attributeChanged(plan.AnalyticsAccessLevel, state.AnalyticsAccessLevel) {
+		options.AnalyticsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.AnalyticsAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.AutoCancelPendingPipelines, state.AutoCancelPendingPipelines) {
+		options.AutoCancelPendingPipelines = gitlab.String(plan.AutoCancelPendingPipelines.ValueString())
+	}
+	if attributeChanged(plan.AutoDevopsDeployStrategy, state.AutoDevopsDeployStrategy) {
+		options.AutoDevopsDeployStrategy = gitlab.String(plan.AutoDevopsDeployStrategy.ValueString())
+	}
+	if attributeChanged(plan.AutoDevopsEnabled, state.AutoDevopsEnabled) {
+		options.AutoDevopsEnabled = gitlab.Bool(plan.AutoDevopsEnabled.ValueBool())
+	}
+	if attributeChanged(plan.AutocloseReferencedIssues, state.AutocloseReferencedIssues) {
+		options.AutocloseReferencedIssues = gitlab.Bool(plan.AutocloseReferencedIssues.ValueBool())
+	}
+	if attributeChanged(plan.BuildGitStrategy, state.BuildGitStrategy) {
+		options.BuildGitStrategy = gitlab.String(plan.BuildGitStrategy.ValueString())
+	}
+	if attributeChanged(plan.BuildTimeout, state.BuildTimeout) {
+		options.BuildTimeout = gitlab.Int(int(plan.BuildTimeout.ValueInt64()))
+	}
+	if attributeChanged(plan.BuildsAccessLevel, state.BuildsAccessLevel) {
+		options.BuildsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.BuildsAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.ContainerExpirationPolicy, state.ContainerExpirationPolicy) {
+		policy, d := expandContainerExpirationPolicyAttributes(ctx, plan.ContainerExpirationPolicy)
+		diags.Append(d...)
+		options.ContainerExpirationPolicyAttributes = policy
+	}
+	if attributeChanged(plan.ContainerRegistryAccessLevel, state.ContainerRegistryAccessLevel) {
+		options.ContainerRegistryAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.ContainerRegistryAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.EmailsDisabled, state.EmailsDisabled) {
+		options.EmailsDisabled = gitlab.Bool(plan.EmailsDisabled.ValueBool())
+	}
+	if attributeChanged(plan.ExternalAuthorizationClassificationLabel, state.ExternalAuthorizationClassificationLabel) {
+		options.ExternalAuthorizationClassificationLabel = gitlab.String(plan.ExternalAuthorizationClassificationLabel.ValueString())
+	}
+	if attributeChanged(plan.ForkingAccessLevel, state.ForkingAccessLevel) {
+		options.ForkingAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.ForkingAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.IssuesAccessLevel, state.IssuesAccessLevel) {
+		options.IssuesAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.IssuesAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.MergeRequestsAccessLevel, state.MergeRequestsAccessLevel) {
+		options.MergeRequestsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.MergeRequestsAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.OperationsAccessLevel, state.OperationsAccessLevel) {
+		options.OperationsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.OperationsAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.PublicBuilds, state.PublicBuilds) {
+		options.PublicBuilds = gitlab.Bool(plan.PublicBuilds.ValueBool())
+	}
+	if attributeChanged(plan.RepositoryAccessLevel, state.RepositoryAccessLevel) {
+		options.RepositoryAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.RepositoryAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.RepositoryStorage, state.RepositoryStorage) {
+		options.RepositoryStorage = gitlab.String(plan.RepositoryStorage.ValueString())
+	}
+	if attributeChanged(plan.RequirementsAccessLevel, state.RequirementsAccessLevel) {
+		options.RequirementsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.RequirementsAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.SecurityAndComplianceAccessLevel, state.SecurityAndComplianceAccessLevel) {
+		options.SecurityAndComplianceAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.SecurityAndComplianceAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.SnippetsAccessLevel, state.SnippetsAccessLevel) {
+		options.SnippetsAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.SnippetsAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.SuggestionCommitMessage, state.SuggestionCommitMessage) {
+		options.SuggestionCommitMessage = gitlab.String(plan.SuggestionCommitMessage.ValueString())
+	}
+	if attributeChanged(plan.Topics, state.Topics) {
+		topics, err := stringSetValue(ctx, plan.Topics)
+		if err != nil {
+			diags.AddError("Invalid topics", err.Error())
+			return nil, diags
+		}
+		options.Topics = topics
+	}
+	if attributeChanged(plan.WikiAccessLevel, state.WikiAccessLevel) {
+		options.WikiAccessLevel = gitlab.AccessControl(gitlab.AccessControlValue(plan.WikiAccessLevel.ValueString()))
+	}
+	if attributeChanged(plan.SquashCommitTemplate, state.SquashCommitTemplate) {
+		options.SquashCommitTemplate = gitlab.String(plan.SquashCommitTemplate.ValueString())
+	}
+	if attributeChanged(plan.MergeCommitTemplate, state.MergeCommitTemplate) {
+		options.MergeCommitTemplate = gitlab.String(plan.MergeCommitTemplate.ValueString())
+	}
+	if attributeChanged(plan.CiDefaultGitDepth, state.CiDefaultGitDepth) {
+		options.CIDefaultGitDepth = gitlab.Int(int(plan.CiDefaultGitDepth.ValueInt64()))
+	}
+	if attributeChanged(plan.CiSeparatedCaches, state.CiSeparatedCaches) {
+		options.CISeperateCache = gitlab.Bool(plan.CiSeparatedCaches.ValueBool())
+	}
+	if attributeChanged(plan.MrDefaultTargetSelf, state.MrDefaultTargetSelf) {
+		options.MergeRequestDefaultTargetSelf = gitlab.Bool(plan.MrDefaultTargetSelf.ValueBool())
+	}
+
+	return options, diags
+}
+
+// editOrAddPushRules sets the push rules of the project to the values of the `push_rules` attribute.
+func (r *gitlabProjectResource) editOrAddPushRules(ctx context.Context, projectID string, value types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var data gitlabPushRulesModel
+	diags.Append(value.As(ctx, &data, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(editOrAddProjectPushRules(ctx, r.client, projectID, data)...)
+	return diags
+}
+
+// replaceDefaultBranch creates the new default branch from the current one, protects it and deletes the current one.
+func (r *gitlabProjectResource) replaceDefaultBranch(ctx context.Context, project *gitlab.Project, newDefaultBranch string) error {
+	oldDefaultBranch := project.DefaultBranch
+
+	tflog.Debug(ctx, "create new default branch", map[string]interface{}{"project": project.ID, "branch": newDefaultBranch})
+	_, _, err := r.client.Branches.CreateBranch(project.ID, &gitlab.CreateBranchOptions{
+		Branch: gitlab.String(newDefaultBranch),
+		Ref:    gitlab.String(oldDefaultBranch),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to create branch %q for project %d: %w", newDefaultBranch, project.ID, err)
+	}
+
+	tflog.Debug(ctx, "set new default branch", map[string]interface{}{"project": project.ID, "branch": newDefaultBranch})
+	_, _, err = r.client.Projects.EditProject(project.ID, &gitlab.EditProjectOptions{
+		DefaultBranch: gitlab.String(newDefaultBranch),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to set default branch to %q for project %d: %w", newDefaultBranch, project.ID, err)
+	}
+
+	tflog.Debug(ctx, "protect new default branch", map[string]interface{}{"project": project.ID, "branch": newDefaultBranch})
+	_, _, err = r.client.ProtectedBranches.ProtectRepositoryBranches(project.ID, &gitlab.ProtectRepositoryBranchesOptions{
+		Name: gitlab.String(newDefaultBranch),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to protect default branch %q for project %d: %w", newDefaultBranch, project.ID, err)
+	}
+
+	tflog.Debug(ctx, "check for protection on old default branch", map[string]interface{}{"project": project.ID, "branch": oldDefaultBranch})
+	branch, _, err := r.client.ProtectedBranches.GetProtectedBranch(project.ID, oldDefaultBranch, gitlab.WithContext(ctx))
+	if err != nil && !api.Is404(err) {
+		return fmt.Errorf("failed to check for protected default branch %q for project %d: %w", oldDefaultBranch, project.ID, err)
+	}
+	if branch != nil {
+		tflog.Debug(ctx, "unprotect old default branch", map[string]interface{}{"project": project.ID, "branch": oldDefaultBranch})
+		if _, err := r.client.ProtectedBranches.UnprotectRepositoryBranches(project.ID, oldDefaultBranch, gitlab.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to unprotect undesired default branch %q for project %d: %w", oldDefaultBranch, project.ID, err)
+		}
+	}
+
+	tflog.Debug(ctx, "delete old default branch", map[string]interface{}{"project": project.ID, "branch": oldDefaultBranch})
+	if _, err := r.client.Branches.DeleteBranch(project.ID, oldDefaultBranch, gitlab.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to clean up undesired default branch %q for project %d: %w", oldDefaultBranch, project.ID, err)
+	}
+
+	project.DefaultBranch = newDefaultBranch
+	return nil
+}
+
+func (r *gitlabProjectResource) expectDefaultBranchProtection(ctx context.Context, project *gitlab.Project) (bool, error) {
+	// If the project is part of a group it may have default branch protection disabled for its projects
+	if project.Namespace.Kind == "group" {
+		group, _, err := r.client.Groups.GetGroup(project.Namespace.ID, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return false, err
+		}
+
+		return group.DefaultBranchProtection != 0, nil
+	}
+
+	currentUser, _, err := r.client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return false, fmt.Errorf("failed to check if user is admin to verify is default branch protection is enabled on instance-level: %w", err)
+	}
+
+	if currentUser.IsAdmin {
+		// If the project is not part of a group it may have default branch protection disabled because of the instance-wide application settings
+		settings, _, err := r.client.Settings.GetSettings(nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return false, err
+		}
+
+		return settings.DefaultBranchProtection != 0, nil
+	}
+
+	// NOTE: for the lack of a better solution (at least for now), we assume that the default branch protection is NOT disabled on instance-level.
+	//       To override this behavior it's best to set `skip_wait_for_default_branch_protection = true` in the resource config.
+	return true, nil
+}
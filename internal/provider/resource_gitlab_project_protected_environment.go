@@ -26,6 +26,7 @@ import (
 var _ resource.Resource = &gitlabProjectProtectedEnvironmentResource{}
 var _ resource.ResourceWithConfigure = &gitlabProjectProtectedEnvironmentResource{}
 var _ resource.ResourceWithImportState = &gitlabProjectProtectedEnvironmentResource{}
+var _ resource.ResourceWithModifyPlan = &gitlabProjectProtectedEnvironmentResource{}
 
 func init() {
 	registerResource(NewGitLabProjectProtectedEnvironmentResource)
@@ -38,7 +39,8 @@ func NewGitLabProjectProtectedEnvironmentResource() resource.Resource {
 
 // gitlabProjectProtectedEnvironmentResource defines the resource implementation.
 type gitlabProjectProtectedEnvironmentResource struct {
-	client *gitlab.Client
+	client       *gitlab.Client
+	providerData *api.ProviderData
 }
 
 // gitlabProjectProtectedEnvironmentResourceModel describes the resource data model.
@@ -48,6 +50,7 @@ type gitlabProjectProtectedEnvironmentResourceModel struct {
 	Environment           types.String                                              `tfsdk:"environment"`
 	RequiredApprovalCount types.Int64                                               `tfsdk:"required_approval_count"`
 	DeployAccessLevels    []gitlabProjectProtectedEnvironmentDeployAccessLevelModel `tfsdk:"deploy_access_levels"`
+	ApprovalRules         []gitlabProjectProtectedEnvironmentApprovalRuleModel      `tfsdk:"approval_rules"`
 }
 
 type gitlabProjectProtectedEnvironmentDeployAccessLevelModel struct {
@@ -57,6 +60,14 @@ type gitlabProjectProtectedEnvironmentDeployAccessLevelModel struct {
 	GroupId                types.Int64  `tfsdk:"group_id"`
 }
 
+type gitlabProjectProtectedEnvironmentApprovalRuleModel struct {
+	AccessLevel            types.String `tfsdk:"access_level"`
+	AccessLevelDescription types.String `tfsdk:"access_level_description"`
+	UserId                 types.Int64  `tfsdk:"user_id"`
+	GroupId                types.Int64  `tfsdk:"group_id"`
+	RequiredApprovals      types.Int64  `tfsdk:"required_approvals"`
+}
+
 func (r *gitlabProjectProtectedEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project_protected_environment"
 }
@@ -91,26 +102,23 @@ func (r *gitlabProjectProtectedEnvironmentResource) Schema(ctx context.Context,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"required_approval_count": schema.Int64Attribute{
-				MarkdownDescription: "The number of approvals required to deploy to this environment.",
+				MarkdownDescription: "The number of approvals required to deploy to this environment. GitLab recommends to use `approval_rules` instead.",
 				Optional:            true,
 				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-					int64planmodifier.UseStateForUnknown(),
-				},
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
 			},
 		},
 		Blocks: map[string]schema.Block{
 			"deploy_access_levels": schema.SetNestedBlock{
 				MarkdownDescription: "Array of access levels allowed to deploy, with each described by a hash.",
 				Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
-				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace(), setplanmodifier.UseStateForUnknown()},
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"access_level": schema.StringAttribute{
 							MarkdownDescription: fmt.Sprintf("Levels of access required to deploy to this protected environment. Valid values are %s.", utils.RenderValueListForDocs(api.ValidProtectedEnvironmentDeploymentLevelNames)),
 							Optional:            true,
-							PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 							Validators: []validator.String{
 								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("user_id"), path.MatchRelative().AtParent().AtName("group_id")),
 								stringvalidator.OneOfCaseInsensitive(api.ValidProtectedEnvironmentDeploymentLevelNames...),
@@ -124,13 +132,48 @@ func (r *gitlabProjectProtectedEnvironmentResource) Schema(ctx context.Context,
 						"user_id": schema.Int64Attribute{
 							MarkdownDescription: "The ID of the user allowed to deploy to this protected environment. The user must be a member of the project.",
 							Optional:            true,
-							PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
 							Validators:          []validator.Int64{int64validator.AtLeast(1)},
 						},
 						"group_id": schema.Int64Attribute{
 							MarkdownDescription: "The ID of the group allowed to deploy to this protected environment. The project must be shared with the group.",
 							Optional:            true,
-							PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+							Validators:          []validator.Int64{int64validator.AtLeast(1)},
+						},
+					},
+				},
+			},
+			"approval_rules": schema.SetNestedBlock{
+				MarkdownDescription: "Array of approval rules to deploy, with each described by a hash. Each rule requires its own number of approvals from a user, the members of a group or the users with an access level.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"access_level": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("Levels of access allowed to approve a deployment to this protected environment. Valid values are %s.", utils.RenderValueListForDocs(api.ValidProtectedEnvironmentDeploymentLevelNames)),
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("user_id"), path.MatchRelative().AtParent().AtName("group_id")),
+								stringvalidator.OneOfCaseInsensitive(api.ValidProtectedEnvironmentDeploymentLevelNames...),
+							},
+						},
+						"access_level_description": schema.StringAttribute{
+							MarkdownDescription: "Readable description of level of access.",
+							Computed:            true,
+							PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						},
+						"user_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the user allowed to approve a deployment to this protected environment. The user must be a member of the project.",
+							Optional:            true,
+							Validators:          []validator.Int64{int64validator.AtLeast(1)},
+						},
+						"group_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the group allowed to approve a deployment to this protected environment. The project must be shared with the group.",
+							Optional:            true,
+							Validators:          []validator.Int64{int64validator.AtLeast(1)},
+						},
+						"required_approvals": schema.Int64Attribute{
+							MarkdownDescription: "The number of approvals required from the approvers of this rule to allow deployment to this protected environment. Defaults to `1`.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers:       []planmodifier.Int64{defaultInt64(1)},
 							Validators:          []validator.Int64{int64validator.AtLeast(1)},
 						},
 					},
@@ -147,7 +190,21 @@ func (r *gitlabProjectProtectedEnvironmentResource) Configure(ctx context.Contex
 		return
 	}
 
-	r.client = req.ProviderData.(*gitlab.Client)
+	r.providerData = req.ProviderData.(*api.ProviderData)
+	r.client = r.providerData.Client
+}
+
+// ModifyPlan fails the plan if the GitLab instance doesn't support the approval rules or the in-place update of protected environments.
+func (r *gitlabProjectProtectedEnvironmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRequireFeatureForAttribute(ctx, r.providerData, req, resp, "protected_environment_approval_rules", "approval_rules")
+
+	// Changes of existing protected environments are updated in-place, which isn't supported by older GitLab versions.
+	if r.providerData == nil || req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	if err := r.providerData.Capabilities.CheckFeature(ctx, "protected_environment_update", `the update of resource "gitlab_project_protected_environment"`); err != nil {
+		resp.Diagnostics.AddError("GitLab feature unavailable", err.Error())
+	}
 }
 
 // Create creates a new upstream resources and adds it into the Terraform state.
@@ -166,7 +223,7 @@ func (r *gitlabProjectProtectedEnvironmentResource) Create(ctx context.Context,
 	environmentName := data.Environment.ValueString()
 
 	// configure GitLab API call
-	options := &gitlab.ProtectRepositoryEnvironmentsOptions{
+	options := &api.ProtectEnvironmentOptions{
 		Name: gitlab.String(environmentName),
 	}
 
@@ -175,25 +232,17 @@ func (r *gitlabProjectProtectedEnvironmentResource) Create(ctx context.Context,
 	}
 
 	// deploy access levels
-	deployAccessLevelsOption := make([]*gitlab.EnvironmentAccessOptions, len(data.DeployAccessLevels))
-	for i, v := range data.DeployAccessLevels {
-		deployAccessLevelOptions := &gitlab.EnvironmentAccessOptions{}
+	deployAccessLevelsOption := expandProtectedEnvironmentDeployAccessLevels(data.DeployAccessLevels)
+	options.DeployAccessLevels = &deployAccessLevelsOption
 
-		if !v.AccessLevel.IsNull() && v.AccessLevel.ValueString() != "" {
-			deployAccessLevelOptions.AccessLevel = gitlab.AccessLevel(api.AccessLevelNameToValue[v.AccessLevel.ValueString()])
-		}
-		if !v.UserId.IsNull() && v.UserId.ValueInt64() != 0 {
-			deployAccessLevelOptions.UserID = gitlab.Int(int(v.UserId.ValueInt64()))
-		}
-		if !v.GroupId.IsNull() && v.GroupId.ValueInt64() != 0 {
-			deployAccessLevelOptions.GroupID = gitlab.Int(int(v.GroupId.ValueInt64()))
-		}
-		deployAccessLevelsOption[i] = deployAccessLevelOptions
+	// approval rules
+	if len(data.ApprovalRules) > 0 {
+		approvalRulesOption := expandProtectedEnvironmentApprovalRules(data.ApprovalRules)
+		options.ApprovalRules = &approvalRulesOption
 	}
-	options.DeployAccessLevels = &deployAccessLevelsOption
 
 	// Protect environment
-	protectedEnvironment, _, err := r.client.ProtectedEnvironments.ProtectRepositoryEnvironments(projectID, options, gitlab.WithContext(ctx))
+	protectedEnvironment, _, err := api.ProtectEnvironment(ctx, r.client, projectID, options)
 	if err != nil {
 		if api.Is404(err) {
 			resp.Diagnostics.AddError(
@@ -243,7 +292,7 @@ func (r *gitlabProjectProtectedEnvironmentResource) Read(ctx context.Context, re
 	}
 
 	// Read environment protection
-	protectedEnvironment, _, err := r.client.ProtectedEnvironments.GetProtectedEnvironment(projectID, environmentName, gitlab.WithContext(ctx))
+	protectedEnvironment, _, err := api.GetProtectedEnvironment(ctx, r.client, projectID, environmentName)
 	if err != nil {
 		if api.Is404(err) {
 			tflog.Debug(ctx, "protected environment does not exist, removing from state", map[string]interface{}{
@@ -261,11 +310,69 @@ func (r *gitlabProjectProtectedEnvironmentResource) Read(ctx context.Context, re
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Report who changed the protected environment outside of Terraform
+	resp.Diagnostics.Append(driftReportDiagnostics(ctx, r.providerData, req, resp, "gitlab_project_protected_environment", api.AuditedResource{
+		Project:       projectID,
+		TargetType:    "ProtectedEnvironment",
+		TargetDetails: environmentName,
+	}, "required_approval_count", "deploy_access_levels", "approval_rules")...)
 }
 
-// Updates updates the resource in-place.
+// Update updates the resource in-place.
+// The deploy access levels and approval rules are changed in a single request,
+// so that the environment stays protected while they are changed.
 func (r *gitlabProjectProtectedEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Provider Error, report upstream", "Somehow the resource was requested to perform an in-place upgrade which is not possible.")
+	var data *gitlabProjectProtectedEnvironmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, environmentName, err := utils.ParseTwoPartID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid resource ID format",
+			fmt.Sprintf("The resource ID '%s' has an invalid format. It should be '<project>:<environment-name>'. Error: %s", data.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// The IDs of the existing deploy access levels and approval rules are required to update or remove them.
+	existing, _, err := api.GetProtectedEnvironment(ctx, r.client, projectID, environmentName)
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read protected environment details: %s", err.Error()))
+		return
+	}
+
+	deployAccessLevelsOption := diffProtectedEnvironmentDeployAccessLevels(existing.DeployAccessLevels, data.DeployAccessLevels)
+	approvalRulesOption := diffProtectedEnvironmentApprovalRules(existing.ApprovalRules, data.ApprovalRules)
+	options := &api.ProtectEnvironmentOptions{
+		DeployAccessLevels: &deployAccessLevelsOption,
+		ApprovalRules:      &approvalRulesOption,
+	}
+	if !data.RequiredApprovalCount.IsNull() && !data.RequiredApprovalCount.IsUnknown() {
+		options.RequiredApprovalCount = gitlab.Int(int(data.RequiredApprovalCount.ValueInt64()))
+	}
+
+	protectedEnvironment, _, err := api.UpdateProtectedEnvironment(ctx, r.client, projectID, environmentName, options)
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to update protected environment: %s", err.Error()))
+		return
+	}
+
+	// persist API response in state model
+	r.protectedEnvironmentToStateModel(data.Project.ValueString(), protectedEnvironment, data)
+
+	tflog.Debug(ctx, "updated a protected environment", map[string]interface{}{
+		"project": projectID, "environment": environmentName,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Deletes removes the resource.
@@ -302,13 +409,39 @@ func (r *gitlabProjectProtectedEnvironmentResource) ImportState(ctx context.Cont
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *gitlabProjectProtectedEnvironmentResource) protectedEnvironmentToStateModel(projectID string, protectedEnvironment *gitlab.ProtectedEnvironment, data *gitlabProjectProtectedEnvironmentResourceModel) {
+func (r *gitlabProjectProtectedEnvironmentResource) protectedEnvironmentToStateModel(projectID string, protectedEnvironment *api.ProtectedEnvironment, data *gitlabProjectProtectedEnvironmentResourceModel) {
 	data.Project = types.StringValue(projectID)
 	data.Environment = types.StringValue(protectedEnvironment.Name)
 	data.RequiredApprovalCount = types.Int64Value(int64(protectedEnvironment.RequiredApprovalCount))
 
-	deployAccessLevelsData := make([]gitlabProjectProtectedEnvironmentDeployAccessLevelModel, len(protectedEnvironment.DeployAccessLevels))
-	for i, v := range protectedEnvironment.DeployAccessLevels {
+	data.DeployAccessLevels = flattenProtectedEnvironmentDeployAccessLevels(protectedEnvironment.DeployAccessLevels)
+	data.ApprovalRules = flattenProtectedEnvironmentApprovalRules(protectedEnvironment.ApprovalRules)
+}
+
+// expandProtectedEnvironmentDeployAccessLevels converts the deploy access levels of a protected environment to the API options.
+func expandProtectedEnvironmentDeployAccessLevels(deployAccessLevels []gitlabProjectProtectedEnvironmentDeployAccessLevelModel) []*api.EnvironmentAccessOptions {
+	deployAccessLevelsOption := make([]*api.EnvironmentAccessOptions, len(deployAccessLevels))
+	for i, v := range deployAccessLevels {
+		deployAccessLevelOptions := &api.EnvironmentAccessOptions{}
+
+		if !v.AccessLevel.IsNull() && v.AccessLevel.ValueString() != "" {
+			deployAccessLevelOptions.AccessLevel = gitlab.AccessLevel(api.AccessLevelNameToValue[v.AccessLevel.ValueString()])
+		}
+		if !v.UserId.IsNull() && v.UserId.ValueInt64() != 0 {
+			deployAccessLevelOptions.UserID = gitlab.Int(int(v.UserId.ValueInt64()))
+		}
+		if !v.GroupId.IsNull() && v.GroupId.ValueInt64() != 0 {
+			deployAccessLevelOptions.GroupID = gitlab.Int(int(v.GroupId.ValueInt64()))
+		}
+		deployAccessLevelsOption[i] = deployAccessLevelOptions
+	}
+	return deployAccessLevelsOption
+}
+
+// flattenProtectedEnvironmentDeployAccessLevels converts the deploy access levels of a protected environment to the state model.
+func flattenProtectedEnvironmentDeployAccessLevels(deployAccessLevels []*api.EnvironmentAccessDescription) []gitlabProjectProtectedEnvironmentDeployAccessLevelModel {
+	deployAccessLevelsData := make([]gitlabProjectProtectedEnvironmentDeployAccessLevelModel, len(deployAccessLevels))
+	for i, v := range deployAccessLevels {
 		deployAccessLevelData := gitlabProjectProtectedEnvironmentDeployAccessLevelModel{
 			AccessLevelDescription: types.StringValue(v.AccessLevelDescription),
 		}
@@ -324,5 +457,132 @@ func (r *gitlabProjectProtectedEnvironmentResource) protectedEnvironmentToStateM
 
 		deployAccessLevelsData[i] = deployAccessLevelData
 	}
-	data.DeployAccessLevels = deployAccessLevelsData
+	return deployAccessLevelsData
+}
+
+// expandProtectedEnvironmentApprovalRules converts the approval rules of a protected environment to the API options.
+func expandProtectedEnvironmentApprovalRules(approvalRules []gitlabProjectProtectedEnvironmentApprovalRuleModel) []*api.EnvironmentApprovalRuleOptions {
+	approvalRulesOption := make([]*api.EnvironmentApprovalRuleOptions, len(approvalRules))
+	for i, v := range approvalRules {
+		approvalRuleOptions := &api.EnvironmentApprovalRuleOptions{}
+
+		if !v.AccessLevel.IsNull() && v.AccessLevel.ValueString() != "" {
+			approvalRuleOptions.AccessLevel = gitlab.AccessLevel(api.AccessLevelNameToValue[v.AccessLevel.ValueString()])
+		}
+		if !v.UserId.IsNull() && v.UserId.ValueInt64() != 0 {
+			approvalRuleOptions.UserID = gitlab.Int(int(v.UserId.ValueInt64()))
+		}
+		if !v.GroupId.IsNull() && v.GroupId.ValueInt64() != 0 {
+			approvalRuleOptions.GroupID = gitlab.Int(int(v.GroupId.ValueInt64()))
+		}
+		if !v.RequiredApprovals.IsNull() && !v.RequiredApprovals.IsUnknown() {
+			approvalRuleOptions.RequiredApprovals = gitlab.Int(int(v.RequiredApprovals.ValueInt64()))
+		}
+		approvalRulesOption[i] = approvalRuleOptions
+	}
+	return approvalRulesOption
+}
+
+// flattenProtectedEnvironmentApprovalRules converts the approval rules of a protected environment to the state model.
+func flattenProtectedEnvironmentApprovalRules(approvalRules []*api.EnvironmentApprovalRule) []gitlabProjectProtectedEnvironmentApprovalRuleModel {
+	approvalRulesData := make([]gitlabProjectProtectedEnvironmentApprovalRuleModel, len(approvalRules))
+	for i, v := range approvalRules {
+		approvalRuleData := gitlabProjectProtectedEnvironmentApprovalRuleModel{
+			AccessLevelDescription: types.StringValue(v.AccessLevelDescription),
+			RequiredApprovals:      types.Int64Value(int64(v.RequiredApprovals)),
+		}
+		if v.AccessLevel != 0 {
+			approvalRuleData.AccessLevel = types.StringValue(api.AccessLevelValueToName[v.AccessLevel])
+		}
+		if v.UserID != 0 {
+			approvalRuleData.UserId = types.Int64Value(int64(v.UserID))
+		}
+		if v.GroupID != 0 {
+			approvalRuleData.GroupId = types.Int64Value(int64(v.GroupID))
+		}
+
+		approvalRulesData[i] = approvalRuleData
+	}
+	return approvalRulesData
+}
+
+// protectedEnvironmentApproverKey identifies who is allowed to deploy or approve a deployment,
+// to match the planned deploy access levels and approval rules with the existing ones.
+func protectedEnvironmentApproverKey(accessLevel gitlab.AccessLevelValue, userID int, groupID int) string {
+	// The access level of a user or group isn't configured, so it's ignored even if GitLab returns one.
+	if userID != 0 || groupID != 0 {
+		accessLevel = 0
+	}
+	return fmt.Sprintf("%d:%d:%d", accessLevel, userID, groupID)
+}
+
+// protectedEnvironmentOptionsApproverKey is the protectedEnvironmentApproverKey of API options.
+func protectedEnvironmentOptionsApproverKey(accessLevel *gitlab.AccessLevelValue, userID *int, groupID *int) string {
+	var a gitlab.AccessLevelValue
+	var u, g int
+	if accessLevel != nil {
+		a = *accessLevel
+	}
+	if userID != nil {
+		u = *userID
+	}
+	if groupID != nil {
+		g = *groupID
+	}
+	return protectedEnvironmentApproverKey(a, u, g)
+}
+
+// diffProtectedEnvironmentDeployAccessLevels returns the API options to change the existing deploy access levels to the planned ones.
+// The existing deploy access levels which aren't planned are removed and the planned ones which don't exist are added.
+func diffProtectedEnvironmentDeployAccessLevels(existing []*api.EnvironmentAccessDescription, planned []gitlabProjectProtectedEnvironmentDeployAccessLevelModel) []*api.EnvironmentAccessOptions {
+	plannedOptions := expandProtectedEnvironmentDeployAccessLevels(planned)
+	plannedKeys := make(map[string]bool, len(plannedOptions))
+	for _, v := range plannedOptions {
+		plannedKeys[protectedEnvironmentOptionsApproverKey(v.AccessLevel, v.UserID, v.GroupID)] = true
+	}
+
+	options := []*api.EnvironmentAccessOptions{}
+	existingKeys := make(map[string]bool, len(existing))
+	for _, v := range existing {
+		key := protectedEnvironmentApproverKey(v.AccessLevel, v.UserID, v.GroupID)
+		existingKeys[key] = true
+		if !plannedKeys[key] {
+			options = append(options, &api.EnvironmentAccessOptions{ID: gitlab.Int(v.ID), Destroy: gitlab.Bool(true)})
+		}
+	}
+	for _, v := range plannedOptions {
+		if !existingKeys[protectedEnvironmentOptionsApproverKey(v.AccessLevel, v.UserID, v.GroupID)] {
+			options = append(options, v)
+		}
+	}
+	return options
+}
+
+// diffProtectedEnvironmentApprovalRules returns the API options to change the existing approval rules to the planned ones.
+// The required approvals of existing approval rules are updated in-place.
+func diffProtectedEnvironmentApprovalRules(existing []*api.EnvironmentApprovalRule, planned []gitlabProjectProtectedEnvironmentApprovalRuleModel) []*api.EnvironmentApprovalRuleOptions {
+	expanded := expandProtectedEnvironmentApprovalRules(planned)
+	plannedOptions := make(map[string]*api.EnvironmentApprovalRuleOptions, len(expanded))
+	for _, v := range expanded {
+		plannedOptions[protectedEnvironmentOptionsApproverKey(v.AccessLevel, v.UserID, v.GroupID)] = v
+	}
+
+	options := []*api.EnvironmentApprovalRuleOptions{}
+	for _, v := range existing {
+		key := protectedEnvironmentApproverKey(v.AccessLevel, v.UserID, v.GroupID)
+		plannedOption, ok := plannedOptions[key]
+		switch {
+		case !ok:
+			options = append(options, &api.EnvironmentApprovalRuleOptions{ID: gitlab.Int(v.ID), Destroy: gitlab.Bool(true)})
+		case plannedOption.RequiredApprovals != nil && *plannedOption.RequiredApprovals != v.RequiredApprovals:
+			options = append(options, &api.EnvironmentApprovalRuleOptions{ID: gitlab.Int(v.ID), RequiredApprovals: plannedOption.RequiredApprovals})
+		}
+		delete(plannedOptions, key)
+	}
+	for _, v := range expanded {
+		if _, ok := plannedOptions[protectedEnvironmentOptionsApproverKey(v.AccessLevel, v.UserID, v.GroupID)]; ok {
+			options = append(options, v)
+		}
+	}
+	return options
 }
@@ -4,8 +4,11 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -305,6 +308,149 @@ func TestAcc_GitlabProjectProtectedEnvironment_EnsureDeployAccessLevelsAreUnorde
 	})
 }
 
+func TestAcc_GitlabProjectProtectedEnvironment_approvalRulesUpdatedInPlace(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	// Set up project environment.
+	project := testutil.CreateProject(t)
+	environment := testutil.CreateProjectEnvironment(t, project.ID, &gitlab.CreateEnvironmentOptions{
+		Name: gitlab.String(acctest.RandomWithPrefix("test-protected-environment")),
+	})
+
+	// Set up project user.
+	user := testutil.CreateUsers(t, 1)[0]
+	testutil.AddProjectMembers(t, project.ID, []*gitlab.User{user})
+
+	// Set up group access.
+	group := testutil.CreateGroups(t, 1)[0]
+	if _, err := testutil.TestGitlabClient.Projects.ShareProjectWithGroup(project.ID, &gitlab.ShareWithGroupOptions{
+		GroupID:     &group.ID,
+		GroupAccess: gitlab.AccessLevel(gitlab.MaintainerPermissions),
+	}); err != nil {
+		t.Fatalf("unable to share project %d with group %d", project.ID, group.ID)
+	}
+
+	// The ID of the approval rule created in the first step, which must be kept by the update.
+	var approvalRuleID int
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectProtectedEnvironment_CheckDestroy(project.ID, environment.Name),
+		Steps: []resource.TestStep{
+			// Create a protected environment with an approval rule.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_protected_environment" "this" {
+					project     = %d
+					environment = %q
+
+					deploy_access_levels {
+						access_level = "developer"
+					}
+
+					approval_rules {
+						access_level = "maintainer"
+					}
+				}`, project.ID, environment.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_protected_environment.this", "approval_rules.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_project_protected_environment.this", "approval_rules.0.required_approvals", "1"),
+					resource.TestCheckResourceAttrSet("gitlab_project_protected_environment.this", "approval_rules.0.access_level_description"),
+					func(*terraform.State) error {
+						pe, _, err := api.GetProtectedEnvironment(context.Background(), testutil.TestGitlabClient, strconv.Itoa(project.ID), environment.Name)
+						if err != nil {
+							return fmt.Errorf("unable to get protected environment: %w", err)
+						}
+						if len(pe.ApprovalRules) != 1 {
+							return fmt.Errorf("expected 1 approval rule, got %d", len(pe.ApprovalRules))
+						}
+						approvalRuleID = pe.ApprovalRules[0].ID
+						return nil
+					},
+				),
+			},
+			// Verify upstream attributes with an import.
+			{
+				ResourceName:      "gitlab_project_protected_environment.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Change the approvers and the deploy access levels without replacing the protected environment.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_protected_environment" "this" {
+					project     = %d
+					environment = %q
+
+					deploy_access_levels {
+						access_level = "maintainer"
+					}
+
+					deploy_access_levels {
+						group_id = %d
+					}
+
+					approval_rules {
+						access_level       = "maintainer"
+						required_approvals = 2
+					}
+
+					approval_rules {
+						user_id = %d
+					}
+
+					approval_rules {
+						group_id           = %d
+						required_approvals = 3
+					}
+				}`, project.ID, environment.Name, group.ID, user.ID, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_protected_environment.this", "deploy_access_levels.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_project_protected_environment.this", "approval_rules.#", "3"),
+					func(*terraform.State) error {
+						pe, _, err := api.GetProtectedEnvironment(context.Background(), testutil.TestGitlabClient, strconv.Itoa(project.ID), environment.Name)
+						if err != nil {
+							return fmt.Errorf("unable to get protected environment: %w", err)
+						}
+						for _, rule := range pe.ApprovalRules {
+							if rule.ID != approvalRuleID {
+								continue
+							}
+							if rule.RequiredApprovals != 2 {
+								return fmt.Errorf("expected approval rule %d to require 2 approvals, got %d", approvalRuleID, rule.RequiredApprovals)
+							}
+							return nil
+						}
+						return fmt.Errorf("approval rule %d was replaced instead of updated in place", approvalRuleID)
+					},
+				),
+			},
+			// Verify upstream attributes with an import.
+			{
+				ResourceName:      "gitlab_project_protected_environment.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove the approval rules.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_protected_environment" "this" {
+					project     = %d
+					environment = %q
+
+					deploy_access_levels {
+						access_level = "maintainer"
+					}
+				}`, project.ID, environment.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_protected_environment.this", "deploy_access_levels.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_project_protected_environment.this", "approval_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAcc_GitlabProjectProtectedEnvironment_CheckDestroy(projectID int, environmentName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, _, err := testutil.TestGitlabClient.ProtectedEnvironments.GetProtectedEnvironment(projectID, environmentName)
@@ -317,3 +463,33 @@ func testAcc_GitlabProjectProtectedEnvironment_CheckDestroy(projectID int, envir
 		return nil
 	}
 }
+
+func TestAcc_GitlabProjectProtectedEnvironment_approvalRulesRequirePremium(t *testing.T) {
+	project := testutil.CreateProject(t)
+	environment := testutil.CreateProjectEnvironment(t, project.ID, &gitlab.CreateEnvironmentOptions{
+		Name: gitlab.String(acctest.RandomWithPrefix("test-protected-environment")),
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: testutil.IsRunningInEE,
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_protected_environment" "this" {
+					project     = %d
+					environment = %q
+
+					deploy_access_levels {
+						access_level = "developer"
+					}
+
+					approval_rules {
+						access_level = "maintainer"
+					}
+				}`, project.ID, environment.Name),
+				ExpectError: regexp.MustCompile(`attribute "approval_rules" requires GitLab >= 14.10 / Premium`),
+			},
+		},
+	})
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &gitlabProjectPushRulesResource{}
+var _ resource.ResourceWithConfigure = &gitlabProjectPushRulesResource{}
+var _ resource.ResourceWithImportState = &gitlabProjectPushRulesResource{}
+var _ resource.ResourceWithModifyPlan = &gitlabProjectPushRulesResource{}
+
+func init() {
+	registerResource(NewGitLabProjectPushRulesResource)
+}
+
+// NewGitLabProjectPushRulesResource is a helper function to simplify the provider implementation.
+func NewGitLabProjectPushRulesResource() resource.Resource {
+	return &gitlabProjectPushRulesResource{}
+}
+
+// gitlabProjectPushRulesResource defines the resource implementation.
+type gitlabProjectPushRulesResource struct {
+	client       *gitlab.Client
+	providerData *api.ProviderData
+}
+
+// gitlabProjectPushRulesResourceModel describes the resource data model.
+type gitlabProjectPushRulesResourceModel struct {
+	Id                         types.String `tfsdk:"id"`
+	Project                    types.String `tfsdk:"project"`
+	AuthorEmailRegex           types.String `tfsdk:"author_email_regex"`
+	BranchNameRegex            types.String `tfsdk:"branch_name_regex"`
+	CommitMessageRegex         types.String `tfsdk:"commit_message_regex"`
+	CommitMessageNegativeRegex types.String `tfsdk:"commit_message_negative_regex"`
+	FileNameRegex              types.String `tfsdk:"file_name_regex"`
+	CommitCommitterCheck       types.Bool   `tfsdk:"commit_committer_check"`
+	DenyDeleteTag              types.Bool   `tfsdk:"deny_delete_tag"`
+	MemberCheck                types.Bool   `tfsdk:"member_check"`
+	PreventSecrets             types.Bool   `tfsdk:"prevent_secrets"`
+	RejectUnsignedCommits      types.Bool   `tfsdk:"reject_unsigned_commits"`
+	MaxFileSize                types.Int64  `tfsdk:"max_file_size"`
+}
+
+func (r *gitlabProjectPushRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_push_rules"
+}
+
+func (r *gitlabProjectPushRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := gitlabPushRulesAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the project.",
+		Computed:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	attributes["project"] = schema.StringAttribute{
+		MarkdownDescription: "The ID or full path of the project.",
+		Required:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`gitlab_project_push_rules`" + ` resource allows to manage the lifecycle of the push rules of a project.
+It allows to manage the push rules separately from the project, e.g. by another team.
+
+~> The push rules must not be managed by the ` + "`push_rules`" + ` attribute of the ` + "`gitlab_project`" + ` resource at the same time.
+   The ` + "`push_rules`" + ` attribute isn't managed if it's not configured, so that both resources can be used together.
+   If the push rules already exist when this resource is created, they are updated to the configured values.
+
+-> Push rules require GitLab Premium. Attributes which aren't configured are reset to their defaults.
+   Destroying the resource removes the push rules from the project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#push-rules)`,
+		Attributes: attributes,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gitlabProjectPushRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.providerData = req.ProviderData.(*api.ProviderData)
+	r.client = r.providerData.Client
+}
+
+// ModifyPlan fails the plan if the GitLab instance doesn't support the resource.
+func (r *gitlabProjectPushRulesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRequireFeature(ctx, r.providerData, req, resp, "project_push_rules", "gitlab_project_push_rules")
+}
+
+// Create creates the push rules of the project, or updates the existing ones, and adds them into the Terraform state.
+func (r *gitlabProjectPushRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *gitlabProjectPushRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID of the resource is the ID of the project, also if it's configured by its full path.
+	projectID, err := api.LookupProjectID(ctx, r.client, data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read project %q: %s", data.Project.ValueString(), err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(editOrAddProjectPushRules(ctx, r.client, projectID, data.pushRules())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "created project push rules", map[string]interface{}{"project": projectID})
+
+	data.Id = types.StringValue(projectID)
+	resp.Diagnostics.Append(r.readAfterWrite(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gitlabProjectPushRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *gitlabProjectPushRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exists, diags := r.read(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, "project push rules do not exist, removing from state", map[string]interface{}{"project": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the push rules of the project in-place.
+func (r *gitlabProjectPushRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *gitlabProjectPushRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(editOrAddProjectPushRules(ctx, r.client, data.Id.ValueString(), data.pushRules())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readAfterWrite(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the push rules from the project.
+func (r *gitlabProjectPushRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *gitlabProjectPushRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.Projects.DeleteProjectPushRule(data.Id.ValueString(), gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to delete push rules of project %q: %s", data.Id.ValueString(), err.Error()))
+	}
+}
+
+// ImportState imports the resource into the Terraform state.
+// The push rules can be imported by the ID or the full path of the project, which is resolved to the ID.
+// The project is kept as given, so that it matches the configuration.
+func (r *gitlabProjectPushRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, err := api.LookupProjectID(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("GitLab API error occurred", fmt.Sprintf("Unable to import push rules of project %q: %s", req.ID, err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), req.ID)...)
+}
+
+// read sets the push rules of the project in the model and returns false if the project has no push rules.
+func (r *gitlabProjectPushRulesResource) read(ctx context.Context, data *gitlabProjectPushRulesResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projectID := data.Id.ValueString()
+	pushRules, _, err := r.client.Projects.GetProjectPushRules(projectID, gitlab.WithContext(ctx))
+	if err != nil && !api.Is404(err) {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Unable to read push rules of project %q: %s", projectID, err.Error()))
+		return false, diags
+	}
+
+	// NOTE: push rules id `0` indicates that there haven't been any push rules set.
+	if pushRules == nil || pushRules.ID == 0 {
+		return false, diags
+	}
+	data.setPushRules(projectPushRulesToModel(pushRules))
+	return true, diags
+}
+
+// readAfterWrite sets the push rules of the project in the model after they have been created or updated.
+func (r *gitlabProjectPushRulesResource) readAfterWrite(ctx context.Context, data *gitlabProjectPushRulesResourceModel) diag.Diagnostics {
+	exists, diags := r.read(ctx, data)
+	if !diags.HasError() && !exists {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("The push rules of project %q don't exist after they have been set", data.Id.ValueString()))
+	}
+	return diags
+}
+
+// pushRules returns the push rules of the model.
+func (data *gitlabProjectPushRulesResourceModel) pushRules() gitlabPushRulesModel {
+	return gitlabPushRulesModel{
+		AuthorEmailRegex:           data.AuthorEmailRegex,
+		BranchNameRegex:            data.BranchNameRegex,
+		CommitMessageRegex:         data.CommitMessageRegex,
+		CommitMessageNegativeRegex: data.CommitMessageNegativeRegex,
+		FileNameRegex:              data.FileNameRegex,
+		CommitCommitterCheck:       data.CommitCommitterCheck,
+		DenyDeleteTag:              data.DenyDeleteTag,
+		MemberCheck:                data.MemberCheck,
+		PreventSecrets:             data.PreventSecrets,
+		RejectUnsignedCommits:      data.RejectUnsignedCommits,
+		MaxFileSize:                data.MaxFileSize,
+	}
+}
+
+// setPushRules sets the push rules in the model.
+func (data *gitlabProjectPushRulesResourceModel) setPushRules(pushRules gitlabPushRulesModel) {
+	data.AuthorEmailRegex = pushRules.AuthorEmailRegex
+	data.BranchNameRegex = pushRules.BranchNameRegex
+	data.CommitMessageRegex = pushRules.CommitMessageRegex
+	data.CommitMessageNegativeRegex = pushRules.CommitMessageNegativeRegex
+	data.FileNameRegex = pushRules.FileNameRegex
+	data.CommitCommitterCheck = pushRules.CommitCommitterCheck
+	data.DenyDeleteTag = pushRules.DenyDeleteTag
+	data.MemberCheck = pushRules.MemberCheck
+	data.PreventSecrets = pushRules.PreventSecrets
+	data.RejectUnsignedCommits = pushRules.RejectUnsignedCommits
+	data.MaxFileSize = pushRules.MaxFileSize
+}
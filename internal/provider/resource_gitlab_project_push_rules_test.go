@@ -0,0 +1,117 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAcc_GitlabProjectPushRules_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	project := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAcc_GitlabProjectPushRules_CheckDestroy(project.ID),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_push_rules" "this" {
+						project            = %d
+						author_email_regex = "@example\\.com$"
+						member_check       = true
+					}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.this", "id", fmt.Sprintf("%d", project.ID)),
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.this", "author_email_regex", "@example\\.com$"),
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.this", "member_check", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.this", "prevent_secrets", "false"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_push_rules.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Unconfigured attributes are reset to their defaults
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_push_rules" "this" {
+						project         = %d
+						prevent_secrets = true
+					}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.this", "author_email_regex", ""),
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.this", "member_check", "false"),
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.this", "prevent_secrets", "true"),
+				),
+			},
+			// Import by the full path of the project
+			{
+				ResourceName:            "gitlab_project_push_rules.this",
+				ImportState:             true,
+				ImportStateId:           project.PathWithNamespace,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"project"},
+			},
+		},
+	})
+}
+
+// The push rules of a project are managed by the standalone resource, while the project doesn't configure them.
+func TestAcc_GitlabProjectPushRules_withProject(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	config := fmt.Sprintf(`
+		resource "gitlab_project" "this" {
+			name             = "%s"
+			visibility_level = "public"
+		}
+
+		resource "gitlab_project_push_rules" "this" {
+			project         = gitlab_project.this.id
+			deny_delete_tag = true
+		}`, acctest.RandomWithPrefix("acctest"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("gitlab_project_push_rules.this", "deny_delete_tag", "true"),
+			},
+			// The project doesn't plan to change the push rules
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAcc_GitlabProjectPushRules_CheckDestroy(projectID int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		pushRules, _, err := testutil.TestGitlabClient.Projects.GetProjectPushRules(projectID)
+		if api.Is404(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if pushRules != nil && pushRules.ID != 0 {
+			return fmt.Errorf("the push rules of project %d still exist", projectID)
+		}
+		return nil
+	}
+}
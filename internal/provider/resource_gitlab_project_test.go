@@ -1,20 +1,26 @@
 //go:build acceptance
 // +build acceptance
 
-package sdk
+package provider
 
 import (
 	"context"
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/xanzy/go-gitlab"
 
@@ -27,12 +33,148 @@ type testAccGitlabProjectExpectedAttributes struct {
 	DefaultBranch string
 }
 
+func TestAcc_GitlabProject_UpgradeFromSDKToFramework(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testProjectName := acctest.RandomWithPrefix("acctest")
+
+	// The SDK implementation used blocks for the nested attributes.
+	sdkConfig := fmt.Sprintf(`
+	resource "gitlab_project" "this" {
+		name                   = "%s"
+		visibility_level       = "public"
+		initialize_with_readme = true
+
+		push_rules {
+			author_email_regex = "@example\\.com$"
+			member_check       = true
+		}
+
+		container_expiration_policy {
+			enabled = true
+			cadence = "1month"
+		}
+	}`, testProjectName)
+
+	frameworkConfig := fmt.Sprintf(`
+	resource "gitlab_project" "this" {
+		name                   = "%s"
+		visibility_level       = "public"
+		initialize_with_readme = true
+
+		push_rules = {
+			author_email_regex = "@example\\.com$"
+			member_check       = true
+		}
+
+		container_expiration_policy = {
+			enabled = true
+			cadence = "1month"
+		}
+	}`, testProjectName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		CheckDestroy: testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"gitlab": {
+						VersionConstraint: "~> 15.7.1",
+						Source:            "gitlabhq/gitlab",
+					},
+				},
+				Config: sdkConfig,
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config:                   frameworkConfig,
+				PlanOnly:                 true,
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config:                   frameworkConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.this", "push_rules.author_email_regex", "@example\\.com$"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "push_rules.member_check", "true"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.cadence", "1month"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProject_pushRulesAbsentAndEmpty(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	testProjectName := acctest.RandomWithPrefix("acctest")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			// Without the attribute the push rules aren't managed
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name             = "%s"
+						visibility_level = "public"
+					}`, testProjectName),
+				Check: resource.TestCheckNoResourceAttr("gitlab_project.this", "push_rules"),
+			},
+			// An empty object creates push rules with the defaults
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name             = "%s"
+						visibility_level = "public"
+						push_rules       = {}
+					}`, testProjectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.this", "push_rules.member_check", "false"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "push_rules.max_file_size", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name             = "%s"
+						visibility_level = "public"
+						push_rules       = {
+							member_check = true
+						}
+					}`, testProjectName),
+				Check: testAccCheckGitlabProjectPushRules("gitlab_project.this", &gitlab.ProjectPushRules{
+					MemberCheck: true,
+				}),
+			},
+			// Unconfigured nested attributes are reset to their defaults
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name             = "%s"
+						visibility_level = "public"
+						push_rules       = {}
+					}`, testProjectName),
+				Check: testAccCheckGitlabProjectPushRules("gitlab_project.this", &gitlab.ProjectPushRules{
+					MemberCheck: false,
+				}),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccGitlabProject_minimal(t *testing.T) {
 	var received gitlab.Project
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -65,7 +207,7 @@ func TestAccGitlabProject_basic(t *testing.T) {
 	defaultsMainBranch.DefaultBranch = "main"
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			// Create a project with all the features on (note: "archived" is "false")
@@ -243,8 +385,8 @@ max_file_size = 1234
 					AuthorEmailRegex: "foo_author",
 				}),
 			},
-			// Remove the push_rules block entirely.
-			// NOTE: The push rules will still exist upstream because the push_rules block is computed.
+			// Remove the push_rules attribute entirely.
+			// NOTE: The push rules will still exist upstream because the push_rules attribute is computed.
 			{
 				SkipFunc: testutil.IsRunningInCE,
 				Config:   testAccGitlabProjectConfigDefaultBranch(rInt, "main"),
@@ -252,7 +394,7 @@ max_file_size = 1234
 					AuthorEmailRegex: "foo_author",
 				}),
 			},
-			// Add different push rules after the block was removed previously
+			// Add different push rules after the attribute was removed previously
 			{
 				SkipFunc: testutil.IsRunningInCE,
 				Config:   testAccGitlabProjectConfigPushRules(rInt, `branch_name_regex = "(feature|hotfix)\\/*"`),
@@ -272,7 +414,7 @@ func TestAccGitlabProject_templates(t *testing.T) {
 	templateProject := testAccGitLabProjectCreateTemplateProject(t, templateFileName)
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			// Create a project using custom template name
@@ -319,7 +461,7 @@ func TestAccGitlabProject_PushRules(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			// Create a new project with push rules
@@ -369,7 +511,7 @@ func TestAccGitlabProject_initializeWithReadme(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -396,7 +538,7 @@ func TestAccGitlabProject_initializeWithoutReadme(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -424,7 +566,7 @@ func TestAccGitlabProject_archiveOnDestroy(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectArchivedOnDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -438,7 +580,7 @@ func TestAccGitlabProject_setSinglePushRuleToDefault(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -459,7 +601,7 @@ func TestAccGitlabProject_groupWithoutDefaultBranchProtection(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6MuxProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -483,7 +625,7 @@ func TestAccGitlabProject_IssueMergeRequestTemplates(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -513,7 +655,7 @@ func TestAccGitlabProject_MergePipelines(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -539,7 +681,7 @@ func TestAccGitlabProject_MergeTrains(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -565,7 +707,7 @@ func TestAccGitlabProject_willErrorOnAPIFailure(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			// Step0 Create a project
@@ -612,7 +754,7 @@ func TestAccGitlabProject_willErrorOnAPIFailure(t *testing.T) {
 func TestAccGitlabProject_import(t *testing.T) {
 	rInt := acctest.RandInt()
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -636,7 +778,7 @@ func TestAccGitlabProject_import(t *testing.T) {
 func TestAccGitlabProject_nestedImport(t *testing.T) {
 	rInt := acctest.RandInt()
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6MuxProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -687,7 +829,7 @@ func TestAccGitlabProject_transfer(t *testing.T) {
 	pathAfterTransfer := fmt.Sprintf("foo2group-%d/foo-%d", rInt, rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6MuxProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			// Create a project in a group
@@ -737,7 +879,7 @@ func TestAccGitlabProject_importURL(t *testing.T) {
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -799,7 +941,7 @@ func TestAccGitlabProject_importURLWithPassword(t *testing.T) {
 	importUrl := strings.ReplaceAll(baseProject.HTTPURLToRepo, "://", fmt.Sprintf("://root:%s@", token.Token))
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -834,7 +976,7 @@ func TestAccGitlabProject_initializeWithReadmeAndCustomDefaultBranch(t *testing.
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -884,7 +1026,7 @@ func TestAccGitlabProject_restirctUserDefinedVariables(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -918,7 +1060,7 @@ func TestAccGitlabProject_CreateProjectInUserNamespace(t *testing.T) {
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testutil.RunIfAtLeast(t, "14.10") },
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -949,7 +1091,7 @@ func TestAccGitlabProject_InstanceBranchProtectionDisabled(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -1162,7 +1304,7 @@ func TestAccGitlabProject_ImportURLMirrored(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -1253,7 +1395,7 @@ func TestAccGitlabProject_templateMutualExclusiveNameAndID(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -1271,7 +1413,7 @@ func TestAccGitlabProject_UpdateAnalyticsAccessLevel(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			// Create minimal test project
@@ -1313,7 +1455,7 @@ func TestAccGitlabProject_containerExpirationPolicy(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -1321,7 +1463,7 @@ func TestAccGitlabProject_containerExpirationPolicy(t *testing.T) {
 					resource "gitlab_project" "this" {
 						name = "foo-%d"
 
-						container_expiration_policy {
+						container_expiration_policy = {
 							enabled = true
 							cadence = "1d"
 						}
@@ -1330,8 +1472,8 @@ func TestAccGitlabProject_containerExpirationPolicy(t *testing.T) {
 					}`, rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGitlabProjectExists("gitlab_project.this", &received),
-					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.0.enabled", "true"),
-					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.0.cadence", "1d"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.enabled", "true"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.cadence", "1d"),
 				),
 			},
 			// Verify Import
@@ -1346,7 +1488,7 @@ func TestAccGitlabProject_containerExpirationPolicy(t *testing.T) {
 					resource "gitlab_project" "this" {
 						name = "foo-%d"
 
-						container_expiration_policy {
+						container_expiration_policy = {
 							enabled = true
 							cadence = "1month"
 							name_regex_keep = "bar"
@@ -1356,10 +1498,10 @@ func TestAccGitlabProject_containerExpirationPolicy(t *testing.T) {
 					}`, rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGitlabProjectExists("gitlab_project.this", &received),
-					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.0.enabled", "true"),
-					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.0.cadence", "1month"),
-					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.0.name_regex_keep", "bar"),
-					resource.TestCheckResourceAttrSet("gitlab_project.this", "container_expiration_policy.0.next_run_at"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.enabled", "true"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.cadence", "1month"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.name_regex_keep", "bar"),
+					resource.TestCheckResourceAttrSet("gitlab_project.this", "container_expiration_policy.next_run_at"),
 				),
 			},
 			// Clear attributes
@@ -1368,16 +1510,16 @@ func TestAccGitlabProject_containerExpirationPolicy(t *testing.T) {
 					resource "gitlab_project" "this" {
 						name = "foo-%d"
 
-						container_expiration_policy {}
+						container_expiration_policy = {}
 
 						visibility_level = "public"
 					}`, rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGitlabProjectExists("gitlab_project.this", &received),
-					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.0.enabled", "true"),
-					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.0.cadence", "1month"),
-					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.0.name_regex_keep", "bar"),
-					resource.TestCheckResourceAttrSet("gitlab_project.this", "container_expiration_policy.0.next_run_at"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.enabled", "true"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.cadence", "1month"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "container_expiration_policy.name_regex_keep", "bar"),
+					resource.TestCheckResourceAttrSet("gitlab_project.this", "container_expiration_policy.next_run_at"),
 				),
 			},
 			// Verify Import
@@ -1395,7 +1537,7 @@ func TestAccGitlabProject_DeprecatedBuildCoverageRegex(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -1425,7 +1567,7 @@ func TestAccGitlabProject_SetDefaultFalseBooleansOnCreate(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -1460,7 +1602,7 @@ func TestAccGitlabProject_PublicBuilds(t *testing.T) {
 	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -1481,7 +1623,7 @@ func TestAccGitlabProject_ForkProject(t *testing.T) {
 	testProjectToFork2 := testutil.CreateProject(t)
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			// Create a new `gitlab_project` resource by forking an existing project
@@ -1587,7 +1729,7 @@ func TestAccGitlabProject_ForkProjectAndConfigurePullMirror(t *testing.T) {
 	testProjectToFork := testutil.CreateProject(t)
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			// Create a new `gitlab_project` resource by forking an existing project and configuring the pull mirror
@@ -1626,7 +1768,7 @@ func TestAccGitlabProject_ContainerExpirationPolicy(t *testing.T) {
 	testProjectName := acctest.RandomWithPrefix("acctest")
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: providerFactoriesV6,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckGitlabProjectDestroy,
 		Steps: []resource.TestStep{
 			// Create project with container expiration policy
@@ -1636,7 +1778,7 @@ func TestAccGitlabProject_ContainerExpirationPolicy(t *testing.T) {
 					  name                = "%s"
 					  visibility_level    = "public"
 
-					  container_expiration_policy {
+					  container_expiration_policy = {
 						enabled = true
 						cadence = "1d"
 						keep_n  = 5
@@ -1657,7 +1799,7 @@ func TestAccGitlabProject_ContainerExpirationPolicy(t *testing.T) {
 					  name                = "%s"
 					  visibility_level    = "public"
 
-					  container_expiration_policy {
+					  container_expiration_policy = {
 						enabled = false
 					  }
 					}
@@ -1763,61 +1905,88 @@ func testAccCheckGitlabProjectArchivedOnDestroy(s *terraform.State) error {
 }
 
 func testAccCheckAggregateGitlabProject(expected, received *gitlab.Project) resource.TestCheckFunc {
-	var checks []resource.TestCheckFunc
-
-	testResource := allResources["gitlab_project"]()
-	expectedData := testResource.TestResourceData()
-	receivedData := testResource.TestResourceData()
-	for a, v := range testResource.Schema {
-		attribute := a
-		attrValue := v
-		checks = append(checks, func(_ *terraform.State) error {
-			if attrValue.Computed {
-				if attrDefault, err := attrValue.DefaultValue(); err == nil {
-					if attrDefault == nil {
-						return nil // Skipping because we have no way of pre-computing computed vars
-					}
-				} else {
-					return err
-				}
-			}
+	return func(_ *terraform.State) error {
+		ctx := context.Background()
+		providerData := &api.ProviderData{Client: testutil.TestGitlabClient, Capabilities: api.NewCapabilities(testutil.TestGitlabClient)}
+		r := &gitlabProjectResource{client: providerData.Client, providerData: providerData}
 
-			if err := resourceGitlabProjectSetToState(context.Background(), testutil.TestGitlabClient, expectedData, expected); err != nil {
-				return err
-			}
+		var schemaResp fwresource.SchemaResponse
+		r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
 
-			if err := resourceGitlabProjectSetToState(context.Background(), testutil.TestGitlabClient, receivedData, received); err != nil {
-				return err
+		expectedState, err := testAccGitlabProjectToState(ctx, r, schemaResp.Schema, expected)
+		if err != nil {
+			return err
+		}
+		receivedState, err := testAccGitlabProjectToState(ctx, r, schemaResp.Schema, received)
+		if err != nil {
+			return err
+		}
+
+		var messages []string
+		for name, attribute := range schemaResp.Schema.Attributes {
+			// Skipping because we have no way of pre-computing computed vars
+			if attribute.IsComputed() && !testAccHasDefaultValue(attribute) {
+				continue
 			}
 
-			// ignored for now
-			if attribute == "container_expiration_policy" {
-				return nil
+			var e, r attr.Value
+			if diags := expectedState.GetAttribute(ctx, path.Root(name), &e); diags.HasError() {
+				return fmt.Errorf("unable to get expected attribute %s: %v", name, diags)
 			}
+			if diags := receivedState.GetAttribute(ctx, path.Root(name), &r); diags.HasError() {
+				return fmt.Errorf("unable to get received attribute %s: %v", name, diags)
+			}
+			if !e.Equal(r) {
+				messages = append(messages, fmt.Sprintf(`attribute %s expected "%s" received "%s"`, name, e, r))
+			}
+		}
 
-			return testAccCompareGitLabAttribute(attribute, expectedData, receivedData)
-		})
+		if len(messages) > 0 {
+			sort.Strings(messages)
+			return errors.New(strings.Join(messages, "\n"))
+		}
+		return nil
 	}
-	return resource.ComposeAggregateTestCheckFunc(checks...)
-}
-
-// testAccCompareGitLabAttribute compares an attribute in two ResourceData's for
-// equivalency.
-func testAccCompareGitLabAttribute(attr string, expected, received *schema.ResourceData) error {
-	e := expected.Get(attr)
-	r := received.Get(attr)
-	switch e.(type) { // nolint // TODO: Resolve this golangci-lint issue: S1034: assigning the result of this type assertion to a variable (switch e := e.(type)) could eliminate type assertions in switch cases (gosimple)
-	case *schema.Set:
-		if !e.(*schema.Set).Equal(r) { // nolint // TODO: Resolve this golangci-lint issue: S1034(related information): could eliminate this type assertion (gosimple)
-			return fmt.Errorf(`attribute set %s expected "%+v" received "%+v"`, attr, e, r)
+}
+
+// testAccGitlabProjectToState maps the project to the state of the `gitlab_project` resource.
+func testAccGitlabProjectToState(ctx context.Context, r *gitlabProjectResource, s schema.Schema, project *gitlab.Project) (*tfsdk.State, error) {
+	var data gitlabProjectResourceModel
+	if diags := r.projectToStateModel(ctx, project, true, &data); diags.HasError() {
+		return nil, fmt.Errorf("unable to map project: %v", diags)
+	}
+
+	state := &tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		return nil, fmt.Errorf("unable to set state: %v", diags)
+	}
+	return state, nil
+}
+
+// testAccHasDefaultValue returns true if the attribute has a default value for when it isn't configured.
+func testAccHasDefaultValue(attribute schema.Attribute) bool {
+	var modifiers []interface{}
+	switch a := attribute.(type) {
+	case schema.BoolAttribute:
+		for _, m := range a.PlanModifiers {
+			modifiers = append(modifiers, m)
+		}
+	case schema.Int64Attribute:
+		for _, m := range a.PlanModifiers {
+			modifiers = append(modifiers, m)
 		}
-	default:
-		// Stringify to check because of type differences
-		if fmt.Sprintf("%v", e) != fmt.Sprintf("%v", r) {
-			return fmt.Errorf(`attribute %s expected "%+v" received "%+v"`, attr, e, r)
+	case schema.StringAttribute:
+		for _, m := range a.PlanModifiers {
+			modifiers = append(modifiers, m)
 		}
 	}
-	return nil
+
+	for _, m := range modifiers {
+		if _, ok := m.(defaultValuePlanModifier); ok {
+			return true
+		}
+	}
+	return false
 }
 
 func testAccCheckGitlabProjectDefaultBranch(project *gitlab.Project, want *testAccGitlabProjectExpectedAttributes) resource.TestCheckFunc {
@@ -2072,7 +2241,7 @@ resource "gitlab_project" "foo" {
   build_git_strategy = "fetch"
   build_timeout = 42 * 60
   builds_access_level = "enabled"
-  container_expiration_policy {
+  container_expiration_policy = {
 	enabled = true
   	cadence = "1month"
   }
@@ -2174,7 +2343,7 @@ resource "gitlab_project" "foo" {
   build_git_strategy = "fetch"
   build_timeout = 10 * 60
   builds_access_level = "disabled"
-  container_expiration_policy {
+  container_expiration_policy = {
 	enabled = true
   	cadence = "3month"
   }
@@ -2303,7 +2472,7 @@ resource "gitlab_project" "foo" {
   path = "foo.%[1]d"
   description = "Terraform acceptance tests"
 
-  push_rules {
+  push_rules = {
 %[2]s
   }
 
@@ -2316,7 +2485,7 @@ resource "gitlab_project" "foo" {
   build_git_strategy = "fetch"
   build_timeout = 42 * 60
   builds_access_level = "enabled"
-  container_expiration_policy {
+  container_expiration_policy = {
 	enabled = true
   	cadence = "1month"
   }
@@ -2497,7 +2666,7 @@ resource "gitlab_project" "foo" {
   build_git_strategy = "fetch"
   build_timeout = 42 * 60
   builds_access_level = "enabled"
-  container_expiration_policy {
+  container_expiration_policy = {
 	enabled = true
   	cadence = "1month"
   }
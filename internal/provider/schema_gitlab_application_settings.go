@@ -0,0 +1,3309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/xanzy/go-gitlab"
+)
+
+// gitlabApplicationSettingsResourceModel describes the resource data model.
+type gitlabApplicationSettingsResourceModel struct {
+	Id                                                  types.String  `tfsdk:"id"`
+	AdminMode                                           types.Bool    `tfsdk:"admin_mode"`
+	AbuseNotificationEmail                              types.String  `tfsdk:"abuse_notification_email"`
+	AfterSignOutPath                                    types.String  `tfsdk:"after_sign_out_path"`
+	AfterSignUpText                                     types.String  `tfsdk:"after_sign_up_text"`
+	AkismetApiKey                                       types.String  `tfsdk:"akismet_api_key"`
+	AkismetEnabled                                      types.Bool    `tfsdk:"akismet_enabled"`
+	AllowGroupOwnersToManageLdap                        types.Bool    `tfsdk:"allow_group_owners_to_manage_ldap"`
+	AllowLocalRequestsFromSystemHooks                   types.Bool    `tfsdk:"allow_local_requests_from_system_hooks"`
+	AllowLocalRequestsFromWebHooksAndServices           types.Bool    `tfsdk:"allow_local_requests_from_web_hooks_and_services"`
+	ArchiveBuildsInHumanReadable                        types.String  `tfsdk:"archive_builds_in_human_readable"`
+	AssetProxyEnabled                                   types.Bool    `tfsdk:"asset_proxy_enabled"`
+	AssetProxySecretKey                                 types.String  `tfsdk:"asset_proxy_secret_key"`
+	AssetProxyUrl                                       types.String  `tfsdk:"asset_proxy_url"`
+	AssetProxyAllowlist                                 types.List    `tfsdk:"asset_proxy_allowlist"`
+	AuthorizedKeysEnabled                               types.Bool    `tfsdk:"authorized_keys_enabled"`
+	AutoDevopsDomain                                    types.String  `tfsdk:"auto_devops_domain"`
+	AutoDevopsEnabled                                   types.Bool    `tfsdk:"auto_devops_enabled"`
+	AutomaticPurchasedStorageAllocation                 types.Bool    `tfsdk:"automatic_purchased_storage_allocation"`
+	CheckNamespacePlan                                  types.Bool    `tfsdk:"check_namespace_plan"`
+	CommitEmailHostname                                 types.String  `tfsdk:"commit_email_hostname"`
+	ContainerExpirationPoliciesEnableHistoricEntries    types.Bool    `tfsdk:"container_expiration_policies_enable_historic_entries"`
+	ContainerRegistryCleanupTagsServiceMaxListSize      types.Int64   `tfsdk:"container_registry_cleanup_tags_service_max_list_size"`
+	ContainerRegistryDeleteTagsServiceTimeout           types.Int64   `tfsdk:"container_registry_delete_tags_service_timeout"`
+	ContainerRegistryExpirationPoliciesCaching          types.Bool    `tfsdk:"container_registry_expiration_policies_caching"`
+	ContainerRegistryExpirationPoliciesWorkerCapacity   types.Int64   `tfsdk:"container_registry_expiration_policies_worker_capacity"`
+	ContainerRegistryTokenExpireDelay                   types.Int64   `tfsdk:"container_registry_token_expire_delay"`
+	PackageRegistryCleanupPoliciesWorkerCapacity        types.Int64   `tfsdk:"package_registry_cleanup_policies_worker_capacity"`
+	DeactivateDormantUsers                              types.Bool    `tfsdk:"deactivate_dormant_users"`
+	DefaultArtifactsExpireIn                            types.String  `tfsdk:"default_artifacts_expire_in"`
+	DefaultBranchName                                   types.String  `tfsdk:"default_branch_name"`
+	DefaultBranchProtection                             types.Int64   `tfsdk:"default_branch_protection"`
+	DefaultCiConfigPath                                 types.String  `tfsdk:"default_ci_config_path"`
+	DefaultGroupVisibility                              types.String  `tfsdk:"default_group_visibility"`
+	DefaultProjectCreation                              types.Int64   `tfsdk:"default_project_creation"`
+	DefaultProjectVisibility                            types.String  `tfsdk:"default_project_visibility"`
+	DefaultProjectsLimit                                types.Int64   `tfsdk:"default_projects_limit"`
+	DefaultSnippetVisibility                            types.String  `tfsdk:"default_snippet_visibility"`
+	DelayedProjectDeletion                              types.Bool    `tfsdk:"delayed_project_deletion"`
+	DelayedGroupDeletion                                types.Bool    `tfsdk:"delayed_group_deletion"`
+	DeleteInactiveProjects                              types.Bool    `tfsdk:"delete_inactive_projects"`
+	DeletionAdjournedPeriod                             types.Int64   `tfsdk:"deletion_adjourned_period"`
+	DiffMaxPatchBytes                                   types.Int64   `tfsdk:"diff_max_patch_bytes"`
+	DiffMaxFiles                                        types.Int64   `tfsdk:"diff_max_files"`
+	DiffMaxLines                                        types.Int64   `tfsdk:"diff_max_lines"`
+	DisableFeedToken                                    types.Bool    `tfsdk:"disable_feed_token"`
+	DisabledOauthSignInSources                          types.List    `tfsdk:"disabled_oauth_sign_in_sources"`
+	DnsRebindingProtectionEnabled                       types.Bool    `tfsdk:"dns_rebinding_protection_enabled"`
+	DomainDenylistEnabled                               types.Bool    `tfsdk:"domain_denylist_enabled"`
+	DomainDenylist                                      types.List    `tfsdk:"domain_denylist"`
+	DomainAllowlist                                     types.List    `tfsdk:"domain_allowlist"`
+	DsaKeyRestriction                                   types.Int64   `tfsdk:"dsa_key_restriction"`
+	EcdsaKeyRestriction                                 types.Int64   `tfsdk:"ecdsa_key_restriction"`
+	EcdsaSkKeyRestriction                               types.Int64   `tfsdk:"ecdsa_sk_key_restriction"`
+	Ed25519KeyRestriction                               types.Int64   `tfsdk:"ed25519_key_restriction"`
+	Ed25519SkKeyRestriction                             types.Int64   `tfsdk:"ed25519_sk_key_restriction"`
+	EksAccessKeyId                                      types.String  `tfsdk:"eks_access_key_id"`
+	EksAccountId                                        types.String  `tfsdk:"eks_account_id"`
+	EksIntegrationEnabled                               types.Bool    `tfsdk:"eks_integration_enabled"`
+	EksSecretAccessKey                                  types.String  `tfsdk:"eks_secret_access_key"`
+	ElasticsearchAwsAccessKey                           types.String  `tfsdk:"elasticsearch_aws_access_key"`
+	ElasticsearchAwsRegion                              types.String  `tfsdk:"elasticsearch_aws_region"`
+	ElasticsearchAwsSecretAccessKey                     types.String  `tfsdk:"elasticsearch_aws_secret_access_key"`
+	ElasticsearchAws                                    types.Bool    `tfsdk:"elasticsearch_aws"`
+	ElasticsearchIndexedFieldLengthLimit                types.Int64   `tfsdk:"elasticsearch_indexed_field_length_limit"`
+	ElasticsearchIndexedFileSizeLimitKb                 types.Int64   `tfsdk:"elasticsearch_indexed_file_size_limit_kb"`
+	ElasticsearchIndexing                               types.Bool    `tfsdk:"elasticsearch_indexing"`
+	ElasticsearchLimitIndexing                          types.Bool    `tfsdk:"elasticsearch_limit_indexing"`
+	ElasticsearchMaxBulkConcurrency                     types.Int64   `tfsdk:"elasticsearch_max_bulk_concurrency"`
+	ElasticsearchMaxBulkSizeMb                          types.Int64   `tfsdk:"elasticsearch_max_bulk_size_mb"`
+	ElasticsearchNamespaceIds                           types.List    `tfsdk:"elasticsearch_namespace_ids"`
+	ElasticsearchProjectIds                             types.List    `tfsdk:"elasticsearch_project_ids"`
+	ElasticsearchSearch                                 types.Bool    `tfsdk:"elasticsearch_search"`
+	ElasticsearchUrl                                    types.List    `tfsdk:"elasticsearch_url"`
+	ElasticsearchUsername                               types.String  `tfsdk:"elasticsearch_username"`
+	ElasticsearchPassword                               types.String  `tfsdk:"elasticsearch_password"`
+	EmailAdditionalText                                 types.String  `tfsdk:"email_additional_text"`
+	EmailAuthorInBody                                   types.Bool    `tfsdk:"email_author_in_body"`
+	EnabledGitAccessProtocol                            types.String  `tfsdk:"enabled_git_access_protocol"`
+	EnforceNamespaceStorageLimit                        types.Bool    `tfsdk:"enforce_namespace_storage_limit"`
+	EnforceTerms                                        types.Bool    `tfsdk:"enforce_terms"`
+	ExternalAuthClientCert                              types.String  `tfsdk:"external_auth_client_cert"`
+	ExternalAuthClientKeyPass                           types.String  `tfsdk:"external_auth_client_key_pass"`
+	ExternalAuthClientKey                               types.String  `tfsdk:"external_auth_client_key"`
+	ExternalAuthorizationServiceDefaultLabel            types.String  `tfsdk:"external_authorization_service_default_label"`
+	ExternalAuthorizationServiceEnabled                 types.Bool    `tfsdk:"external_authorization_service_enabled"`
+	ExternalAuthorizationServiceTimeout                 types.Float64 `tfsdk:"external_authorization_service_timeout"`
+	ExternalAuthorizationServiceUrl                     types.String  `tfsdk:"external_authorization_service_url"`
+	ExternalPipelineValidationServiceUrl                types.String  `tfsdk:"external_pipeline_validation_service_url"`
+	ExternalPipelineValidationServiceToken              types.String  `tfsdk:"external_pipeline_validation_service_token"`
+	ExternalPipelineValidationServiceTimeout            types.Int64   `tfsdk:"external_pipeline_validation_service_timeout"`
+	FileTemplateProjectId                               types.Int64   `tfsdk:"file_template_project_id"`
+	FirstDayOfWeek                                      types.Int64   `tfsdk:"first_day_of_week"`
+	GeoNodeAllowedIps                                   types.String  `tfsdk:"geo_node_allowed_ips"`
+	GeoStatusTimeout                                    types.Int64   `tfsdk:"geo_status_timeout"`
+	GitTwoFactorSessionExpiry                           types.Int64   `tfsdk:"git_two_factor_session_expiry"`
+	GitalyTimeoutDefault                                types.Int64   `tfsdk:"gitaly_timeout_default"`
+	GitalyTimeoutFast                                   types.Int64   `tfsdk:"gitaly_timeout_fast"`
+	GitalyTimeoutMedium                                 types.Int64   `tfsdk:"gitaly_timeout_medium"`
+	GrafanaEnabled                                      types.Bool    `tfsdk:"grafana_enabled"`
+	GrafanaUrl                                          types.String  `tfsdk:"grafana_url"`
+	GravatarEnabled                                     types.Bool    `tfsdk:"gravatar_enabled"`
+	GroupOwnersCanManageDefaultBranchProtection         types.Bool    `tfsdk:"group_owners_can_manage_default_branch_protection"`
+	HashedStorageEnabled                                types.Bool    `tfsdk:"hashed_storage_enabled"`
+	HelpPageHideCommercialContent                       types.Bool    `tfsdk:"help_page_hide_commercial_content"`
+	HelpPageSupportUrl                                  types.String  `tfsdk:"help_page_support_url"`
+	HelpPageText                                        types.String  `tfsdk:"help_page_text"`
+	HelpText                                            types.String  `tfsdk:"help_text"`
+	HideThirdPartyOffers                                types.Bool    `tfsdk:"hide_third_party_offers"`
+	HomePageUrl                                         types.String  `tfsdk:"home_page_url"`
+	HousekeepingEnabled                                 types.Bool    `tfsdk:"housekeeping_enabled"`
+	HousekeepingFullRepackPeriod                        types.Int64   `tfsdk:"housekeeping_full_repack_period"`
+	HousekeepingGcPeriod                                types.Int64   `tfsdk:"housekeeping_gc_period"`
+	HousekeepingIncrementalRepackPeriod                 types.Int64   `tfsdk:"housekeeping_incremental_repack_period"`
+	HtmlEmailsEnabled                                   types.Bool    `tfsdk:"html_emails_enabled"`
+	ImportSources                                       types.List    `tfsdk:"import_sources"`
+	InProductMarketingEmailsEnabled                     types.Bool    `tfsdk:"in_product_marketing_emails_enabled"`
+	InactiveProjectsDeleteAfterMonths                   types.Int64   `tfsdk:"inactive_projects_delete_after_months"`
+	InactiveProjectsMinSizeMb                           types.Int64   `tfsdk:"inactive_projects_min_size_mb"`
+	InactiveProjectsSendWarningEmailAfterMonths         types.Int64   `tfsdk:"inactive_projects_send_warning_email_after_months"`
+	InvisibleCaptchaEnabled                             types.Bool    `tfsdk:"invisible_captcha_enabled"`
+	IssuesCreateLimit                                   types.Int64   `tfsdk:"issues_create_limit"`
+	KeepLatestArtifact                                  types.Bool    `tfsdk:"keep_latest_artifact"`
+	LocalMarkdownVersion                                types.Int64   `tfsdk:"local_markdown_version"`
+	MailgunSigningKey                                   types.String  `tfsdk:"mailgun_signing_key"`
+	MailgunEventsEnabled                                types.Bool    `tfsdk:"mailgun_events_enabled"`
+	MaintenanceModeMessage                              types.String  `tfsdk:"maintenance_mode_message"`
+	MaintenanceMode                                     types.Bool    `tfsdk:"maintenance_mode"`
+	MaxArtifactsSize                                    types.Int64   `tfsdk:"max_artifacts_size"`
+	MaxAttachmentSize                                   types.Int64   `tfsdk:"max_attachment_size"`
+	MaxExportSize                                       types.Int64   `tfsdk:"max_export_size"`
+	MaxImportSize                                       types.Int64   `tfsdk:"max_import_size"`
+	MaxPagesSize                                        types.Int64   `tfsdk:"max_pages_size"`
+	MaxPersonalAccessTokenLifetime                      types.Int64   `tfsdk:"max_personal_access_token_lifetime"`
+	MaxSshKeyLifetime                                   types.Int64   `tfsdk:"max_ssh_key_lifetime"`
+	MetricsMethodCallThreshold                          types.Int64   `tfsdk:"metrics_method_call_threshold"`
+	MaxNumberOfRepositoryDownloads                      types.Int64   `tfsdk:"max_number_of_repository_downloads"`
+	MaxNumberOfRepositoryDownloadsWithinTimePeriod      types.Int64   `tfsdk:"max_number_of_repository_downloads_within_time_period"`
+	GitRateLimitUsersAllowlist                          types.List    `tfsdk:"git_rate_limit_users_allowlist"`
+	MirrorAvailable                                     types.Bool    `tfsdk:"mirror_available"`
+	MirrorCapacityThreshold                             types.Int64   `tfsdk:"mirror_capacity_threshold"`
+	MirrorMaxCapacity                                   types.Int64   `tfsdk:"mirror_max_capacity"`
+	MirrorMaxDelay                                      types.Int64   `tfsdk:"mirror_max_delay"`
+	NpmPackageRequestsForwarding                        types.Bool    `tfsdk:"npm_package_requests_forwarding"`
+	PypiPackageRequestsForwarding                       types.Bool    `tfsdk:"pypi_package_requests_forwarding"`
+	OutboundLocalRequestsWhitelist                      types.List    `tfsdk:"outbound_local_requests_whitelist"`
+	PagesDomainVerificationEnabled                      types.Bool    `tfsdk:"pages_domain_verification_enabled"`
+	PasswordAuthenticationEnabledForGit                 types.Bool    `tfsdk:"password_authentication_enabled_for_git"`
+	PasswordAuthenticationEnabledForWeb                 types.Bool    `tfsdk:"password_authentication_enabled_for_web"`
+	PasswordNumberRequired                              types.Bool    `tfsdk:"password_number_required"`
+	PasswordSymbolRequired                              types.Bool    `tfsdk:"password_symbol_required"`
+	PasswordUppercaseRequired                           types.Bool    `tfsdk:"password_uppercase_required"`
+	PasswordLowercaseRequired                           types.Bool    `tfsdk:"password_lowercase_required"`
+	PerformanceBarAllowedGroupPath                      types.String  `tfsdk:"performance_bar_allowed_group_path"`
+	PersonalAccessTokenPrefix                           types.String  `tfsdk:"personal_access_token_prefix"`
+	PipelineLimitPerProjectUserSha                      types.Int64   `tfsdk:"pipeline_limit_per_project_user_sha"`
+	PlantumlEnabled                                     types.Bool    `tfsdk:"plantuml_enabled"`
+	PlantumlUrl                                         types.String  `tfsdk:"plantuml_url"`
+	PollingIntervalMultiplier                           types.Float64 `tfsdk:"polling_interval_multiplier"`
+	ProjectExportEnabled                                types.Bool    `tfsdk:"project_export_enabled"`
+	PrometheusMetricsEnabled                            types.Bool    `tfsdk:"prometheus_metrics_enabled"`
+	ProtectedCiVariables                                types.Bool    `tfsdk:"protected_ci_variables"`
+	PushEventActivitiesLimit                            types.Int64   `tfsdk:"push_event_activities_limit"`
+	PushEventHooksLimit                                 types.Int64   `tfsdk:"push_event_hooks_limit"`
+	RateLimitingResponseText                            types.String  `tfsdk:"rate_limiting_response_text"`
+	RawBlobRequestLimit                                 types.Int64   `tfsdk:"raw_blob_request_limit"`
+	SearchRateLimit                                     types.Int64   `tfsdk:"search_rate_limit"`
+	SearchRateLimitUnauthenticated                      types.Int64   `tfsdk:"search_rate_limit_unauthenticated"`
+	RecaptchaEnabled                                    types.Bool    `tfsdk:"recaptcha_enabled"`
+	RecaptchaPrivateKey                                 types.String  `tfsdk:"recaptcha_private_key"`
+	RecaptchaSiteKey                                    types.String  `tfsdk:"recaptcha_site_key"`
+	ReceiveMaxInputSize                                 types.Int64   `tfsdk:"receive_max_input_size"`
+	RepositoryChecksEnabled                             types.Bool    `tfsdk:"repository_checks_enabled"`
+	RepositorySizeLimit                                 types.Int64   `tfsdk:"repository_size_limit"`
+	RepositoryStoragesWeighted                          types.Map     `tfsdk:"repository_storages_weighted"`
+	RepositoryStorages                                  types.List    `tfsdk:"repository_storages"`
+	RequireAdminApprovalAfterUserSignup                 types.Bool    `tfsdk:"require_admin_approval_after_user_signup"`
+	RequireTwoFactorAuthentication                      types.Bool    `tfsdk:"require_two_factor_authentication"`
+	RestrictedVisibilityLevels                          types.List    `tfsdk:"restricted_visibility_levels"`
+	RsaKeyRestriction                                   types.Int64   `tfsdk:"rsa_key_restriction"`
+	SendUserConfirmationEmail                           types.Bool    `tfsdk:"send_user_confirmation_email"`
+	SessionExpireDelay                                  types.Int64   `tfsdk:"session_expire_delay"`
+	SharedRunnersEnabled                                types.Bool    `tfsdk:"shared_runners_enabled"`
+	SharedRunnersMinutes                                types.Int64   `tfsdk:"shared_runners_minutes"`
+	SharedRunnersText                                   types.String  `tfsdk:"shared_runners_text"`
+	SidekiqJobLimiterMode                               types.String  `tfsdk:"sidekiq_job_limiter_mode"`
+	SidekiqJobLimiterCompressionThresholdBytes          types.Int64   `tfsdk:"sidekiq_job_limiter_compression_threshold_bytes"`
+	SidekiqJobLimiterLimitBytes                         types.Int64   `tfsdk:"sidekiq_job_limiter_limit_bytes"`
+	SignInText                                          types.String  `tfsdk:"sign_in_text"`
+	SignupEnabled                                       types.Bool    `tfsdk:"signup_enabled"`
+	SlackAppEnabled                                     types.Bool    `tfsdk:"slack_app_enabled"`
+	SlackAppId                                          types.String  `tfsdk:"slack_app_id"`
+	SlackAppSecret                                      types.String  `tfsdk:"slack_app_secret"`
+	SlackAppSigningSecret                               types.String  `tfsdk:"slack_app_signing_secret"`
+	SlackAppVerificationToken                           types.String  `tfsdk:"slack_app_verification_token"`
+	SnippetSizeLimit                                    types.Int64   `tfsdk:"snippet_size_limit"`
+	SnowplowAppId                                       types.String  `tfsdk:"snowplow_app_id"`
+	SnowplowCollectorHostname                           types.String  `tfsdk:"snowplow_collector_hostname"`
+	SnowplowCookieDomain                                types.String  `tfsdk:"snowplow_cookie_domain"`
+	SnowplowEnabled                                     types.Bool    `tfsdk:"snowplow_enabled"`
+	SourcegraphEnabled                                  types.Bool    `tfsdk:"sourcegraph_enabled"`
+	SourcegraphPublicOnly                               types.Bool    `tfsdk:"sourcegraph_public_only"`
+	SourcegraphUrl                                      types.String  `tfsdk:"sourcegraph_url"`
+	SpamCheckEndpointEnabled                            types.Bool    `tfsdk:"spam_check_endpoint_enabled"`
+	SpamCheckEndpointUrl                                types.String  `tfsdk:"spam_check_endpoint_url"`
+	SpamCheckApiKey                                     types.String  `tfsdk:"spam_check_api_key"`
+	SuggestPipelineEnabled                              types.Bool    `tfsdk:"suggest_pipeline_enabled"`
+	TerminalMaxSessionTime                              types.Int64   `tfsdk:"terminal_max_session_time"`
+	Terms                                               types.String  `tfsdk:"terms"`
+	ThrottleAuthenticatedApiEnabled                     types.Bool    `tfsdk:"throttle_authenticated_api_enabled"`
+	ThrottleAuthenticatedApiPeriodInSeconds             types.Int64   `tfsdk:"throttle_authenticated_api_period_in_seconds"`
+	ThrottleAuthenticatedApiRequestsPerPeriod           types.Int64   `tfsdk:"throttle_authenticated_api_requests_per_period"`
+	ThrottleAuthenticatedPackagesApiEnabled             types.Bool    `tfsdk:"throttle_authenticated_packages_api_enabled"`
+	ThrottleAuthenticatedPackagesApiPeriodInSeconds     types.Int64   `tfsdk:"throttle_authenticated_packages_api_period_in_seconds"`
+	ThrottleAuthenticatedPackagesApiRequestsPerPeriod   types.Int64   `tfsdk:"throttle_authenticated_packages_api_requests_per_period"`
+	ThrottleAuthenticatedWebEnabled                     types.Bool    `tfsdk:"throttle_authenticated_web_enabled"`
+	ThrottleAuthenticatedWebPeriodInSeconds             types.Int64   `tfsdk:"throttle_authenticated_web_period_in_seconds"`
+	ThrottleAuthenticatedWebRequestsPerPeriod           types.Int64   `tfsdk:"throttle_authenticated_web_requests_per_period"`
+	ThrottleUnauthenticatedApiEnabled                   types.Bool    `tfsdk:"throttle_unauthenticated_api_enabled"`
+	ThrottleUnauthenticatedApiPeriodInSeconds           types.Int64   `tfsdk:"throttle_unauthenticated_api_period_in_seconds"`
+	ThrottleUnauthenticatedApiRequestsPerPeriod         types.Int64   `tfsdk:"throttle_unauthenticated_api_requests_per_period"`
+	ThrottleUnauthenticatedPackagesApiEnabled           types.Bool    `tfsdk:"throttle_unauthenticated_packages_api_enabled"`
+	ThrottleUnauthenticatedPackagesApiPeriodInSeconds   types.Int64   `tfsdk:"throttle_unauthenticated_packages_api_period_in_seconds"`
+	ThrottleUnauthenticatedPackagesApiRequestsPerPeriod types.Int64   `tfsdk:"throttle_unauthenticated_packages_api_requests_per_period"`
+	ThrottleUnauthenticatedWebEnabled                   types.Bool    `tfsdk:"throttle_unauthenticated_web_enabled"`
+	ThrottleUnauthenticatedWebPeriodInSeconds           types.Int64   `tfsdk:"throttle_unauthenticated_web_period_in_seconds"`
+	ThrottleUnauthenticatedWebRequestsPerPeriod         types.Int64   `tfsdk:"throttle_unauthenticated_web_requests_per_period"`
+	TimeTrackingLimitToHours                            types.Bool    `tfsdk:"time_tracking_limit_to_hours"`
+	TwoFactorGracePeriod                                types.Int64   `tfsdk:"two_factor_grace_period"`
+	UniqueIpsLimitEnabled                               types.Bool    `tfsdk:"unique_ips_limit_enabled"`
+	UniqueIpsLimitPerUser                               types.Int64   `tfsdk:"unique_ips_limit_per_user"`
+	UniqueIpsLimitTimeWindow                            types.Int64   `tfsdk:"unique_ips_limit_time_window"`
+	UsagePingEnabled                                    types.Bool    `tfsdk:"usage_ping_enabled"`
+	UserDeactivationEmailsEnabled                       types.Bool    `tfsdk:"user_deactivation_emails_enabled"`
+	UserDefaultExternal                                 types.Bool    `tfsdk:"user_default_external"`
+	UserDefaultInternalRegex                            types.String  `tfsdk:"user_default_internal_regex"`
+	UserOauthApplications                               types.Bool    `tfsdk:"user_oauth_applications"`
+	UserShowAddSshKeyMessage                            types.Bool    `tfsdk:"user_show_add_ssh_key_message"`
+	VersionCheckEnabled                                 types.Bool    `tfsdk:"version_check_enabled"`
+	WhatsNewVariant                                     types.String  `tfsdk:"whats_new_variant"`
+	WebIdeClientsidePreviewEnabled                      types.Bool    `tfsdk:"web_ide_clientside_preview_enabled"`
+	WikiPageMaxContentBytes                             types.Int64   `tfsdk:"wiki_page_max_content_bytes"`
+}
+
+// gitlabApplicationSettingsAttributes returns the attributes of the application settings.
+// All attributes are optional and computed, so that only the configured settings are managed.
+func gitlabApplicationSettingsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the application settings. It's always `gitlab`.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"admin_mode": schema.BoolAttribute{
+			MarkdownDescription: "Require administrators to enable Admin Mode by re-authenticating for administrative tasks.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"abuse_notification_email": schema.StringAttribute{
+			MarkdownDescription: "If set, abuse reports are sent to this address. Abuse reports are always available in the Admin Area.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"after_sign_out_path": schema.StringAttribute{
+			MarkdownDescription: "Where to redirect users after logout.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"after_sign_up_text": schema.StringAttribute{
+			MarkdownDescription: "Text shown to the user after signing up.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"akismet_api_key": schema.StringAttribute{
+			MarkdownDescription: "API key for Akismet spam protection.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"akismet_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: akismet_api_key) Enable or disable Akismet spam protection.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"allow_group_owners_to_manage_ldap": schema.BoolAttribute{
+			MarkdownDescription: "Set to true to allow group owners to manage LDAP.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"allow_local_requests_from_system_hooks": schema.BoolAttribute{
+			MarkdownDescription: "Allow requests to the local network from system hooks.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"allow_local_requests_from_web_hooks_and_services": schema.BoolAttribute{
+			MarkdownDescription: "Allow requests to the local network from web hooks and services.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"archive_builds_in_human_readable": schema.StringAttribute{
+			MarkdownDescription: "Set the duration for which the jobs are considered as old and expired. After that time passes, the jobs are archived and no longer able to be retried. Make it empty to never expire jobs. It has to be no less than 1 day, for example: 15 days, 1 month, 2 years.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"asset_proxy_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: asset_proxy_url) Enable proxying of assets. GitLab restart is required to apply changes.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"asset_proxy_secret_key": schema.StringAttribute{
+			MarkdownDescription: "Shared secret with the asset proxy server. GitLab restart is required to apply changes.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"asset_proxy_url": schema.StringAttribute{
+			MarkdownDescription: "URL of the asset proxy server. GitLab restart is required to apply changes.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"asset_proxy_allowlist": schema.ListAttribute{
+			MarkdownDescription: "Assets that match these domains are not proxied. Wildcards allowed. Your GitLab installation URL is automatically allowlisted. GitLab restart is required to apply changes.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"authorized_keys_enabled": schema.BoolAttribute{
+			MarkdownDescription: "By default, we write to the authorized_keys file to support Git over SSH without additional configuration. GitLab can be optimized to authenticate SSH keys via the database file. Only disable this if you have configured your OpenSSH server to use the AuthorizedKeysCommand.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"auto_devops_domain": schema.StringAttribute{
+			MarkdownDescription: "Specify a domain to use by default for every project’s Auto Review Apps and Auto Deploy stages.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"auto_devops_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable Auto DevOps for projects by default. It automatically builds, tests, and deploys applications based on a predefined CI/CD configuration.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"automatic_purchased_storage_allocation": schema.BoolAttribute{
+			MarkdownDescription: "Enabling this permits automatic allocation of purchased storage in a namespace.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"check_namespace_plan": schema.BoolAttribute{
+			MarkdownDescription: "Enabling this makes only licensed EE features available to projects if the project namespace’s plan includes the feature or if the project is public.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"commit_email_hostname": schema.StringAttribute{
+			MarkdownDescription: "Custom hostname (for private commit emails).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"container_expiration_policies_enable_historic_entries": schema.BoolAttribute{
+			MarkdownDescription: "Enable cleanup policies for all projects.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"container_registry_cleanup_tags_service_max_list_size": schema.Int64Attribute{
+			MarkdownDescription: "The maximum number of tags that can be deleted in a single execution of cleanup policies.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"container_registry_delete_tags_service_timeout": schema.Int64Attribute{
+			MarkdownDescription: "The maximum time, in seconds, that the cleanup process can take to delete a batch of tags for cleanup policies.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"container_registry_expiration_policies_caching": schema.BoolAttribute{
+			MarkdownDescription: "Caching during the execution of cleanup policies.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"container_registry_expiration_policies_worker_capacity": schema.Int64Attribute{
+			MarkdownDescription: "Number of workers for cleanup policies.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"container_registry_token_expire_delay": schema.Int64Attribute{
+			MarkdownDescription: "Container Registry token duration in minutes.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"package_registry_cleanup_policies_worker_capacity": schema.Int64Attribute{
+			MarkdownDescription: "Number of workers assigned to the packages cleanup policies.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"deactivate_dormant_users": schema.BoolAttribute{
+			MarkdownDescription: "Enable automatic deactivation of dormant users.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"default_artifacts_expire_in": schema.StringAttribute{
+			MarkdownDescription: "Set the default expiration time for each job’s artifacts.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"default_branch_name": schema.StringAttribute{
+			MarkdownDescription: "Instance-level custom initial branch name (introduced in GitLab 13.2).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"default_branch_protection": schema.Int64Attribute{
+			MarkdownDescription: "Determine if developers can push to the default branch. Can take: 0 (not protected, both users with the Developer role or Maintainer role can push new commits and force push), 1 (partially protected, users with the Developer role or Maintainer role can push new commits, but cannot force push) or 2 (fully protected, users with the Developer or Maintainer role cannot push new commits, but users with the Developer or Maintainer role can; no one can force push) as a parameter. Default is 2.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"default_ci_config_path": schema.StringAttribute{
+			MarkdownDescription: "Default CI/CD configuration file and path for new projects (.gitlab-ci.yml if not set).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"default_group_visibility": schema.StringAttribute{
+			MarkdownDescription: "What visibility level new groups receive. Can take private, internal and public as a parameter. Default is private.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"default_project_creation": schema.Int64Attribute{
+			MarkdownDescription: "Default project creation protection. Can take: 0 (No one), 1 (Maintainers) or 2 (Developers + Maintainers).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"default_project_visibility": schema.StringAttribute{
+			MarkdownDescription: "What visibility level new projects receive. Can take private, internal and public as a parameter. Default is private.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"default_projects_limit": schema.Int64Attribute{
+			MarkdownDescription: "Project limit per user. Default is 100000.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"default_snippet_visibility": schema.StringAttribute{
+			MarkdownDescription: "What visibility level new snippets receive. Can take private, internal and public as a parameter. Default is private.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"delayed_project_deletion": schema.BoolAttribute{
+			MarkdownDescription: "Enable delayed project deletion by default in new groups. Default is false. From GitLab 15.1, can only be enabled when delayed_group_deletion is true.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"delayed_group_deletion": schema.BoolAttribute{
+			MarkdownDescription: "Enable delayed group deletion. Default is true. Introduced in GitLab 15.0. From GitLab 15.1, disables and locks the group-level setting for delayed protect deletion when set to false.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"delete_inactive_projects": schema.BoolAttribute{
+			MarkdownDescription: "Enable inactive project deletion feature. Default is false. Introduced in GitLab 14.10. Became operational in GitLab 15.0 (with feature flag inactive_projects_deletion, disabled by default).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"deletion_adjourned_period": schema.Int64Attribute{
+			MarkdownDescription: "The number of days to wait before deleting a project or group that is marked for deletion. Value must be between 1 and 90. Defaults to 7. From GitLab 15.1, a hook on deletion_adjourned_period sets the period to 1 on every update, and sets both delayed_project_deletion and delayed_group_deletion to false if the period is 0.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"diff_max_patch_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Maximum diff patch size, in bytes.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"diff_max_files": schema.Int64Attribute{
+			MarkdownDescription: "Maximum files in a diff.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"diff_max_lines": schema.Int64Attribute{
+			MarkdownDescription: "Maximum lines in a diff.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"disable_feed_token": schema.BoolAttribute{
+			MarkdownDescription: "Disable display of RSS/Atom and calendar feed tokens (introduced in GitLab 13.7).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"disabled_oauth_sign_in_sources": schema.ListAttribute{
+			MarkdownDescription: "Disabled OAuth sign-in sources.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"dns_rebinding_protection_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enforce DNS rebinding attack protection.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"domain_denylist_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: domain_denylist) Allows blocking sign-ups from emails from specific domains.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"domain_denylist": schema.ListAttribute{
+			MarkdownDescription: "Users with email addresses that match these domains cannot sign up. Wildcards allowed. Use separate lines for multiple entries. Ex: domain.com, *.domain.com.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"domain_allowlist": schema.ListAttribute{
+			MarkdownDescription: "Force people to use only corporate emails for sign-up. Default is null, meaning there is no restriction.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"dsa_key_restriction": schema.Int64Attribute{
+			MarkdownDescription: "The minimum allowed bit length of an uploaded DSA key. Default is 0 (no restriction). -1 disables DSA keys.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"ecdsa_key_restriction": schema.Int64Attribute{
+			MarkdownDescription: "The minimum allowed curve size (in bits) of an uploaded ECDSA key. Default is 0 (no restriction). -1 disables ECDSA keys.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"ecdsa_sk_key_restriction": schema.Int64Attribute{
+			MarkdownDescription: "The minimum allowed curve size (in bits) of an uploaded ECDSA_SK key. Default is 0 (no restriction). -1 disables ECDSA_SK keys.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"ed25519_key_restriction": schema.Int64Attribute{
+			MarkdownDescription: "The minimum allowed curve size (in bits) of an uploaded ED25519 key. Default is 0 (no restriction). -1 disables ED25519 keys.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"ed25519_sk_key_restriction": schema.Int64Attribute{
+			MarkdownDescription: "The minimum allowed curve size (in bits) of an uploaded ED25519_SK key. Default is 0 (no restriction). -1 disables ED25519_SK keys.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"eks_access_key_id": schema.StringAttribute{
+			MarkdownDescription: "AWS IAM access key ID.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"eks_account_id": schema.StringAttribute{
+			MarkdownDescription: "Amazon account ID.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"eks_integration_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable integration with Amazon EKS.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"eks_secret_access_key": schema.StringAttribute{
+			MarkdownDescription: "AWS IAM secret access key.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_aws_access_key": schema.StringAttribute{
+			MarkdownDescription: "AWS IAM access key.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_aws_region": schema.StringAttribute{
+			MarkdownDescription: "The AWS region the Elasticsearch domain is configured.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_aws_secret_access_key": schema.StringAttribute{
+			MarkdownDescription: "AWS IAM secret access key.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_aws": schema.BoolAttribute{
+			MarkdownDescription: "Enable the use of AWS hosted Elasticsearch.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_indexed_field_length_limit": schema.Int64Attribute{
+			MarkdownDescription: "Maximum size of text fields to index by Elasticsearch. 0 value means no limit. This does not apply to repository and wiki indexing.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_indexed_file_size_limit_kb": schema.Int64Attribute{
+			MarkdownDescription: "Maximum size of repository and wiki files that are indexed by Elasticsearch.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_indexing": schema.BoolAttribute{
+			MarkdownDescription: "Enable Elasticsearch indexing.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_limit_indexing": schema.BoolAttribute{
+			MarkdownDescription: "Limit Elasticsearch to index certain namespaces and projects.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_max_bulk_concurrency": schema.Int64Attribute{
+			MarkdownDescription: "Maximum concurrency of Elasticsearch bulk requests per indexing operation. This only applies to repository indexing operations.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_max_bulk_size_mb": schema.Int64Attribute{
+			MarkdownDescription: "Maximum size of Elasticsearch bulk indexing requests in MB. This only applies to repository indexing operations.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_namespace_ids": schema.ListAttribute{
+			MarkdownDescription: "The namespaces to index via Elasticsearch if elasticsearch_limit_indexing is enabled.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_project_ids": schema.ListAttribute{
+			MarkdownDescription: "The projects to index via Elasticsearch if elasticsearch_limit_indexing is enabled.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_search": schema.BoolAttribute{
+			MarkdownDescription: "Enable Elasticsearch search.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_url": schema.ListAttribute{
+			MarkdownDescription: "The URL to use for connecting to Elasticsearch. Use a comma-separated list to support cluster (for example, http://localhost:9200, http://localhost:9201).",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_username": schema.StringAttribute{
+			MarkdownDescription: "The username of your Elasticsearch instance.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"elasticsearch_password": schema.StringAttribute{
+			MarkdownDescription: "The password of your Elasticsearch instance.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"email_additional_text": schema.StringAttribute{
+			MarkdownDescription: "Additional text added to the bottom of every email for legal/auditing/compliance reasons.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"email_author_in_body": schema.BoolAttribute{
+			MarkdownDescription: "Some email servers do not support overriding the email sender name. Enable this option to include the name of the author of the issue, merge request or comment in the email body instead.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"enabled_git_access_protocol": schema.StringAttribute{
+			MarkdownDescription: "Enabled protocols for Git access. Allowed values are: ssh, http, and nil to allow both protocols.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"enforce_namespace_storage_limit": schema.BoolAttribute{
+			MarkdownDescription: "Enabling this permits enforcement of namespace storage limits.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"enforce_terms": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: terms) Enforce application ToS to all users.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"external_auth_client_cert": schema.StringAttribute{
+			MarkdownDescription: "(If enabled, requires: external_auth_client_key) The certificate to use to authenticate with the external authorization service.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"external_auth_client_key_pass": schema.StringAttribute{
+			MarkdownDescription: "Passphrase to use for the private key when authenticating with the external service this is encrypted when stored.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"external_auth_client_key": schema.StringAttribute{
+			MarkdownDescription: "Private key for the certificate when authentication is required for the external authorization service, this is encrypted when stored.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"external_authorization_service_default_label": schema.StringAttribute{
+			MarkdownDescription: "The default classification label to use when requesting authorization and no classification label has been specified on the project.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"external_authorization_service_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: external_authorization_service_default_label, external_authorization_service_timeout and external_authorization_service_url) Enable using an external authorization service for accessing projects.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"external_authorization_service_timeout": schema.Float64Attribute{
+			MarkdownDescription: "The timeout after which an authorization request is aborted, in seconds. When a request times out, access is denied to the user. (min: 0.001, max: 10, step: 0.001).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Float64{float64planmodifier.UseStateForUnknown()},
+		},
+
+		"external_authorization_service_url": schema.StringAttribute{
+			MarkdownDescription: "URL to which authorization requests are directed.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"external_pipeline_validation_service_url": schema.StringAttribute{
+			MarkdownDescription: "URL to use for pipeline validation requests.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"external_pipeline_validation_service_token": schema.StringAttribute{
+			MarkdownDescription: "Optional. Token to include as the X-Gitlab-Token header in requests to the URL in external_pipeline_validation_service_url.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"external_pipeline_validation_service_timeout": schema.Int64Attribute{
+			MarkdownDescription: "How long to wait for a response from the pipeline validation service. Assumes OK if it times out.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"file_template_project_id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of a project to load custom file templates from.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"first_day_of_week": schema.Int64Attribute{
+			MarkdownDescription: "Start day of the week for calendar views and date pickers. Valid values are 0 (default) for Sunday, 1 for Monday, and 6 for Saturday.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"geo_node_allowed_ips": schema.StringAttribute{
+			MarkdownDescription: "Comma-separated list of IPs and CIDRs of allowed secondary nodes. For example, 1.1.1.1, 2.2.2.0/24.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"geo_status_timeout": schema.Int64Attribute{
+			MarkdownDescription: "The amount of seconds after which a request to get a secondary node status times out.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"git_two_factor_session_expiry": schema.Int64Attribute{
+			MarkdownDescription: "Maximum duration (in minutes) of a session for Git operations when 2FA is enabled.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"gitaly_timeout_default": schema.Int64Attribute{
+			MarkdownDescription: "Default Gitaly timeout, in seconds. This timeout is not enforced for Git fetch/push operations or Sidekiq jobs. Set to 0 to disable timeouts.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"gitaly_timeout_fast": schema.Int64Attribute{
+			MarkdownDescription: "Gitaly fast operation timeout, in seconds. Some Gitaly operations are expected to be fast. If they exceed this threshold, there may be a problem with a storage shard and ‘failing fast’ can help maintain the stability of the GitLab instance. Set to 0 to disable timeouts.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"gitaly_timeout_medium": schema.Int64Attribute{
+			MarkdownDescription: "Medium Gitaly timeout, in seconds. This should be a value between the Fast and the Default timeout. Set to 0 to disable timeouts.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"grafana_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable Grafana.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"grafana_url": schema.StringAttribute{
+			MarkdownDescription: "Grafana URL.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"gravatar_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable Gravatar.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"group_owners_can_manage_default_branch_protection": schema.BoolAttribute{
+			MarkdownDescription: "Prevent overrides of default branch protection.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"hashed_storage_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Create new projects using hashed storage paths: Enable immutable, hash-based paths and repository names to store repositories on disk. This prevents repositories from having to be moved or renamed when the Project URL changes and may improve disk I/O performance. (Always enabled in GitLab versions 13.0 and later, configuration is scheduled for removal in 14.0).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"help_page_hide_commercial_content": schema.BoolAttribute{
+			MarkdownDescription: "Hide marketing-related entries from help.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"help_page_support_url": schema.StringAttribute{
+			MarkdownDescription: "Alternate support URL for help page and help dropdown.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"help_page_text": schema.StringAttribute{
+			MarkdownDescription: "Custom text displayed on the help page.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"help_text": schema.StringAttribute{
+			MarkdownDescription: "GitLab server administrator information.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"hide_third_party_offers": schema.BoolAttribute{
+			MarkdownDescription: "Do not display offers from third parties in GitLab.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"home_page_url": schema.StringAttribute{
+			MarkdownDescription: "Redirect to this URL when not logged in.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"housekeeping_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: housekeeping_bitmaps_enabled, housekeeping_full_repack_period, housekeeping_gc_period, and housekeeping_incremental_repack_period) Enable or disable Git housekeeping.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"housekeeping_full_repack_period": schema.Int64Attribute{
+			MarkdownDescription: "Number of Git pushes after which an incremental git repack is run.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"housekeeping_gc_period": schema.Int64Attribute{
+			MarkdownDescription: "Number of Git pushes after which git gc is run.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"housekeeping_incremental_repack_period": schema.Int64Attribute{
+			MarkdownDescription: "Number of Git pushes after which an incremental git repack is run.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"html_emails_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable HTML emails.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"import_sources": schema.ListAttribute{
+			MarkdownDescription: "Sources to allow project import from, possible values: github, bitbucket, bitbucket_server, gitlab, fogbugz, git, gitlab_project, gitea, manifest, and phabricator.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"in_product_marketing_emails_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable in-product marketing emails. Enabled by default.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"inactive_projects_delete_after_months": schema.Int64Attribute{
+			MarkdownDescription: "If delete_inactive_projects is true, the time (in months) to wait before deleting inactive projects. Default is 2. Introduced in GitLab 14.10. Became operational in GitLab 15.0.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"inactive_projects_min_size_mb": schema.Int64Attribute{
+			MarkdownDescription: "If delete_inactive_projects is true, the minimum repository size for projects to be checked for inactivity. Default is 0. Introduced in GitLab 14.10. Became operational in GitLab 15.0.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"inactive_projects_send_warning_email_after_months": schema.Int64Attribute{
+			MarkdownDescription: "If delete_inactive_projects is true, sets the time (in months) to wait before emailing maintainers that the project is scheduled be deleted because it is inactive. Default is 1. Introduced in GitLab 14.10. Became operational in GitLab 15.0.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"invisible_captcha_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable Invisible CAPTCHA spam detection during sign-up. Disabled by default.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"issues_create_limit": schema.Int64Attribute{
+			MarkdownDescription: "Max number of issue creation requests per minute per user. Disabled by default.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"keep_latest_artifact": schema.BoolAttribute{
+			MarkdownDescription: "Prevent the deletion of the artifacts from the most recent successful jobs, regardless of the expiry time. Enabled by default.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"local_markdown_version": schema.Int64Attribute{
+			MarkdownDescription: "Increase this value when any cached Markdown should be invalidated.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"mailgun_signing_key": schema.StringAttribute{
+			MarkdownDescription: "The Mailgun HTTP webhook signing key for receiving events from webhook.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"mailgun_events_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable Mailgun event receiver.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"maintenance_mode_message": schema.StringAttribute{
+			MarkdownDescription: "Message displayed when instance is in maintenance mode.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"maintenance_mode": schema.BoolAttribute{
+			MarkdownDescription: "When instance is in maintenance mode, non-administrative users can sign in with read-only access and make read-only API requests.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"max_artifacts_size": schema.Int64Attribute{
+			MarkdownDescription: "Maximum artifacts size in MB.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"max_attachment_size": schema.Int64Attribute{
+			MarkdownDescription: "Limit attachment size in MB.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"max_export_size": schema.Int64Attribute{
+			MarkdownDescription: "Maximum export size in MB. 0 for unlimited. Default = 0 (unlimited).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"max_import_size": schema.Int64Attribute{
+			MarkdownDescription: "Maximum import size in MB. 0 for unlimited. Default = 0 (unlimited) Modified from 50MB to 0 in GitLab 13.8.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"max_pages_size": schema.Int64Attribute{
+			MarkdownDescription: "Maximum size of pages repositories in MB.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"max_personal_access_token_lifetime": schema.Int64Attribute{
+			MarkdownDescription: "Maximum allowable lifetime for access tokens in days.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"max_ssh_key_lifetime": schema.Int64Attribute{
+			MarkdownDescription: "Maximum allowable lifetime for SSH keys in days. Introduced in GitLab 14.6.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"metrics_method_call_threshold": schema.Int64Attribute{
+			MarkdownDescription: "A method call is only tracked when it takes longer than the given amount of milliseconds.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"max_number_of_repository_downloads": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of unique repositories a user can download in the specified time period before they are banned. Default: 0, Maximum: 10,000 repositories. Introduced in GitLab 15.1.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"max_number_of_repository_downloads_within_time_period": schema.Int64Attribute{
+			MarkdownDescription: "Reporting time period (in seconds). Default: 0, Maximum: 864000 seconds (10 days). Introduced in GitLab 15.1.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"git_rate_limit_users_allowlist": schema.ListAttribute{
+			MarkdownDescription: "List of usernames excluded from Git anti-abuse rate limits. Default: [], Maximum: 100 usernames. Introduced in GitLab 15.2.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"mirror_available": schema.BoolAttribute{
+			MarkdownDescription: "Allow repository mirroring to configured by project Maintainers. If disabled, only Administrators can configure repository mirroring.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"mirror_capacity_threshold": schema.Int64Attribute{
+			MarkdownDescription: "Minimum capacity to be available before scheduling more mirrors preemptively.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"mirror_max_capacity": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of mirrors that can be synchronizing at the same time.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"mirror_max_delay": schema.Int64Attribute{
+			MarkdownDescription: "Maximum time (in minutes) between updates that a mirror can have when scheduled to synchronize.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"npm_package_requests_forwarding": schema.BoolAttribute{
+			MarkdownDescription: "Use npmjs.org as a default remote repository when the package is not found in the GitLab Package Registry for npm.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"pypi_package_requests_forwarding": schema.BoolAttribute{
+			MarkdownDescription: "Use pypi.org as a default remote repository when the package is not found in the GitLab Package Registry for PyPI.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"outbound_local_requests_whitelist": schema.ListAttribute{
+			MarkdownDescription: "Define a list of trusted domains or IP addresses to which local requests are allowed when local requests for hooks and services are disabled.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"pages_domain_verification_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Require users to prove ownership of custom domains. Domain verification is an essential security measure for public GitLab sites. Users are required to demonstrate they control a domain before it is enabled.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"password_authentication_enabled_for_git": schema.BoolAttribute{
+			MarkdownDescription: "Enable authentication for Git over HTTP(S) via a GitLab account password. Default is true.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"password_authentication_enabled_for_web": schema.BoolAttribute{
+			MarkdownDescription: "Enable authentication for the web interface via a GitLab account password. Default is true.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"password_number_required": schema.BoolAttribute{
+			MarkdownDescription: "Indicates whether passwords require at least one number. Introduced in GitLab 15.1.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"password_symbol_required": schema.BoolAttribute{
+			MarkdownDescription: "Indicates whether passwords require at least one symbol character. Introduced in GitLab 15.1.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"password_uppercase_required": schema.BoolAttribute{
+			MarkdownDescription: "Indicates whether passwords require at least one uppercase letter. Introduced in GitLab 15.1.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"password_lowercase_required": schema.BoolAttribute{
+			MarkdownDescription: "Indicates whether passwords require at least one lowercase letter. Introduced in GitLab 15.1.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"performance_bar_allowed_group_path": schema.StringAttribute{
+			MarkdownDescription: "Path of the group that is allowed to toggle the performance bar.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"personal_access_token_prefix": schema.StringAttribute{
+			MarkdownDescription: "Prefix for all generated personal access tokens.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"pipeline_limit_per_project_user_sha": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of pipeline creation requests per minute per user and commit. Disabled by default.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"plantuml_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: plantuml_url) Enable PlantUML integration. Default is false.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"plantuml_url": schema.StringAttribute{
+			MarkdownDescription: "The PlantUML instance URL for integration.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"polling_interval_multiplier": schema.Float64Attribute{
+			MarkdownDescription: "Interval multiplier used by endpoints that perform polling. Set to 0 to disable polling.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Float64{float64planmodifier.UseStateForUnknown()},
+		},
+
+		"project_export_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable project export.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"prometheus_metrics_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable Prometheus metrics.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"protected_ci_variables": schema.BoolAttribute{
+			MarkdownDescription: "CI/CD variables are protected by default.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"push_event_activities_limit": schema.Int64Attribute{
+			MarkdownDescription: "Number of changes (branches or tags) in a single push to determine whether individual push events or bulk push events are created. Bulk push events are created if it surpasses that value.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"push_event_hooks_limit": schema.Int64Attribute{
+			MarkdownDescription: "Number of changes (branches or tags) in a single push to determine whether webhooks and services fire or not. Webhooks and services aren’t submitted if it surpasses that value.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"rate_limiting_response_text": schema.StringAttribute{
+			MarkdownDescription: "When rate limiting is enabled via the throttle_* settings, send this plain text response when a rate limit is exceeded. ‘Retry later’ is sent if this is blank.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"raw_blob_request_limit": schema.Int64Attribute{
+			MarkdownDescription: "Max number of requests per minute for each raw path. Default: 300. To disable throttling set to 0.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"search_rate_limit": schema.Int64Attribute{
+			MarkdownDescription: "Max number of requests per minute for performing a search while authenticated. Default: 30. To disable throttling set to 0.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"search_rate_limit_unauthenticated": schema.Int64Attribute{
+			MarkdownDescription: "Max number of requests per minute for performing a search while unauthenticated. Default: 10. To disable throttling set to 0.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"recaptcha_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: recaptcha_private_key and recaptcha_site_key) Enable reCAPTCHA.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"recaptcha_private_key": schema.StringAttribute{
+			MarkdownDescription: "Private key for reCAPTCHA.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"recaptcha_site_key": schema.StringAttribute{
+			MarkdownDescription: "Site key for reCAPTCHA.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"receive_max_input_size": schema.Int64Attribute{
+			MarkdownDescription: "Maximum push size (MB).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"repository_checks_enabled": schema.BoolAttribute{
+			MarkdownDescription: "GitLab periodically runs git fsck in all project and wiki repositories to look for silent disk corruption issues.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"repository_size_limit": schema.Int64Attribute{
+			MarkdownDescription: "Size limit per repository (MB).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"repository_storages_weighted": schema.MapAttribute{
+			MarkdownDescription: "(GitLab 13.1 and later) Hash of names of taken from gitlab.yml to weights. New projects are created in one of these stores, chosen by a weighted random selection.",
+			ElementType:         types.Int64Type,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
+		},
+
+		"repository_storages": schema.ListAttribute{
+			MarkdownDescription: "(GitLab 13.0 and earlier) List of names of enabled storage paths, taken from gitlab.yml. New projects are created in one of these stores, chosen at random.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"require_admin_approval_after_user_signup": schema.BoolAttribute{
+			MarkdownDescription: "When enabled, any user that signs up for an account using the registration form is placed under a Pending approval state and has to be explicitly approved by an administrator.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"require_two_factor_authentication": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: two_factor_grace_period) Require all users to set up Two-factor authentication.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"restricted_visibility_levels": schema.ListAttribute{
+			MarkdownDescription: "Selected levels cannot be used by non-Administrator users for groups, projects or snippets. Can take private, internal and public as a parameter. Default is null which means there is no restriction.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+
+		"rsa_key_restriction": schema.Int64Attribute{
+			MarkdownDescription: "The minimum allowed bit length of an uploaded RSA key. Default is 0 (no restriction). -1 disables RSA keys.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"send_user_confirmation_email": schema.BoolAttribute{
+			MarkdownDescription: "Send confirmation email on sign-up.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"session_expire_delay": schema.Int64Attribute{
+			MarkdownDescription: "Session duration in minutes. GitLab restart is required to apply changes.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"shared_runners_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: shared_runners_text and shared_runners_minutes) Enable shared runners for new projects.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"shared_runners_minutes": schema.Int64Attribute{
+			MarkdownDescription: "Set the maximum number of CI/CD minutes that a group can use on shared runners per month.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"shared_runners_text": schema.StringAttribute{
+			MarkdownDescription: "Shared runners text.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"sidekiq_job_limiter_mode": schema.StringAttribute{
+			MarkdownDescription: "track or compress. Sets the behavior for Sidekiq job size limits. Default: ‘compress’.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"sidekiq_job_limiter_compression_threshold_bytes": schema.Int64Attribute{
+			MarkdownDescription: "The threshold in bytes at which Sidekiq jobs are compressed before being stored in Redis. Default: 100 000 bytes (100KB).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"sidekiq_job_limiter_limit_bytes": schema.Int64Attribute{
+			MarkdownDescription: "The threshold in bytes at which Sidekiq jobs are rejected. Default: 0 bytes (doesn’t reject any job).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"sign_in_text": schema.StringAttribute{
+			MarkdownDescription: "Text on the login page.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"signup_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable registration. Default is true.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"slack_app_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: slack_app_id, slack_app_secret and slack_app_secret) Enable Slack app.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"slack_app_id": schema.StringAttribute{
+			MarkdownDescription: "The app ID of the Slack-app.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"slack_app_secret": schema.StringAttribute{
+			MarkdownDescription: "The app secret of the Slack-app.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"slack_app_signing_secret": schema.StringAttribute{
+			MarkdownDescription: "The signing secret of the Slack-app.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"slack_app_verification_token": schema.StringAttribute{
+			MarkdownDescription: "The verification token of the Slack-app.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"snippet_size_limit": schema.Int64Attribute{
+			MarkdownDescription: "Max snippet content size in bytes. Default: 52428800 Bytes (50MB).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"snowplow_app_id": schema.StringAttribute{
+			MarkdownDescription: "The Snowplow site name / application ID. (for example, gitlab)",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"snowplow_collector_hostname": schema.StringAttribute{
+			MarkdownDescription: "The Snowplow collector hostname. (for example, snowplow.trx.gitlab.net)",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"snowplow_cookie_domain": schema.StringAttribute{
+			MarkdownDescription: "The Snowplow cookie domain. (for example, .gitlab.com)",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"snowplow_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable snowplow tracking.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"sourcegraph_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enables Sourcegraph integration. Default is false. If enabled, requires sourcegraph_url.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"sourcegraph_public_only": schema.BoolAttribute{
+			MarkdownDescription: "Blocks Sourcegraph from being loaded on private and internal projects. Default is true.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"sourcegraph_url": schema.StringAttribute{
+			MarkdownDescription: "The Sourcegraph instance URL for integration.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"spam_check_endpoint_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enables spam checking using external Spam Check API endpoint. Default is false.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"spam_check_endpoint_url": schema.StringAttribute{
+			MarkdownDescription: "URL of the external Spamcheck service endpoint. Valid URI schemes are grpc or tls. Specifying tls forces communication to be encrypted.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"spam_check_api_key": schema.StringAttribute{
+			MarkdownDescription: "API key used by GitLab for accessing the Spam Check service endpoint.",
+			Sensitive:           true,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"suggest_pipeline_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable pipeline suggestion banner.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"terminal_max_session_time": schema.Int64Attribute{
+			MarkdownDescription: "Maximum time for web terminal websocket connection (in seconds). Set to 0 for unlimited time.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"terms": schema.StringAttribute{
+			MarkdownDescription: "(Required by: enforce_terms) Markdown content for the ToS.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_authenticated_api_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: throttle_authenticated_api_period_in_seconds and throttle_authenticated_api_requests_per_period) Enable authenticated API request rate limit. Helps reduce request volume (for example, from crawlers or abusive bots).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_authenticated_api_period_in_seconds": schema.Int64Attribute{
+			MarkdownDescription: "Rate limit period (in seconds).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_authenticated_api_requests_per_period": schema.Int64Attribute{
+			MarkdownDescription: "Maximum requests per period per user.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_authenticated_packages_api_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: throttle_authenticated_packages_api_period_in_seconds and throttle_authenticated_packages_api_requests_per_period) Enable authenticated API request rate limit. Helps reduce request volume (for example, from crawlers or abusive bots). View Package Registry rate limits for more details.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_authenticated_packages_api_period_in_seconds": schema.Int64Attribute{
+			MarkdownDescription: "Rate limit period (in seconds). View Package Registry rate limits for more details.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_authenticated_packages_api_requests_per_period": schema.Int64Attribute{
+			MarkdownDescription: "Maximum requests per period per user. View Package Registry rate limits for more details.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_authenticated_web_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: throttle_authenticated_web_period_in_seconds and throttle_authenticated_web_requests_per_period) Enable authenticated web request rate limit. Helps reduce request volume (for example, from crawlers or abusive bots).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_authenticated_web_period_in_seconds": schema.Int64Attribute{
+			MarkdownDescription: "Rate limit period (in seconds).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_authenticated_web_requests_per_period": schema.Int64Attribute{
+			MarkdownDescription: "Maximum requests per period per user.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_unauthenticated_api_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: throttle_unauthenticated_api_period_in_seconds and throttle_unauthenticated_api_requests_per_period) Enable unauthenticated API request rate limit. Helps reduce request volume (for example, from crawlers or abusive bots).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_unauthenticated_api_period_in_seconds": schema.Int64Attribute{
+			MarkdownDescription: "Rate limit period in seconds.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_unauthenticated_api_requests_per_period": schema.Int64Attribute{
+			MarkdownDescription: "Max requests per period per IP.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_unauthenticated_packages_api_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: throttle_unauthenticated_packages_api_period_in_seconds and throttle_unauthenticated_packages_api_requests_per_period) Enable authenticated API request rate limit. Helps reduce request volume (for example, from crawlers or abusive bots). View Package Registry rate limits for more details.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_unauthenticated_packages_api_period_in_seconds": schema.Int64Attribute{
+			MarkdownDescription: "Rate limit period (in seconds). View Package Registry rate limits for more details.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_unauthenticated_packages_api_requests_per_period": schema.Int64Attribute{
+			MarkdownDescription: "Maximum requests per period per user. View Package Registry rate limits for more details.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_unauthenticated_web_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: throttle_unauthenticated_web_period_in_seconds and throttle_unauthenticated_web_requests_per_period) Enable unauthenticated web request rate limit. Helps reduce request volume (for example, from crawlers or abusive bots).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_unauthenticated_web_period_in_seconds": schema.Int64Attribute{
+			MarkdownDescription: "Rate limit period in seconds.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"throttle_unauthenticated_web_requests_per_period": schema.Int64Attribute{
+			MarkdownDescription: "Max requests per period per IP.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"time_tracking_limit_to_hours": schema.BoolAttribute{
+			MarkdownDescription: "Limit display of time tracking units to hours. Default is false.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"two_factor_grace_period": schema.Int64Attribute{
+			MarkdownDescription: "Amount of time (in hours) that users are allowed to skip forced configuration of two-factor authentication.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"unique_ips_limit_enabled": schema.BoolAttribute{
+			MarkdownDescription: "(If enabled, requires: unique_ips_limit_per_user and unique_ips_limit_time_window) Limit sign in from multiple IPs.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"unique_ips_limit_per_user": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of IPs per user.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"unique_ips_limit_time_window": schema.Int64Attribute{
+			MarkdownDescription: "How many seconds an IP is counted towards the limit.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+
+		"usage_ping_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Every week GitLab reports license usage back to GitLab, Inc.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"user_deactivation_emails_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Send an email to users upon account deactivation.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"user_default_external": schema.BoolAttribute{
+			MarkdownDescription: "Newly registered users are external by default.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"user_default_internal_regex": schema.StringAttribute{
+			MarkdownDescription: "Specify an email address regex pattern to identify default internal users.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"user_oauth_applications": schema.BoolAttribute{
+			MarkdownDescription: "Allow users to register any application to use GitLab as an OAuth provider.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"user_show_add_ssh_key_message": schema.BoolAttribute{
+			MarkdownDescription: "When set to false disable the You won't be able to pull or push project code via SSH warning shown to users with no uploaded SSH key.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"version_check_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Let GitLab inform you when an update is available.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"whats_new_variant": schema.StringAttribute{
+			MarkdownDescription: "What’s new variant, possible values: all_tiers, current_tier, and disabled.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+
+		"web_ide_clientside_preview_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Live Preview (allow live previews of JavaScript projects in the Web IDE using CodeSandbox Live Preview).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+
+		"wiki_page_max_content_bytes": schema.Int64Attribute{
+			MarkdownDescription: "Maximum wiki page content size in bytes. Default: 52428800 Bytes (50 MB). The minimum value is 1024 bytes.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+	}
+}
+
+// applicationSettingsToStateModel sets the application settings in the model.
+func applicationSettingsToStateModel(ctx context.Context, settings *gitlab.Settings, data *gitlabApplicationSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	data.AdminMode = types.BoolValue(settings.AdminMode)
+	data.AbuseNotificationEmail = types.StringValue(settings.AbuseNotificationEmail)
+	data.AfterSignOutPath = types.StringValue(settings.AfterSignOutPath)
+	data.AfterSignUpText = types.StringValue(settings.AfterSignUpText)
+	data.AkismetApiKey = types.StringValue(settings.AkismetAPIKey)
+	data.AkismetEnabled = types.BoolValue(settings.AkismetEnabled)
+	data.AllowGroupOwnersToManageLdap = types.BoolValue(settings.AllowGroupOwnersToManageLDAP)
+	data.AllowLocalRequestsFromSystemHooks = types.BoolValue(settings.AllowLocalRequestsFromSystemHooks)
+	data.AllowLocalRequestsFromWebHooksAndServices = types.BoolValue(settings.AllowLocalRequestsFromWebHooksAndServices)
+	data.ArchiveBuildsInHumanReadable = types.StringValue(settings.ArchiveBuildsInHumanReadable)
+	data.AssetProxyEnabled = types.BoolValue(settings.AssetProxyEnabled)
+	data.AssetProxySecretKey = types.StringValue(settings.AssetProxySecretKey)
+	data.AssetProxyUrl = types.StringValue(settings.AssetProxyURL)
+	data.AssetProxyAllowlist, d = stringListValue(ctx, settings.AssetProxyAllowlist)
+	diags.Append(d...)
+	data.AuthorizedKeysEnabled = types.BoolValue(settings.AuthorizedKeysEnabled)
+	data.AutoDevopsDomain = types.StringValue(settings.AutoDevOpsDomain)
+	data.AutoDevopsEnabled = types.BoolValue(settings.AutoDevOpsEnabled)
+	data.AutomaticPurchasedStorageAllocation = types.BoolValue(settings.AutomaticPurchasedStorageAllocation)
+	data.CheckNamespacePlan = types.BoolValue(settings.CheckNamespacePlan)
+	data.CommitEmailHostname = types.StringValue(settings.CommitEmailHostname)
+	data.ContainerExpirationPoliciesEnableHistoricEntries = types.BoolValue(settings.ContainerExpirationPoliciesEnableHistoricEntries)
+	data.ContainerRegistryCleanupTagsServiceMaxListSize = types.Int64Value(int64(settings.ContainerRegistryCleanupTagsServiceMaxListSize))
+	data.ContainerRegistryDeleteTagsServiceTimeout = types.Int64Value(int64(settings.ContainerRegistryDeleteTagsServiceTimeout))
+	data.ContainerRegistryExpirationPoliciesCaching = types.BoolValue(settings.ContainerRegistryExpirationPoliciesCaching)
+	data.ContainerRegistryExpirationPoliciesWorkerCapacity = types.Int64Value(int64(settings.ContainerRegistryExpirationPoliciesWorkerCapacity))
+	data.ContainerRegistryTokenExpireDelay = types.Int64Value(int64(settings.ContainerRegistryTokenExpireDelay))
+	data.PackageRegistryCleanupPoliciesWorkerCapacity = types.Int64Value(int64(settings.PackageRegistryCleanupPoliciesWorkerCapacity))
+	data.DeactivateDormantUsers = types.BoolValue(settings.DeactivateDormantUsers)
+	data.DefaultArtifactsExpireIn = types.StringValue(settings.DefaultArtifactsExpireIn)
+	data.DefaultBranchName = types.StringValue(settings.DefaultBranchName)
+	data.DefaultBranchProtection = types.Int64Value(int64(settings.DefaultBranchProtection))
+	data.DefaultCiConfigPath = types.StringValue(settings.DefaultCiConfigPath)
+	data.DefaultGroupVisibility = types.StringValue(string(settings.DefaultGroupVisibility))
+	data.DefaultProjectCreation = types.Int64Value(int64(settings.DefaultProjectCreation))
+	data.DefaultProjectVisibility = types.StringValue(string(settings.DefaultProjectVisibility))
+	data.DefaultProjectsLimit = types.Int64Value(int64(settings.DefaultProjectsLimit))
+	data.DefaultSnippetVisibility = types.StringValue(string(settings.DefaultSnippetVisibility))
+	data.DelayedProjectDeletion = types.BoolValue(settings.DelayedProjectDeletion)
+	data.DelayedGroupDeletion = types.BoolValue(settings.DelayedGroupDeletion)
+	data.DeleteInactiveProjects = types.BoolValue(settings.DeleteInactiveProjects)
+	data.DeletionAdjournedPeriod = types.Int64Value(int64(settings.DeletionAdjournedPeriod))
+	data.DiffMaxPatchBytes = types.Int64Value(int64(settings.DiffMaxPatchBytes))
+	data.DiffMaxFiles = types.Int64Value(int64(settings.DiffMaxFiles))
+	data.DiffMaxLines = types.Int64Value(int64(settings.DiffMaxLines))
+	data.DisableFeedToken = types.BoolValue(settings.DisableFeedToken)
+	data.DisabledOauthSignInSources, d = stringListValue(ctx, settings.DisabledOauthSignInSources)
+	diags.Append(d...)
+	data.DnsRebindingProtectionEnabled = types.BoolValue(settings.DNSRebindingProtectionEnabled)
+	data.DomainDenylistEnabled = types.BoolValue(settings.DomainDenylistEnabled)
+	data.DomainDenylist, d = stringListValue(ctx, settings.DomainDenylist)
+	diags.Append(d...)
+	data.DomainAllowlist, d = stringListValue(ctx, settings.DomainAllowlist)
+	diags.Append(d...)
+	data.DsaKeyRestriction = types.Int64Value(int64(settings.DSAKeyRestriction))
+	data.EcdsaKeyRestriction = types.Int64Value(int64(settings.ECDSAKeyRestriction))
+	data.EcdsaSkKeyRestriction = types.Int64Value(int64(settings.ECDSASKKeyRestriction))
+	data.Ed25519KeyRestriction = types.Int64Value(int64(settings.Ed25519KeyRestriction))
+	data.Ed25519SkKeyRestriction = types.Int64Value(int64(settings.Ed25519SKKeyRestriction))
+	data.EksAccessKeyId = types.StringValue(settings.EKSAccessKeyID)
+	data.EksAccountId = types.StringValue(settings.EKSAccountID)
+	data.EksIntegrationEnabled = types.BoolValue(settings.EKSIntegrationEnabled)
+	data.EksSecretAccessKey = types.StringValue(settings.EKSSecretAccessKey)
+	data.ElasticsearchAwsAccessKey = types.StringValue(settings.ElasticsearchAWSAccessKey)
+	data.ElasticsearchAwsRegion = types.StringValue(settings.ElasticsearchAWSRegion)
+	data.ElasticsearchAwsSecretAccessKey = types.StringValue(settings.ElasticsearchAWSSecretAccessKey)
+	data.ElasticsearchAws = types.BoolValue(settings.ElasticsearchAWS)
+	data.ElasticsearchIndexedFieldLengthLimit = types.Int64Value(int64(settings.ElasticsearchIndexedFieldLengthLimit))
+	data.ElasticsearchIndexedFileSizeLimitKb = types.Int64Value(int64(settings.ElasticsearchIndexedFileSizeLimitKB))
+	data.ElasticsearchIndexing = types.BoolValue(settings.ElasticsearchIndexing)
+	data.ElasticsearchLimitIndexing = types.BoolValue(settings.ElasticsearchLimitIndexing)
+	data.ElasticsearchMaxBulkConcurrency = types.Int64Value(int64(settings.ElasticsearchMaxBulkConcurrency))
+	data.ElasticsearchMaxBulkSizeMb = types.Int64Value(int64(settings.ElasticsearchMaxBulkSizeMB))
+	data.ElasticsearchNamespaceIds, d = stringListValue(ctx, intsToStrings(settings.ElasticsearchNamespaceIDs))
+	diags.Append(d...)
+	data.ElasticsearchProjectIds, d = stringListValue(ctx, intsToStrings(settings.ElasticsearchProjectIDs))
+	diags.Append(d...)
+	data.ElasticsearchSearch = types.BoolValue(settings.ElasticsearchSearch)
+	data.ElasticsearchUrl, d = stringListValue(ctx, settings.ElasticsearchURL)
+	diags.Append(d...)
+	data.ElasticsearchUsername = types.StringValue(settings.ElasticsearchUsername)
+	data.ElasticsearchPassword = types.StringValue(settings.ElasticsearchPassword)
+	data.EmailAdditionalText = types.StringValue(settings.EmailAdditionalText)
+	data.EmailAuthorInBody = types.BoolValue(settings.EmailAuthorInBody)
+	data.EnabledGitAccessProtocol = types.StringValue(settings.EnabledGitAccessProtocol)
+	data.EnforceNamespaceStorageLimit = types.BoolValue(settings.EnforceNamespaceStorageLimit)
+	data.EnforceTerms = types.BoolValue(settings.EnforceTerms)
+	data.ExternalAuthClientCert = types.StringValue(settings.ExternalAuthClientCert)
+	data.ExternalAuthClientKeyPass = types.StringValue(settings.ExternalAuthClientKeyPass)
+	data.ExternalAuthClientKey = types.StringValue(settings.ExternalAuthClientKey)
+	data.ExternalAuthorizationServiceDefaultLabel = types.StringValue(settings.ExternalAuthorizationServiceDefaultLabel)
+	data.ExternalAuthorizationServiceEnabled = types.BoolValue(settings.ExternalAuthorizationServiceEnabled)
+	data.ExternalAuthorizationServiceTimeout = types.Float64Value(settings.ExternalAuthorizationServiceTimeout)
+	data.ExternalAuthorizationServiceUrl = types.StringValue(settings.ExternalAuthorizationServiceURL)
+	data.ExternalPipelineValidationServiceUrl = types.StringValue(settings.ExternalPipelineValidationServiceURL)
+	data.ExternalPipelineValidationServiceToken = types.StringValue(settings.ExternalPipelineValidationServiceToken)
+	data.ExternalPipelineValidationServiceTimeout = types.Int64Value(int64(settings.ExternalPipelineValidationServiceTimeout))
+	data.FileTemplateProjectId = types.Int64Value(int64(settings.FileTemplateProjectID))
+	data.FirstDayOfWeek = types.Int64Value(int64(settings.FirstDayOfWeek))
+	data.GeoNodeAllowedIps = types.StringValue(settings.GeoNodeAllowedIPs)
+	data.GeoStatusTimeout = types.Int64Value(int64(settings.GeoStatusTimeout))
+	data.GitTwoFactorSessionExpiry = types.Int64Value(int64(settings.GitTwoFactorSessionExpiry))
+	data.GitalyTimeoutDefault = types.Int64Value(int64(settings.GitalyTimeoutDefault))
+	data.GitalyTimeoutFast = types.Int64Value(int64(settings.GitalyTimeoutFast))
+	data.GitalyTimeoutMedium = types.Int64Value(int64(settings.GitalyTimeoutMedium))
+	data.GrafanaEnabled = types.BoolValue(settings.GrafanaEnabled)
+	data.GrafanaUrl = types.StringValue(settings.GrafanaURL)
+	data.GravatarEnabled = types.BoolValue(settings.GravatarEnabled)
+	data.GroupOwnersCanManageDefaultBranchProtection = types.BoolValue(settings.GroupOwnersCanManageDefaultBranchProtection)
+	data.HashedStorageEnabled = types.BoolValue(settings.HashedStorageEnabled)
+	data.HelpPageHideCommercialContent = types.BoolValue(settings.HelpPageHideCommercialContent)
+	data.HelpPageSupportUrl = types.StringValue(settings.HelpPageSupportURL)
+	data.HelpPageText = types.StringValue(settings.HelpPageText)
+	data.HelpText = types.StringValue(settings.HelpText)
+	data.HideThirdPartyOffers = types.BoolValue(settings.HideThirdPartyOffers)
+	data.HomePageUrl = types.StringValue(settings.HomePageURL)
+	data.HousekeepingEnabled = types.BoolValue(settings.HousekeepingEnabled)
+	data.HousekeepingFullRepackPeriod = types.Int64Value(int64(settings.HousekeepingFullRepackPeriod))
+	data.HousekeepingGcPeriod = types.Int64Value(int64(settings.HousekeepingGcPeriod))
+	data.HousekeepingIncrementalRepackPeriod = types.Int64Value(int64(settings.HousekeepingIncrementalRepackPeriod))
+	data.HtmlEmailsEnabled = types.BoolValue(settings.HTMLEmailsEnabled)
+	data.ImportSources, d = stringListValue(ctx, settings.ImportSources)
+	diags.Append(d...)
+	data.InProductMarketingEmailsEnabled = types.BoolValue(settings.InProductMarketingEmailsEnabled)
+	data.InactiveProjectsDeleteAfterMonths = types.Int64Value(int64(settings.InactiveProjectsDeleteAfterMonths))
+	data.InactiveProjectsMinSizeMb = types.Int64Value(int64(settings.InactiveProjectsMinSizeMB))
+	data.InactiveProjectsSendWarningEmailAfterMonths = types.Int64Value(int64(settings.InactiveProjectsSendWarningEmailAfterMonths))
+	data.InvisibleCaptchaEnabled = types.BoolValue(settings.InvisibleCaptchaEnabled)
+	data.IssuesCreateLimit = types.Int64Value(int64(settings.IssuesCreateLimit))
+	data.KeepLatestArtifact = types.BoolValue(settings.KeepLatestArtifact)
+	data.LocalMarkdownVersion = types.Int64Value(int64(settings.LocalMarkdownVersion))
+	data.MailgunSigningKey = types.StringValue(settings.MailgunSigningKey)
+	data.MailgunEventsEnabled = types.BoolValue(settings.MailgunEventsEnabled)
+	data.MaintenanceModeMessage = types.StringValue(settings.MaintenanceModeMessage)
+	data.MaintenanceMode = types.BoolValue(settings.MaintenanceMode)
+	data.MaxArtifactsSize = types.Int64Value(int64(settings.MaxArtifactsSize))
+	data.MaxAttachmentSize = types.Int64Value(int64(settings.MaxAttachmentSize))
+	data.MaxExportSize = types.Int64Value(int64(settings.MaxExportSize))
+	data.MaxImportSize = types.Int64Value(int64(settings.MaxImportSize))
+	data.MaxPagesSize = types.Int64Value(int64(settings.MaxPagesSize))
+	data.MaxPersonalAccessTokenLifetime = types.Int64Value(int64(settings.MaxPersonalAccessTokenLifetime))
+	data.MaxSshKeyLifetime = types.Int64Value(int64(settings.MaxSSHKeyLifetime))
+	data.MetricsMethodCallThreshold = types.Int64Value(int64(settings.MetricsMethodCallThreshold))
+	data.MaxNumberOfRepositoryDownloads = types.Int64Value(int64(settings.MaxNumberOfRepositoryDownloads))
+	data.MaxNumberOfRepositoryDownloadsWithinTimePeriod = types.Int64Value(int64(settings.MaxNumberOfRepositoryDownloadsWithinTimePeriod))
+	data.GitRateLimitUsersAllowlist, d = stringListValue(ctx, settings.GitRateLimitUsersAllowlist)
+	diags.Append(d...)
+	data.MirrorAvailable = types.BoolValue(settings.MirrorAvailable)
+	data.MirrorCapacityThreshold = types.Int64Value(int64(settings.MirrorCapacityThreshold))
+	data.MirrorMaxCapacity = types.Int64Value(int64(settings.MirrorMaxCapacity))
+	data.MirrorMaxDelay = types.Int64Value(int64(settings.MirrorMaxDelay))
+	data.NpmPackageRequestsForwarding = types.BoolValue(settings.NPMPackageRequestsForwarding)
+	data.PypiPackageRequestsForwarding = types.BoolValue(settings.PyPIPackageRequestsForwarding)
+	data.OutboundLocalRequestsWhitelist, d = stringListValue(ctx, settings.OutboundLocalRequestsWhitelist)
+	diags.Append(d...)
+	data.PagesDomainVerificationEnabled = types.BoolValue(settings.PagesDomainVerificationEnabled)
+	data.PasswordAuthenticationEnabledForGit = types.BoolValue(settings.PasswordAuthenticationEnabledForGit)
+	data.PasswordAuthenticationEnabledForWeb = types.BoolValue(settings.PasswordAuthenticationEnabledForWeb)
+	data.PasswordNumberRequired = types.BoolValue(settings.PasswordNumberRequired)
+	data.PasswordSymbolRequired = types.BoolValue(settings.PasswordSymbolRequired)
+	data.PasswordUppercaseRequired = types.BoolValue(settings.PasswordUppercaseRequired)
+	data.PasswordLowercaseRequired = types.BoolValue(settings.PasswordLowercaseRequired)
+	data.PerformanceBarAllowedGroupPath = types.StringValue(settings.PerformanceBarAllowedGroupPath)
+	data.PersonalAccessTokenPrefix = types.StringValue(settings.PersonalAccessTokenPrefix)
+	data.PipelineLimitPerProjectUserSha = types.Int64Value(int64(settings.PipelineLimitPerProjectUserSha))
+	data.PlantumlEnabled = types.BoolValue(settings.PlantumlEnabled)
+	data.PlantumlUrl = types.StringValue(settings.PlantumlURL)
+	data.PollingIntervalMultiplier = types.Float64Value(settings.PollingIntervalMultiplier)
+	data.ProjectExportEnabled = types.BoolValue(settings.ProjectExportEnabled)
+	data.PrometheusMetricsEnabled = types.BoolValue(settings.PrometheusMetricsEnabled)
+	data.ProtectedCiVariables = types.BoolValue(settings.ProtectedCIVariables)
+	data.PushEventActivitiesLimit = types.Int64Value(int64(settings.PushEventActivitiesLimit))
+	data.PushEventHooksLimit = types.Int64Value(int64(settings.PushEventHooksLimit))
+	data.RateLimitingResponseText = types.StringValue(settings.RateLimitingResponseText)
+	data.RawBlobRequestLimit = types.Int64Value(int64(settings.RawBlobRequestLimit))
+	data.SearchRateLimit = types.Int64Value(int64(settings.SearchRateLimit))
+	data.SearchRateLimitUnauthenticated = types.Int64Value(int64(settings.SearchRateLimitUnauthenticated))
+	data.RecaptchaEnabled = types.BoolValue(settings.RecaptchaEnabled)
+	data.RecaptchaPrivateKey = types.StringValue(settings.RecaptchaPrivateKey)
+	data.RecaptchaSiteKey = types.StringValue(settings.RecaptchaSiteKey)
+	data.ReceiveMaxInputSize = types.Int64Value(int64(settings.ReceiveMaxInputSize))
+	data.RepositoryChecksEnabled = types.BoolValue(settings.RepositoryChecksEnabled)
+	data.RepositorySizeLimit = types.Int64Value(int64(settings.RepositorySizeLimit))
+	data.RepositoryStoragesWeighted, d = types.MapValueFrom(ctx, types.Int64Type, settings.RepositoryStoragesWeighted)
+	diags.Append(d...)
+	data.RepositoryStorages, d = stringListValue(ctx, settings.RepositoryStorages)
+	diags.Append(d...)
+	data.RequireAdminApprovalAfterUserSignup = types.BoolValue(settings.RequireAdminApprovalAfterUserSignup)
+	data.RequireTwoFactorAuthentication = types.BoolValue(settings.RequireTwoFactorAuthentication)
+	data.RestrictedVisibilityLevels, d = stringListValue(ctx, visibilityValuesToStrings(settings.RestrictedVisibilityLevels))
+	diags.Append(d...)
+	data.RsaKeyRestriction = types.Int64Value(int64(settings.RSAKeyRestriction))
+	data.SendUserConfirmationEmail = types.BoolValue(settings.SendUserConfirmationEmail)
+	data.SessionExpireDelay = types.Int64Value(int64(settings.SessionExpireDelay))
+	data.SharedRunnersEnabled = types.BoolValue(settings.SharedRunnersEnabled)
+	data.SharedRunnersMinutes = types.Int64Value(int64(settings.SharedRunnersMinutes))
+	data.SharedRunnersText = types.StringValue(settings.SharedRunnersText)
+	data.SidekiqJobLimiterMode = types.StringValue(settings.SidekiqJobLimiterMode)
+	data.SidekiqJobLimiterCompressionThresholdBytes = types.Int64Value(int64(settings.SidekiqJobLimiterCompressionThresholdBytes))
+	data.SidekiqJobLimiterLimitBytes = types.Int64Value(int64(settings.SidekiqJobLimiterLimitBytes))
+	data.SignInText = types.StringValue(settings.SignInText)
+	data.SignupEnabled = types.BoolValue(settings.SignupEnabled)
+	data.SlackAppEnabled = types.BoolValue(settings.SlackAppEnabled)
+	data.SlackAppId = types.StringValue(settings.SlackAppID)
+	data.SlackAppSecret = types.StringValue(settings.SlackAppSecret)
+	data.SlackAppSigningSecret = types.StringValue(settings.SlackAppSigningSecret)
+	data.SlackAppVerificationToken = types.StringValue(settings.SlackAppVerificationToken)
+	data.SnippetSizeLimit = types.Int64Value(int64(settings.SnippetSizeLimit))
+	data.SnowplowAppId = types.StringValue(settings.SnowplowAppID)
+	data.SnowplowCollectorHostname = types.StringValue(settings.SnowplowCollectorHostname)
+	data.SnowplowCookieDomain = types.StringValue(settings.SnowplowCookieDomain)
+	data.SnowplowEnabled = types.BoolValue(settings.SnowplowEnabled)
+	data.SourcegraphEnabled = types.BoolValue(settings.SourcegraphEnabled)
+	data.SourcegraphPublicOnly = types.BoolValue(settings.SourcegraphPublicOnly)
+	data.SourcegraphUrl = types.StringValue(settings.SourcegraphURL)
+	data.SpamCheckEndpointEnabled = types.BoolValue(settings.SpamCheckEndpointEnabled)
+	data.SpamCheckEndpointUrl = types.StringValue(settings.SpamCheckEndpointURL)
+	data.SpamCheckApiKey = types.StringValue(settings.SpamCheckAPIKey)
+	data.SuggestPipelineEnabled = types.BoolValue(settings.SuggestPipelineEnabled)
+	data.TerminalMaxSessionTime = types.Int64Value(int64(settings.TerminalMaxSessionTime))
+	data.Terms = types.StringValue(settings.Terms)
+	data.ThrottleAuthenticatedApiEnabled = types.BoolValue(settings.ThrottleAuthenticatedAPIEnabled)
+	data.ThrottleAuthenticatedApiPeriodInSeconds = types.Int64Value(int64(settings.ThrottleAuthenticatedAPIPeriodInSeconds))
+	data.ThrottleAuthenticatedApiRequestsPerPeriod = types.Int64Value(int64(settings.ThrottleAuthenticatedAPIRequestsPerPeriod))
+	data.ThrottleAuthenticatedPackagesApiEnabled = types.BoolValue(settings.ThrottleAuthenticatedPackagesAPIEnabled)
+	data.ThrottleAuthenticatedPackagesApiPeriodInSeconds = types.Int64Value(int64(settings.ThrottleAuthenticatedPackagesAPIPeriodInSeconds))
+	data.ThrottleAuthenticatedPackagesApiRequestsPerPeriod = types.Int64Value(int64(settings.ThrottleAuthenticatedPackagesAPIRequestsPerPeriod))
+	data.ThrottleAuthenticatedWebEnabled = types.BoolValue(settings.ThrottleAuthenticatedWebEnabled)
+	data.ThrottleAuthenticatedWebPeriodInSeconds = types.Int64Value(int64(settings.ThrottleAuthenticatedWebPeriodInSeconds))
+	data.ThrottleAuthenticatedWebRequestsPerPeriod = types.Int64Value(int64(settings.ThrottleAuthenticatedWebRequestsPerPeriod))
+	data.ThrottleUnauthenticatedApiEnabled = types.BoolValue(settings.ThrottleUnauthenticatedAPIEnabled)
+	data.ThrottleUnauthenticatedApiPeriodInSeconds = types.Int64Value(int64(settings.ThrottleUnauthenticatedAPIPeriodInSeconds))
+	data.ThrottleUnauthenticatedApiRequestsPerPeriod = types.Int64Value(int64(settings.ThrottleUnauthenticatedAPIRequestsPerPeriod))
+	data.ThrottleUnauthenticatedPackagesApiEnabled = types.BoolValue(settings.ThrottleUnauthenticatedPackagesAPIEnabled)
+	data.ThrottleUnauthenticatedPackagesApiPeriodInSeconds = types.Int64Value(int64(settings.ThrottleUnauthenticatedPackagesAPIPeriodInSeconds))
+	data.ThrottleUnauthenticatedPackagesApiRequestsPerPeriod = types.Int64Value(int64(settings.ThrottleUnauthenticatedPackagesAPIRequestsPerPeriod))
+	data.ThrottleUnauthenticatedWebEnabled = types.BoolValue(settings.ThrottleUnauthenticatedWebEnabled)
+	data.ThrottleUnauthenticatedWebPeriodInSeconds = types.Int64Value(int64(settings.ThrottleUnauthenticatedWebPeriodInSeconds))
+	data.ThrottleUnauthenticatedWebRequestsPerPeriod = types.Int64Value(int64(settings.ThrottleUnauthenticatedWebRequestsPerPeriod))
+	data.TimeTrackingLimitToHours = types.BoolValue(settings.TimeTrackingLimitToHours)
+	data.TwoFactorGracePeriod = types.Int64Value(int64(settings.TwoFactorGracePeriod))
+	data.UniqueIpsLimitEnabled = types.BoolValue(settings.UniqueIPsLimitEnabled)
+	data.UniqueIpsLimitPerUser = types.Int64Value(int64(settings.UniqueIPsLimitPerUser))
+	data.UniqueIpsLimitTimeWindow = types.Int64Value(int64(settings.UniqueIPsLimitTimeWindow))
+	data.UsagePingEnabled = types.BoolValue(settings.UsagePingEnabled)
+	data.UserDeactivationEmailsEnabled = types.BoolValue(settings.UserDeactivationEmailsEnabled)
+	data.UserDefaultExternal = types.BoolValue(settings.UserDefaultExternal)
+	data.UserDefaultInternalRegex = types.StringValue(settings.UserDefaultInternalRegex)
+	data.UserOauthApplications = types.BoolValue(settings.UserOauthApplications)
+	data.UserShowAddSshKeyMessage = types.BoolValue(settings.UserShowAddSSHKeyMessage)
+	data.VersionCheckEnabled = types.BoolValue(settings.VersionCheckEnabled)
+	data.WhatsNewVariant = types.StringValue(settings.WhatsNewVariant)
+	data.WebIdeClientsidePreviewEnabled = types.BoolValue(settings.WebIDEClientsidePreviewEnabled)
+	data.WikiPageMaxContentBytes = types.Int64Value(int64(settings.WikiPageMaxContentBytes))
+
+	return diags
+}
+
+// expandUpdateSettingsOptions returns the options to update the application settings which are planned to change.
+func expandUpdateSettingsOptions(ctx context.Context, plan *gitlabApplicationSettingsResourceModel, state *gitlabApplicationSettingsResourceModel) (*gitlab.UpdateSettingsOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	options := gitlab.UpdateSettingsOptions{}
+
+	if attributeChanged(plan.AdminMode, state.AdminMode) {
+		options.AdminMode = gitlab.Bool(plan.AdminMode.ValueBool())
+	}
+
+	if attributeChanged(plan.AbuseNotificationEmail, state.AbuseNotificationEmail) {
+		options.AbuseNotificationEmail = gitlab.String(plan.AbuseNotificationEmail.ValueString())
+	}
+
+	if attributeChanged(plan.AfterSignOutPath, state.AfterSignOutPath) {
+		options.AfterSignOutPath = gitlab.String(plan.AfterSignOutPath.ValueString())
+	}
+
+	if attributeChanged(plan.AfterSignUpText, state.AfterSignUpText) {
+		options.AfterSignUpText = gitlab.String(plan.AfterSignUpText.ValueString())
+	}
+
+	if attributeChanged(plan.AkismetApiKey, state.AkismetApiKey) {
+		options.AkismetAPIKey = gitlab.String(plan.AkismetApiKey.ValueString())
+	}
+
+	if attributeChanged(plan.AkismetEnabled, state.AkismetEnabled) {
+		options.AkismetEnabled = gitlab.Bool(plan.AkismetEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.AllowGroupOwnersToManageLdap, state.AllowGroupOwnersToManageLdap) {
+		options.AllowGroupOwnersToManageLDAP = gitlab.Bool(plan.AllowGroupOwnersToManageLdap.ValueBool())
+	}
+
+	if attributeChanged(plan.AllowLocalRequestsFromSystemHooks, state.AllowLocalRequestsFromSystemHooks) {
+		options.AllowLocalRequestsFromSystemHooks = gitlab.Bool(plan.AllowLocalRequestsFromSystemHooks.ValueBool())
+	}
+
+	if attributeChanged(plan.AllowLocalRequestsFromWebHooksAndServices, state.AllowLocalRequestsFromWebHooksAndServices) {
+		options.AllowLocalRequestsFromWebHooksAndServices = gitlab.Bool(plan.AllowLocalRequestsFromWebHooksAndServices.ValueBool())
+	}
+
+	if attributeChanged(plan.ArchiveBuildsInHumanReadable, state.ArchiveBuildsInHumanReadable) {
+		options.ArchiveBuildsInHumanReadable = gitlab.String(plan.ArchiveBuildsInHumanReadable.ValueString())
+	}
+
+	if attributeChanged(plan.AssetProxyEnabled, state.AssetProxyEnabled) {
+		options.AssetProxyEnabled = gitlab.Bool(plan.AssetProxyEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.AssetProxySecretKey, state.AssetProxySecretKey) {
+		options.AssetProxySecretKey = gitlab.String(plan.AssetProxySecretKey.ValueString())
+	}
+
+	if attributeChanged(plan.AssetProxyUrl, state.AssetProxyUrl) {
+		options.AssetProxyURL = gitlab.String(plan.AssetProxyUrl.ValueString())
+	}
+
+	if attributeChanged(plan.AssetProxyAllowlist, state.AssetProxyAllowlist) {
+		values := stringListElements(ctx, plan.AssetProxyAllowlist, &diags)
+		options.AssetProxyAllowlist = &values
+	}
+
+	if attributeChanged(plan.AuthorizedKeysEnabled, state.AuthorizedKeysEnabled) {
+		options.AuthorizedKeysEnabled = gitlab.Bool(plan.AuthorizedKeysEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.AutoDevopsDomain, state.AutoDevopsDomain) {
+		options.AutoDevOpsDomain = gitlab.String(plan.AutoDevopsDomain.ValueString())
+	}
+
+	if attributeChanged(plan.AutoDevopsEnabled, state.AutoDevopsEnabled) {
+		options.AutoDevOpsEnabled = gitlab.Bool(plan.AutoDevopsEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.AutomaticPurchasedStorageAllocation, state.AutomaticPurchasedStorageAllocation) {
+		options.AutomaticPurchasedStorageAllocation = gitlab.Bool(plan.AutomaticPurchasedStorageAllocation.ValueBool())
+	}
+
+	if attributeChanged(plan.CheckNamespacePlan, state.CheckNamespacePlan) {
+		options.CheckNamespacePlan = gitlab.Bool(plan.CheckNamespacePlan.ValueBool())
+	}
+
+	if attributeChanged(plan.CommitEmailHostname, state.CommitEmailHostname) {
+		options.CommitEmailHostname = gitlab.String(plan.CommitEmailHostname.ValueString())
+	}
+
+	if attributeChanged(plan.ContainerExpirationPoliciesEnableHistoricEntries, state.ContainerExpirationPoliciesEnableHistoricEntries) {
+		options.ContainerExpirationPoliciesEnableHistoricEntries = gitlab.Bool(plan.ContainerExpirationPoliciesEnableHistoricEntries.ValueBool())
+	}
+
+	if attributeChanged(plan.ContainerRegistryCleanupTagsServiceMaxListSize, state.ContainerRegistryCleanupTagsServiceMaxListSize) {
+		options.ContainerRegistryCleanupTagsServiceMaxListSize = gitlab.Int(int(plan.ContainerRegistryCleanupTagsServiceMaxListSize.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ContainerRegistryDeleteTagsServiceTimeout, state.ContainerRegistryDeleteTagsServiceTimeout) {
+		options.ContainerRegistryDeleteTagsServiceTimeout = gitlab.Int(int(plan.ContainerRegistryDeleteTagsServiceTimeout.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ContainerRegistryExpirationPoliciesCaching, state.ContainerRegistryExpirationPoliciesCaching) {
+		options.ContainerRegistryExpirationPoliciesCaching = gitlab.Bool(plan.ContainerRegistryExpirationPoliciesCaching.ValueBool())
+	}
+
+	if attributeChanged(plan.ContainerRegistryExpirationPoliciesWorkerCapacity, state.ContainerRegistryExpirationPoliciesWorkerCapacity) {
+		options.ContainerRegistryExpirationPoliciesWorkerCapacity = gitlab.Int(int(plan.ContainerRegistryExpirationPoliciesWorkerCapacity.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ContainerRegistryTokenExpireDelay, state.ContainerRegistryTokenExpireDelay) {
+		options.ContainerRegistryTokenExpireDelay = gitlab.Int(int(plan.ContainerRegistryTokenExpireDelay.ValueInt64()))
+	}
+
+	if attributeChanged(plan.PackageRegistryCleanupPoliciesWorkerCapacity, state.PackageRegistryCleanupPoliciesWorkerCapacity) {
+		options.PackageRegistryCleanupPoliciesWorkerCapacity = gitlab.Int(int(plan.PackageRegistryCleanupPoliciesWorkerCapacity.ValueInt64()))
+	}
+
+	if attributeChanged(plan.DeactivateDormantUsers, state.DeactivateDormantUsers) {
+		options.DeactivateDormantUsers = gitlab.Bool(plan.DeactivateDormantUsers.ValueBool())
+	}
+
+	if attributeChanged(plan.DefaultArtifactsExpireIn, state.DefaultArtifactsExpireIn) {
+		options.DefaultArtifactsExpireIn = gitlab.String(plan.DefaultArtifactsExpireIn.ValueString())
+	}
+
+	if attributeChanged(plan.DefaultBranchName, state.DefaultBranchName) {
+		options.DefaultBranchName = gitlab.String(plan.DefaultBranchName.ValueString())
+	}
+
+	if attributeChanged(plan.DefaultBranchProtection, state.DefaultBranchProtection) {
+		options.DefaultBranchProtection = gitlab.Int(int(plan.DefaultBranchProtection.ValueInt64()))
+	}
+
+	if attributeChanged(plan.DefaultCiConfigPath, state.DefaultCiConfigPath) {
+		options.DefaultCiConfigPath = gitlab.String(plan.DefaultCiConfigPath.ValueString())
+	}
+
+	if attributeChanged(plan.DefaultGroupVisibility, state.DefaultGroupVisibility) {
+		options.DefaultGroupVisibility = gitlab.Visibility(gitlab.VisibilityValue(plan.DefaultGroupVisibility.ValueString()))
+	}
+
+	if attributeChanged(plan.DefaultProjectCreation, state.DefaultProjectCreation) {
+		options.DefaultProjectCreation = gitlab.Int(int(plan.DefaultProjectCreation.ValueInt64()))
+	}
+
+	if attributeChanged(plan.DefaultProjectVisibility, state.DefaultProjectVisibility) {
+		options.DefaultProjectVisibility = gitlab.Visibility(gitlab.VisibilityValue(plan.DefaultProjectVisibility.ValueString()))
+	}
+
+	if attributeChanged(plan.DefaultProjectsLimit, state.DefaultProjectsLimit) {
+		options.DefaultProjectsLimit = gitlab.Int(int(plan.DefaultProjectsLimit.ValueInt64()))
+	}
+
+	if attributeChanged(plan.DefaultSnippetVisibility, state.DefaultSnippetVisibility) {
+		options.DefaultSnippetVisibility = gitlab.Visibility(gitlab.VisibilityValue(plan.DefaultSnippetVisibility.ValueString()))
+	}
+
+	if attributeChanged(plan.DelayedProjectDeletion, state.DelayedProjectDeletion) {
+		options.DelayedProjectDeletion = gitlab.Bool(plan.DelayedProjectDeletion.ValueBool())
+	}
+
+	if attributeChanged(plan.DelayedGroupDeletion, state.DelayedGroupDeletion) {
+		options.DelayedGroupDeletion = gitlab.Bool(plan.DelayedGroupDeletion.ValueBool())
+	}
+
+	if attributeChanged(plan.DeleteInactiveProjects, state.DeleteInactiveProjects) {
+		options.DeleteInactiveProjects = gitlab.Bool(plan.DeleteInactiveProjects.ValueBool())
+	}
+
+	if attributeChanged(plan.DeletionAdjournedPeriod, state.DeletionAdjournedPeriod) {
+		options.DeletionAdjournedPeriod = gitlab.Int(int(plan.DeletionAdjournedPeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.DiffMaxPatchBytes, state.DiffMaxPatchBytes) {
+		options.DiffMaxPatchBytes = gitlab.Int(int(plan.DiffMaxPatchBytes.ValueInt64()))
+	}
+
+	if attributeChanged(plan.DiffMaxFiles, state.DiffMaxFiles) {
+		options.DiffMaxFiles = gitlab.Int(int(plan.DiffMaxFiles.ValueInt64()))
+	}
+
+	if attributeChanged(plan.DiffMaxLines, state.DiffMaxLines) {
+		options.DiffMaxLines = gitlab.Int(int(plan.DiffMaxLines.ValueInt64()))
+	}
+
+	if attributeChanged(plan.DisableFeedToken, state.DisableFeedToken) {
+		options.DisableFeedToken = gitlab.Bool(plan.DisableFeedToken.ValueBool())
+	}
+
+	if attributeChanged(plan.DisabledOauthSignInSources, state.DisabledOauthSignInSources) {
+		values := stringListElements(ctx, plan.DisabledOauthSignInSources, &diags)
+		options.DisabledOauthSignInSources = &values
+	}
+
+	if attributeChanged(plan.DnsRebindingProtectionEnabled, state.DnsRebindingProtectionEnabled) {
+		options.DNSRebindingProtectionEnabled = gitlab.Bool(plan.DnsRebindingProtectionEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.DomainDenylistEnabled, state.DomainDenylistEnabled) {
+		options.DomainDenylistEnabled = gitlab.Bool(plan.DomainDenylistEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.DomainDenylist, state.DomainDenylist) {
+		values := stringListElements(ctx, plan.DomainDenylist, &diags)
+		options.DomainDenylist = &values
+	}
+
+	if attributeChanged(plan.DomainAllowlist, state.DomainAllowlist) {
+		values := stringListElements(ctx, plan.DomainAllowlist, &diags)
+		options.DomainAllowlist = &values
+	}
+
+	if attributeChanged(plan.DsaKeyRestriction, state.DsaKeyRestriction) {
+		options.DSAKeyRestriction = gitlab.Int(int(plan.DsaKeyRestriction.ValueInt64()))
+	}
+
+	if attributeChanged(plan.EcdsaKeyRestriction, state.EcdsaKeyRestriction) {
+		options.ECDSAKeyRestriction = gitlab.Int(int(plan.EcdsaKeyRestriction.ValueInt64()))
+	}
+
+	if attributeChanged(plan.EcdsaSkKeyRestriction, state.EcdsaSkKeyRestriction) {
+		options.ECDSASKKeyRestriction = gitlab.Int(int(plan.EcdsaSkKeyRestriction.ValueInt64()))
+	}
+
+	if attributeChanged(plan.Ed25519KeyRestriction, state.Ed25519KeyRestriction) {
+		options.Ed25519KeyRestriction = gitlab.Int(int(plan.Ed25519KeyRestriction.ValueInt64()))
+	}
+
+	if attributeChanged(plan.Ed25519SkKeyRestriction, state.Ed25519SkKeyRestriction) {
+		options.Ed25519SKKeyRestriction = gitlab.Int(int(plan.Ed25519SkKeyRestriction.ValueInt64()))
+	}
+
+	if attributeChanged(plan.EksAccessKeyId, state.EksAccessKeyId) {
+		options.EKSAccessKeyID = gitlab.String(plan.EksAccessKeyId.ValueString())
+	}
+
+	if attributeChanged(plan.EksAccountId, state.EksAccountId) {
+		options.EKSAccountID = gitlab.String(plan.EksAccountId.ValueString())
+	}
+
+	if attributeChanged(plan.EksIntegrationEnabled, state.EksIntegrationEnabled) {
+		options.EKSIntegrationEnabled = gitlab.Bool(plan.EksIntegrationEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.EksSecretAccessKey, state.EksSecretAccessKey) {
+		options.EKSSecretAccessKey = gitlab.String(plan.EksSecretAccessKey.ValueString())
+	}
+
+	if attributeChanged(plan.ElasticsearchAwsAccessKey, state.ElasticsearchAwsAccessKey) {
+		options.ElasticsearchAWSAccessKey = gitlab.String(plan.ElasticsearchAwsAccessKey.ValueString())
+	}
+
+	if attributeChanged(plan.ElasticsearchAwsRegion, state.ElasticsearchAwsRegion) {
+		options.ElasticsearchAWSRegion = gitlab.String(plan.ElasticsearchAwsRegion.ValueString())
+	}
+
+	if attributeChanged(plan.ElasticsearchAwsSecretAccessKey, state.ElasticsearchAwsSecretAccessKey) {
+		options.ElasticsearchAWSSecretAccessKey = gitlab.String(plan.ElasticsearchAwsSecretAccessKey.ValueString())
+	}
+
+	if attributeChanged(plan.ElasticsearchAws, state.ElasticsearchAws) {
+		options.ElasticsearchAWS = gitlab.Bool(plan.ElasticsearchAws.ValueBool())
+	}
+
+	if attributeChanged(plan.ElasticsearchIndexedFieldLengthLimit, state.ElasticsearchIndexedFieldLengthLimit) {
+		options.ElasticsearchIndexedFieldLengthLimit = gitlab.Int(int(plan.ElasticsearchIndexedFieldLengthLimit.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ElasticsearchIndexedFileSizeLimitKb, state.ElasticsearchIndexedFileSizeLimitKb) {
+		options.ElasticsearchIndexedFileSizeLimitKB = gitlab.Int(int(plan.ElasticsearchIndexedFileSizeLimitKb.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ElasticsearchIndexing, state.ElasticsearchIndexing) {
+		options.ElasticsearchIndexing = gitlab.Bool(plan.ElasticsearchIndexing.ValueBool())
+	}
+
+	if attributeChanged(plan.ElasticsearchLimitIndexing, state.ElasticsearchLimitIndexing) {
+		options.ElasticsearchLimitIndexing = gitlab.Bool(plan.ElasticsearchLimitIndexing.ValueBool())
+	}
+
+	if attributeChanged(plan.ElasticsearchMaxBulkConcurrency, state.ElasticsearchMaxBulkConcurrency) {
+		options.ElasticsearchMaxBulkConcurrency = gitlab.Int(int(plan.ElasticsearchMaxBulkConcurrency.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ElasticsearchMaxBulkSizeMb, state.ElasticsearchMaxBulkSizeMb) {
+		options.ElasticsearchMaxBulkSizeMB = gitlab.Int(int(plan.ElasticsearchMaxBulkSizeMb.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ElasticsearchNamespaceIds, state.ElasticsearchNamespaceIds) {
+		values, err := stringsToInts(stringListElements(ctx, plan.ElasticsearchNamespaceIds, &diags))
+		if err != nil {
+			diags.AddAttributeError(path.Root("elasticsearch_namespace_ids"), "Invalid ID", err.Error())
+		}
+		options.ElasticsearchNamespaceIDs = &values
+	}
+
+	if attributeChanged(plan.ElasticsearchProjectIds, state.ElasticsearchProjectIds) {
+		values, err := stringsToInts(stringListElements(ctx, plan.ElasticsearchProjectIds, &diags))
+		if err != nil {
+			diags.AddAttributeError(path.Root("elasticsearch_project_ids"), "Invalid ID", err.Error())
+		}
+		options.ElasticsearchProjectIDs = &values
+	}
+
+	if attributeChanged(plan.ElasticsearchSearch, state.ElasticsearchSearch) {
+		options.ElasticsearchSearch = gitlab.Bool(plan.ElasticsearchSearch.ValueBool())
+	}
+
+	if attributeChanged(plan.ElasticsearchUrl, state.ElasticsearchUrl) {
+		options.ElasticsearchURL = gitlab.String(strings.Join(stringListElements(ctx, plan.ElasticsearchUrl, &diags), ","))
+	}
+
+	if attributeChanged(plan.ElasticsearchUsername, state.ElasticsearchUsername) {
+		options.ElasticsearchUsername = gitlab.String(plan.ElasticsearchUsername.ValueString())
+	}
+
+	if attributeChanged(plan.ElasticsearchPassword, state.ElasticsearchPassword) {
+		options.ElasticsearchPassword = gitlab.String(plan.ElasticsearchPassword.ValueString())
+	}
+
+	if attributeChanged(plan.EmailAdditionalText, state.EmailAdditionalText) {
+		options.EmailAdditionalText = gitlab.String(plan.EmailAdditionalText.ValueString())
+	}
+
+	if attributeChanged(plan.EmailAuthorInBody, state.EmailAuthorInBody) {
+		options.EmailAuthorInBody = gitlab.Bool(plan.EmailAuthorInBody.ValueBool())
+	}
+
+	if attributeChanged(plan.EnabledGitAccessProtocol, state.EnabledGitAccessProtocol) {
+		options.EnabledGitAccessProtocol = gitlab.String(plan.EnabledGitAccessProtocol.ValueString())
+	}
+
+	if attributeChanged(plan.EnforceNamespaceStorageLimit, state.EnforceNamespaceStorageLimit) {
+		options.EnforceNamespaceStorageLimit = gitlab.Bool(plan.EnforceNamespaceStorageLimit.ValueBool())
+	}
+
+	if attributeChanged(plan.EnforceTerms, state.EnforceTerms) {
+		options.EnforceTerms = gitlab.Bool(plan.EnforceTerms.ValueBool())
+	}
+
+	if attributeChanged(plan.ExternalAuthClientCert, state.ExternalAuthClientCert) {
+		options.ExternalAuthClientCert = gitlab.String(plan.ExternalAuthClientCert.ValueString())
+	}
+
+	if attributeChanged(plan.ExternalAuthClientKeyPass, state.ExternalAuthClientKeyPass) {
+		options.ExternalAuthClientKeyPass = gitlab.String(plan.ExternalAuthClientKeyPass.ValueString())
+	}
+
+	if attributeChanged(plan.ExternalAuthClientKey, state.ExternalAuthClientKey) {
+		options.ExternalAuthClientKey = gitlab.String(plan.ExternalAuthClientKey.ValueString())
+	}
+
+	if attributeChanged(plan.ExternalAuthorizationServiceDefaultLabel, state.ExternalAuthorizationServiceDefaultLabel) {
+		options.ExternalAuthorizationServiceDefaultLabel = gitlab.String(plan.ExternalAuthorizationServiceDefaultLabel.ValueString())
+	}
+
+	if attributeChanged(plan.ExternalAuthorizationServiceEnabled, state.ExternalAuthorizationServiceEnabled) {
+		options.ExternalAuthorizationServiceEnabled = gitlab.Bool(plan.ExternalAuthorizationServiceEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.ExternalAuthorizationServiceTimeout, state.ExternalAuthorizationServiceTimeout) {
+		value := plan.ExternalAuthorizationServiceTimeout.ValueFloat64()
+		options.ExternalAuthorizationServiceTimeout = &value
+	}
+
+	if attributeChanged(plan.ExternalAuthorizationServiceUrl, state.ExternalAuthorizationServiceUrl) {
+		options.ExternalAuthorizationServiceURL = gitlab.String(plan.ExternalAuthorizationServiceUrl.ValueString())
+	}
+
+	if attributeChanged(plan.ExternalPipelineValidationServiceUrl, state.ExternalPipelineValidationServiceUrl) {
+		options.ExternalPipelineValidationServiceURL = gitlab.String(plan.ExternalPipelineValidationServiceUrl.ValueString())
+	}
+
+	if attributeChanged(plan.ExternalPipelineValidationServiceToken, state.ExternalPipelineValidationServiceToken) {
+		options.ExternalPipelineValidationServiceToken = gitlab.String(plan.ExternalPipelineValidationServiceToken.ValueString())
+	}
+
+	if attributeChanged(plan.ExternalPipelineValidationServiceTimeout, state.ExternalPipelineValidationServiceTimeout) {
+		options.ExternalPipelineValidationServiceTimeout = gitlab.Int(int(plan.ExternalPipelineValidationServiceTimeout.ValueInt64()))
+	}
+
+	if attributeChanged(plan.FileTemplateProjectId, state.FileTemplateProjectId) {
+		options.FileTemplateProjectID = gitlab.Int(int(plan.FileTemplateProjectId.ValueInt64()))
+	}
+
+	if attributeChanged(plan.FirstDayOfWeek, state.FirstDayOfWeek) {
+		options.FirstDayOfWeek = gitlab.Int(int(plan.FirstDayOfWeek.ValueInt64()))
+	}
+
+	if attributeChanged(plan.GeoNodeAllowedIps, state.GeoNodeAllowedIps) {
+		options.GeoNodeAllowedIPs = gitlab.String(plan.GeoNodeAllowedIps.ValueString())
+	}
+
+	if attributeChanged(plan.GeoStatusTimeout, state.GeoStatusTimeout) {
+		options.GeoStatusTimeout = gitlab.Int(int(plan.GeoStatusTimeout.ValueInt64()))
+	}
+
+	if attributeChanged(plan.GitTwoFactorSessionExpiry, state.GitTwoFactorSessionExpiry) {
+		options.GitTwoFactorSessionExpiry = gitlab.Int(int(plan.GitTwoFactorSessionExpiry.ValueInt64()))
+	}
+
+	if attributeChanged(plan.GitalyTimeoutDefault, state.GitalyTimeoutDefault) {
+		options.GitalyTimeoutDefault = gitlab.Int(int(plan.GitalyTimeoutDefault.ValueInt64()))
+	}
+
+	if attributeChanged(plan.GitalyTimeoutFast, state.GitalyTimeoutFast) {
+		options.GitalyTimeoutFast = gitlab.Int(int(plan.GitalyTimeoutFast.ValueInt64()))
+	}
+
+	if attributeChanged(plan.GitalyTimeoutMedium, state.GitalyTimeoutMedium) {
+		options.GitalyTimeoutMedium = gitlab.Int(int(plan.GitalyTimeoutMedium.ValueInt64()))
+	}
+
+	if attributeChanged(plan.GrafanaEnabled, state.GrafanaEnabled) {
+		options.GrafanaEnabled = gitlab.Bool(plan.GrafanaEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.GrafanaUrl, state.GrafanaUrl) {
+		options.GrafanaURL = gitlab.String(plan.GrafanaUrl.ValueString())
+	}
+
+	if attributeChanged(plan.GravatarEnabled, state.GravatarEnabled) {
+		options.GravatarEnabled = gitlab.Bool(plan.GravatarEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.GroupOwnersCanManageDefaultBranchProtection, state.GroupOwnersCanManageDefaultBranchProtection) {
+		options.GroupOwnersCanManageDefaultBranchProtection = gitlab.Bool(plan.GroupOwnersCanManageDefaultBranchProtection.ValueBool())
+	}
+
+	if attributeChanged(plan.HashedStorageEnabled, state.HashedStorageEnabled) {
+		options.HashedStorageEnabled = gitlab.Bool(plan.HashedStorageEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.HelpPageHideCommercialContent, state.HelpPageHideCommercialContent) {
+		options.HelpPageHideCommercialContent = gitlab.Bool(plan.HelpPageHideCommercialContent.ValueBool())
+	}
+
+	if attributeChanged(plan.HelpPageSupportUrl, state.HelpPageSupportUrl) {
+		options.HelpPageSupportURL = gitlab.String(plan.HelpPageSupportUrl.ValueString())
+	}
+
+	if attributeChanged(plan.HelpPageText, state.HelpPageText) {
+		options.HelpPageText = gitlab.String(plan.HelpPageText.ValueString())
+	}
+
+	if attributeChanged(plan.HelpText, state.HelpText) {
+		options.HelpText = gitlab.String(plan.HelpText.ValueString())
+	}
+
+	if attributeChanged(plan.HideThirdPartyOffers, state.HideThirdPartyOffers) {
+		options.HideThirdPartyOffers = gitlab.Bool(plan.HideThirdPartyOffers.ValueBool())
+	}
+
+	if attributeChanged(plan.HomePageUrl, state.HomePageUrl) {
+		options.HomePageURL = gitlab.String(plan.HomePageUrl.ValueString())
+	}
+
+	if attributeChanged(plan.HousekeepingEnabled, state.HousekeepingEnabled) {
+		options.HousekeepingEnabled = gitlab.Bool(plan.HousekeepingEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.HousekeepingFullRepackPeriod, state.HousekeepingFullRepackPeriod) {
+		options.HousekeepingFullRepackPeriod = gitlab.Int(int(plan.HousekeepingFullRepackPeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.HousekeepingGcPeriod, state.HousekeepingGcPeriod) {
+		options.HousekeepingGcPeriod = gitlab.Int(int(plan.HousekeepingGcPeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.HousekeepingIncrementalRepackPeriod, state.HousekeepingIncrementalRepackPeriod) {
+		options.HousekeepingIncrementalRepackPeriod = gitlab.Int(int(plan.HousekeepingIncrementalRepackPeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.HtmlEmailsEnabled, state.HtmlEmailsEnabled) {
+		options.HTMLEmailsEnabled = gitlab.Bool(plan.HtmlEmailsEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.ImportSources, state.ImportSources) {
+		values := stringListElements(ctx, plan.ImportSources, &diags)
+		options.ImportSources = &values
+	}
+
+	if attributeChanged(plan.InProductMarketingEmailsEnabled, state.InProductMarketingEmailsEnabled) {
+		options.InProductMarketingEmailsEnabled = gitlab.Bool(plan.InProductMarketingEmailsEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.InactiveProjectsDeleteAfterMonths, state.InactiveProjectsDeleteAfterMonths) {
+		options.InactiveProjectsDeleteAfterMonths = gitlab.Int(int(plan.InactiveProjectsDeleteAfterMonths.ValueInt64()))
+	}
+
+	if attributeChanged(plan.InactiveProjectsMinSizeMb, state.InactiveProjectsMinSizeMb) {
+		options.InactiveProjectsMinSizeMB = gitlab.Int(int(plan.InactiveProjectsMinSizeMb.ValueInt64()))
+	}
+
+	if attributeChanged(plan.InactiveProjectsSendWarningEmailAfterMonths, state.InactiveProjectsSendWarningEmailAfterMonths) {
+		options.InactiveProjectsSendWarningEmailAfterMonths = gitlab.Int(int(plan.InactiveProjectsSendWarningEmailAfterMonths.ValueInt64()))
+	}
+
+	if attributeChanged(plan.InvisibleCaptchaEnabled, state.InvisibleCaptchaEnabled) {
+		options.InvisibleCaptchaEnabled = gitlab.Bool(plan.InvisibleCaptchaEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.IssuesCreateLimit, state.IssuesCreateLimit) {
+		options.IssuesCreateLimit = gitlab.Int(int(plan.IssuesCreateLimit.ValueInt64()))
+	}
+
+	if attributeChanged(plan.KeepLatestArtifact, state.KeepLatestArtifact) {
+		options.KeepLatestArtifact = gitlab.Bool(plan.KeepLatestArtifact.ValueBool())
+	}
+
+	if attributeChanged(plan.LocalMarkdownVersion, state.LocalMarkdownVersion) {
+		options.LocalMarkdownVersion = gitlab.Int(int(plan.LocalMarkdownVersion.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MailgunSigningKey, state.MailgunSigningKey) {
+		options.MailgunSigningKey = gitlab.String(plan.MailgunSigningKey.ValueString())
+	}
+
+	if attributeChanged(plan.MailgunEventsEnabled, state.MailgunEventsEnabled) {
+		options.MailgunEventsEnabled = gitlab.Bool(plan.MailgunEventsEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.MaintenanceModeMessage, state.MaintenanceModeMessage) {
+		options.MaintenanceModeMessage = gitlab.String(plan.MaintenanceModeMessage.ValueString())
+	}
+
+	if attributeChanged(plan.MaintenanceMode, state.MaintenanceMode) {
+		options.MaintenanceMode = gitlab.Bool(plan.MaintenanceMode.ValueBool())
+	}
+
+	if attributeChanged(plan.MaxArtifactsSize, state.MaxArtifactsSize) {
+		options.MaxArtifactsSize = gitlab.Int(int(plan.MaxArtifactsSize.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MaxAttachmentSize, state.MaxAttachmentSize) {
+		options.MaxAttachmentSize = gitlab.Int(int(plan.MaxAttachmentSize.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MaxExportSize, state.MaxExportSize) {
+		options.MaxExportSize = gitlab.Int(int(plan.MaxExportSize.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MaxImportSize, state.MaxImportSize) {
+		options.MaxImportSize = gitlab.Int(int(plan.MaxImportSize.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MaxPagesSize, state.MaxPagesSize) {
+		options.MaxPagesSize = gitlab.Int(int(plan.MaxPagesSize.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MaxPersonalAccessTokenLifetime, state.MaxPersonalAccessTokenLifetime) {
+		options.MaxPersonalAccessTokenLifetime = gitlab.Int(int(plan.MaxPersonalAccessTokenLifetime.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MaxSshKeyLifetime, state.MaxSshKeyLifetime) {
+		options.MaxSSHKeyLifetime = gitlab.Int(int(plan.MaxSshKeyLifetime.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MetricsMethodCallThreshold, state.MetricsMethodCallThreshold) {
+		options.MetricsMethodCallThreshold = gitlab.Int(int(plan.MetricsMethodCallThreshold.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MaxNumberOfRepositoryDownloads, state.MaxNumberOfRepositoryDownloads) {
+		options.MaxNumberOfRepositoryDownloads = gitlab.Int(int(plan.MaxNumberOfRepositoryDownloads.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MaxNumberOfRepositoryDownloadsWithinTimePeriod, state.MaxNumberOfRepositoryDownloadsWithinTimePeriod) {
+		options.MaxNumberOfRepositoryDownloadsWithinTimePeriod = gitlab.Int(int(plan.MaxNumberOfRepositoryDownloadsWithinTimePeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.GitRateLimitUsersAllowlist, state.GitRateLimitUsersAllowlist) {
+		values := stringListElements(ctx, plan.GitRateLimitUsersAllowlist, &diags)
+		options.GitRateLimitUsersAllowlist = &values
+	}
+
+	if attributeChanged(plan.MirrorAvailable, state.MirrorAvailable) {
+		options.MirrorAvailable = gitlab.Bool(plan.MirrorAvailable.ValueBool())
+	}
+
+	if attributeChanged(plan.MirrorCapacityThreshold, state.MirrorCapacityThreshold) {
+		options.MirrorCapacityThreshold = gitlab.Int(int(plan.MirrorCapacityThreshold.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MirrorMaxCapacity, state.MirrorMaxCapacity) {
+		options.MirrorMaxCapacity = gitlab.Int(int(plan.MirrorMaxCapacity.ValueInt64()))
+	}
+
+	if attributeChanged(plan.MirrorMaxDelay, state.MirrorMaxDelay) {
+		options.MirrorMaxDelay = gitlab.Int(int(plan.MirrorMaxDelay.ValueInt64()))
+	}
+
+	if attributeChanged(plan.NpmPackageRequestsForwarding, state.NpmPackageRequestsForwarding) {
+		options.NPMPackageRequestsForwarding = gitlab.Bool(plan.NpmPackageRequestsForwarding.ValueBool())
+	}
+
+	if attributeChanged(plan.PypiPackageRequestsForwarding, state.PypiPackageRequestsForwarding) {
+		options.PyPIPackageRequestsForwarding = gitlab.Bool(plan.PypiPackageRequestsForwarding.ValueBool())
+	}
+
+	if attributeChanged(plan.OutboundLocalRequestsWhitelist, state.OutboundLocalRequestsWhitelist) {
+		values := stringListElements(ctx, plan.OutboundLocalRequestsWhitelist, &diags)
+		options.OutboundLocalRequestsWhitelist = &values
+	}
+
+	if attributeChanged(plan.PagesDomainVerificationEnabled, state.PagesDomainVerificationEnabled) {
+		options.PagesDomainVerificationEnabled = gitlab.Bool(plan.PagesDomainVerificationEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.PasswordAuthenticationEnabledForGit, state.PasswordAuthenticationEnabledForGit) {
+		options.PasswordAuthenticationEnabledForGit = gitlab.Bool(plan.PasswordAuthenticationEnabledForGit.ValueBool())
+	}
+
+	if attributeChanged(plan.PasswordAuthenticationEnabledForWeb, state.PasswordAuthenticationEnabledForWeb) {
+		options.PasswordAuthenticationEnabledForWeb = gitlab.Bool(plan.PasswordAuthenticationEnabledForWeb.ValueBool())
+	}
+
+	if attributeChanged(plan.PasswordNumberRequired, state.PasswordNumberRequired) {
+		options.PasswordNumberRequired = gitlab.Bool(plan.PasswordNumberRequired.ValueBool())
+	}
+
+	if attributeChanged(plan.PasswordSymbolRequired, state.PasswordSymbolRequired) {
+		options.PasswordSymbolRequired = gitlab.Bool(plan.PasswordSymbolRequired.ValueBool())
+	}
+
+	if attributeChanged(plan.PasswordUppercaseRequired, state.PasswordUppercaseRequired) {
+		options.PasswordUppercaseRequired = gitlab.Bool(plan.PasswordUppercaseRequired.ValueBool())
+	}
+
+	if attributeChanged(plan.PasswordLowercaseRequired, state.PasswordLowercaseRequired) {
+		options.PasswordLowercaseRequired = gitlab.Bool(plan.PasswordLowercaseRequired.ValueBool())
+	}
+
+	if attributeChanged(plan.PerformanceBarAllowedGroupPath, state.PerformanceBarAllowedGroupPath) {
+		options.PerformanceBarAllowedGroupPath = gitlab.String(plan.PerformanceBarAllowedGroupPath.ValueString())
+	}
+
+	if attributeChanged(plan.PersonalAccessTokenPrefix, state.PersonalAccessTokenPrefix) {
+		options.PersonalAccessTokenPrefix = gitlab.String(plan.PersonalAccessTokenPrefix.ValueString())
+	}
+
+	if attributeChanged(plan.PipelineLimitPerProjectUserSha, state.PipelineLimitPerProjectUserSha) {
+		options.PipelineLimitPerProjectUserSha = gitlab.Int(int(plan.PipelineLimitPerProjectUserSha.ValueInt64()))
+	}
+
+	if attributeChanged(plan.PlantumlEnabled, state.PlantumlEnabled) {
+		options.PlantumlEnabled = gitlab.Bool(plan.PlantumlEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.PlantumlUrl, state.PlantumlUrl) {
+		options.PlantumlURL = gitlab.String(plan.PlantumlUrl.ValueString())
+	}
+
+	if attributeChanged(plan.PollingIntervalMultiplier, state.PollingIntervalMultiplier) {
+		value := plan.PollingIntervalMultiplier.ValueFloat64()
+		options.PollingIntervalMultiplier = &value
+	}
+
+	if attributeChanged(plan.ProjectExportEnabled, state.ProjectExportEnabled) {
+		options.ProjectExportEnabled = gitlab.Bool(plan.ProjectExportEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.PrometheusMetricsEnabled, state.PrometheusMetricsEnabled) {
+		options.PrometheusMetricsEnabled = gitlab.Bool(plan.PrometheusMetricsEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.ProtectedCiVariables, state.ProtectedCiVariables) {
+		options.ProtectedCIVariables = gitlab.Bool(plan.ProtectedCiVariables.ValueBool())
+	}
+
+	if attributeChanged(plan.PushEventActivitiesLimit, state.PushEventActivitiesLimit) {
+		options.PushEventActivitiesLimit = gitlab.Int(int(plan.PushEventActivitiesLimit.ValueInt64()))
+	}
+
+	if attributeChanged(plan.PushEventHooksLimit, state.PushEventHooksLimit) {
+		options.PushEventHooksLimit = gitlab.Int(int(plan.PushEventHooksLimit.ValueInt64()))
+	}
+
+	if attributeChanged(plan.RateLimitingResponseText, state.RateLimitingResponseText) {
+		options.RateLimitingResponseText = gitlab.String(plan.RateLimitingResponseText.ValueString())
+	}
+
+	if attributeChanged(plan.RawBlobRequestLimit, state.RawBlobRequestLimit) {
+		options.RawBlobRequestLimit = gitlab.Int(int(plan.RawBlobRequestLimit.ValueInt64()))
+	}
+
+	if attributeChanged(plan.SearchRateLimit, state.SearchRateLimit) {
+		options.SearchRateLimit = gitlab.Int(int(plan.SearchRateLimit.ValueInt64()))
+	}
+
+	if attributeChanged(plan.SearchRateLimitUnauthenticated, state.SearchRateLimitUnauthenticated) {
+		options.SearchRateLimitUnauthenticated = gitlab.Int(int(plan.SearchRateLimitUnauthenticated.ValueInt64()))
+	}
+
+	if attributeChanged(plan.RecaptchaEnabled, state.RecaptchaEnabled) {
+		options.RecaptchaEnabled = gitlab.Bool(plan.RecaptchaEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.RecaptchaPrivateKey, state.RecaptchaPrivateKey) {
+		options.RecaptchaPrivateKey = gitlab.String(plan.RecaptchaPrivateKey.ValueString())
+	}
+
+	if attributeChanged(plan.RecaptchaSiteKey, state.RecaptchaSiteKey) {
+		options.RecaptchaSiteKey = gitlab.String(plan.RecaptchaSiteKey.ValueString())
+	}
+
+	if attributeChanged(plan.ReceiveMaxInputSize, state.ReceiveMaxInputSize) {
+		options.ReceiveMaxInputSize = gitlab.Int(int(plan.ReceiveMaxInputSize.ValueInt64()))
+	}
+
+	if attributeChanged(plan.RepositoryChecksEnabled, state.RepositoryChecksEnabled) {
+		options.RepositoryChecksEnabled = gitlab.Bool(plan.RepositoryChecksEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.RepositorySizeLimit, state.RepositorySizeLimit) {
+		options.RepositorySizeLimit = gitlab.Int(int(plan.RepositorySizeLimit.ValueInt64()))
+	}
+
+	if attributeChanged(plan.RepositoryStoragesWeighted, state.RepositoryStoragesWeighted) {
+		values := make(map[string]int, len(plan.RepositoryStoragesWeighted.Elements()))
+		diags.Append(plan.RepositoryStoragesWeighted.ElementsAs(ctx, &values, false)...)
+		options.RepositoryStoragesWeighted = &values
+	}
+
+	if attributeChanged(plan.RepositoryStorages, state.RepositoryStorages) {
+		values := stringListElements(ctx, plan.RepositoryStorages, &diags)
+		options.RepositoryStorages = &values
+	}
+
+	if attributeChanged(plan.RequireAdminApprovalAfterUserSignup, state.RequireAdminApprovalAfterUserSignup) {
+		options.RequireAdminApprovalAfterUserSignup = gitlab.Bool(plan.RequireAdminApprovalAfterUserSignup.ValueBool())
+	}
+
+	if attributeChanged(plan.RequireTwoFactorAuthentication, state.RequireTwoFactorAuthentication) {
+		options.RequireTwoFactorAuthentication = gitlab.Bool(plan.RequireTwoFactorAuthentication.ValueBool())
+	}
+
+	if attributeChanged(plan.RestrictedVisibilityLevels, state.RestrictedVisibilityLevels) {
+		values := stringsToVisibilityValues(stringListElements(ctx, plan.RestrictedVisibilityLevels, &diags))
+		options.RestrictedVisibilityLevels = &values
+	}
+
+	if attributeChanged(plan.RsaKeyRestriction, state.RsaKeyRestriction) {
+		options.RSAKeyRestriction = gitlab.Int(int(plan.RsaKeyRestriction.ValueInt64()))
+	}
+
+	if attributeChanged(plan.SendUserConfirmationEmail, state.SendUserConfirmationEmail) {
+		options.SendUserConfirmationEmail = gitlab.Bool(plan.SendUserConfirmationEmail.ValueBool())
+	}
+
+	if attributeChanged(plan.SessionExpireDelay, state.SessionExpireDelay) {
+		options.SessionExpireDelay = gitlab.Int(int(plan.SessionExpireDelay.ValueInt64()))
+	}
+
+	if attributeChanged(plan.SharedRunnersEnabled, state.SharedRunnersEnabled) {
+		options.SharedRunnersEnabled = gitlab.Bool(plan.SharedRunnersEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.SharedRunnersMinutes, state.SharedRunnersMinutes) {
+		options.SharedRunnersMinutes = gitlab.Int(int(plan.SharedRunnersMinutes.ValueInt64()))
+	}
+
+	if attributeChanged(plan.SharedRunnersText, state.SharedRunnersText) {
+		options.SharedRunnersText = gitlab.String(plan.SharedRunnersText.ValueString())
+	}
+
+	if attributeChanged(plan.SidekiqJobLimiterMode, state.SidekiqJobLimiterMode) {
+		options.SidekiqJobLimiterMode = gitlab.String(plan.SidekiqJobLimiterMode.ValueString())
+	}
+
+	if attributeChanged(plan.SidekiqJobLimiterCompressionThresholdBytes, state.SidekiqJobLimiterCompressionThresholdBytes) {
+		options.SidekiqJobLimiterCompressionThresholdBytes = gitlab.Int(int(plan.SidekiqJobLimiterCompressionThresholdBytes.ValueInt64()))
+	}
+
+	if attributeChanged(plan.SidekiqJobLimiterLimitBytes, state.SidekiqJobLimiterLimitBytes) {
+		options.SidekiqJobLimiterLimitBytes = gitlab.Int(int(plan.SidekiqJobLimiterLimitBytes.ValueInt64()))
+	}
+
+	if attributeChanged(plan.SignInText, state.SignInText) {
+		options.SignInText = gitlab.String(plan.SignInText.ValueString())
+	}
+
+	if attributeChanged(plan.SignupEnabled, state.SignupEnabled) {
+		options.SignupEnabled = gitlab.Bool(plan.SignupEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.SlackAppEnabled, state.SlackAppEnabled) {
+		options.SlackAppEnabled = gitlab.Bool(plan.SlackAppEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.SlackAppId, state.SlackAppId) {
+		options.SlackAppID = gitlab.String(plan.SlackAppId.ValueString())
+	}
+
+	if attributeChanged(plan.SlackAppSecret, state.SlackAppSecret) {
+		options.SlackAppSecret = gitlab.String(plan.SlackAppSecret.ValueString())
+	}
+
+	if attributeChanged(plan.SlackAppSigningSecret, state.SlackAppSigningSecret) {
+		options.SlackAppSigningSecret = gitlab.String(plan.SlackAppSigningSecret.ValueString())
+	}
+
+	if attributeChanged(plan.SlackAppVerificationToken, state.SlackAppVerificationToken) {
+		options.SlackAppVerificationToken = gitlab.String(plan.SlackAppVerificationToken.ValueString())
+	}
+
+	if attributeChanged(plan.SnippetSizeLimit, state.SnippetSizeLimit) {
+		options.SnippetSizeLimit = gitlab.Int(int(plan.SnippetSizeLimit.ValueInt64()))
+	}
+
+	if attributeChanged(plan.SnowplowAppId, state.SnowplowAppId) {
+		options.SnowplowAppID = gitlab.String(plan.SnowplowAppId.ValueString())
+	}
+
+	if attributeChanged(plan.SnowplowCollectorHostname, state.SnowplowCollectorHostname) {
+		options.SnowplowCollectorHostname = gitlab.String(plan.SnowplowCollectorHostname.ValueString())
+	}
+
+	if attributeChanged(plan.SnowplowCookieDomain, state.SnowplowCookieDomain) {
+		options.SnowplowCookieDomain = gitlab.String(plan.SnowplowCookieDomain.ValueString())
+	}
+
+	if attributeChanged(plan.SnowplowEnabled, state.SnowplowEnabled) {
+		options.SnowplowEnabled = gitlab.Bool(plan.SnowplowEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.SourcegraphEnabled, state.SourcegraphEnabled) {
+		options.SourcegraphEnabled = gitlab.Bool(plan.SourcegraphEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.SourcegraphPublicOnly, state.SourcegraphPublicOnly) {
+		options.SourcegraphPublicOnly = gitlab.Bool(plan.SourcegraphPublicOnly.ValueBool())
+	}
+
+	if attributeChanged(plan.SourcegraphUrl, state.SourcegraphUrl) {
+		options.SourcegraphURL = gitlab.String(plan.SourcegraphUrl.ValueString())
+	}
+
+	if attributeChanged(plan.SpamCheckEndpointEnabled, state.SpamCheckEndpointEnabled) {
+		options.SpamCheckEndpointEnabled = gitlab.Bool(plan.SpamCheckEndpointEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.SpamCheckEndpointUrl, state.SpamCheckEndpointUrl) {
+		options.SpamCheckEndpointURL = gitlab.String(plan.SpamCheckEndpointUrl.ValueString())
+	}
+
+	if attributeChanged(plan.SpamCheckApiKey, state.SpamCheckApiKey) {
+		options.SpamCheckAPIKey = gitlab.String(plan.SpamCheckApiKey.ValueString())
+	}
+
+	if attributeChanged(plan.SuggestPipelineEnabled, state.SuggestPipelineEnabled) {
+		options.SuggestPipelineEnabled = gitlab.Bool(plan.SuggestPipelineEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.TerminalMaxSessionTime, state.TerminalMaxSessionTime) {
+		options.TerminalMaxSessionTime = gitlab.Int(int(plan.TerminalMaxSessionTime.ValueInt64()))
+	}
+
+	if attributeChanged(plan.Terms, state.Terms) {
+		options.Terms = gitlab.String(plan.Terms.ValueString())
+	}
+
+	if attributeChanged(plan.ThrottleAuthenticatedApiEnabled, state.ThrottleAuthenticatedApiEnabled) {
+		options.ThrottleAuthenticatedAPIEnabled = gitlab.Bool(plan.ThrottleAuthenticatedApiEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.ThrottleAuthenticatedApiPeriodInSeconds, state.ThrottleAuthenticatedApiPeriodInSeconds) {
+		options.ThrottleAuthenticatedAPIPeriodInSeconds = gitlab.Int(int(plan.ThrottleAuthenticatedApiPeriodInSeconds.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ThrottleAuthenticatedApiRequestsPerPeriod, state.ThrottleAuthenticatedApiRequestsPerPeriod) {
+		options.ThrottleAuthenticatedAPIRequestsPerPeriod = gitlab.Int(int(plan.ThrottleAuthenticatedApiRequestsPerPeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ThrottleAuthenticatedPackagesApiEnabled, state.ThrottleAuthenticatedPackagesApiEnabled) {
+		options.ThrottleAuthenticatedPackagesAPIEnabled = gitlab.Bool(plan.ThrottleAuthenticatedPackagesApiEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.ThrottleAuthenticatedPackagesApiPeriodInSeconds, state.ThrottleAuthenticatedPackagesApiPeriodInSeconds) {
+		options.ThrottleAuthenticatedPackagesAPIPeriodInSeconds = gitlab.Int(int(plan.ThrottleAuthenticatedPackagesApiPeriodInSeconds.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ThrottleAuthenticatedPackagesApiRequestsPerPeriod, state.ThrottleAuthenticatedPackagesApiRequestsPerPeriod) {
+		options.ThrottleAuthenticatedPackagesAPIRequestsPerPeriod = gitlab.Int(int(plan.ThrottleAuthenticatedPackagesApiRequestsPerPeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ThrottleAuthenticatedWebEnabled, state.ThrottleAuthenticatedWebEnabled) {
+		options.ThrottleAuthenticatedWebEnabled = gitlab.Bool(plan.ThrottleAuthenticatedWebEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.ThrottleAuthenticatedWebPeriodInSeconds, state.ThrottleAuthenticatedWebPeriodInSeconds) {
+		options.ThrottleAuthenticatedWebPeriodInSeconds = gitlab.Int(int(plan.ThrottleAuthenticatedWebPeriodInSeconds.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ThrottleAuthenticatedWebRequestsPerPeriod, state.ThrottleAuthenticatedWebRequestsPerPeriod) {
+		options.ThrottleAuthenticatedWebRequestsPerPeriod = gitlab.Int(int(plan.ThrottleAuthenticatedWebRequestsPerPeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ThrottleUnauthenticatedApiEnabled, state.ThrottleUnauthenticatedApiEnabled) {
+		options.ThrottleUnauthenticatedAPIEnabled = gitlab.Bool(plan.ThrottleUnauthenticatedApiEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.ThrottleUnauthenticatedApiPeriodInSeconds, state.ThrottleUnauthenticatedApiPeriodInSeconds) {
+		options.ThrottleUnauthenticatedAPIPeriodInSeconds = gitlab.Int(int(plan.ThrottleUnauthenticatedApiPeriodInSeconds.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ThrottleUnauthenticatedApiRequestsPerPeriod, state.ThrottleUnauthenticatedApiRequestsPerPeriod) {
+		options.ThrottleUnauthenticatedAPIRequestsPerPeriod = gitlab.Int(int(plan.ThrottleUnauthenticatedApiRequestsPerPeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ThrottleUnauthenticatedPackagesApiEnabled, state.ThrottleUnauthenticatedPackagesApiEnabled) {
+		options.ThrottleUnauthenticatedPackagesAPIEnabled = gitlab.Bool(plan.ThrottleUnauthenticatedPackagesApiEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.ThrottleUnauthenticatedPackagesApiPeriodInSeconds, state.ThrottleUnauthenticatedPackagesApiPeriodInSeconds) {
+		options.ThrottleUnauthenticatedPackagesAPIPeriodInSeconds = gitlab.Int(int(plan.ThrottleUnauthenticatedPackagesApiPeriodInSeconds.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ThrottleUnauthenticatedPackagesApiRequestsPerPeriod, state.ThrottleUnauthenticatedPackagesApiRequestsPerPeriod) {
+		options.ThrottleUnauthenticatedPackagesAPIRequestsPerPeriod = gitlab.Int(int(plan.ThrottleUnauthenticatedPackagesApiRequestsPerPeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ThrottleUnauthenticatedWebEnabled, state.ThrottleUnauthenticatedWebEnabled) {
+		options.ThrottleUnauthenticatedWebEnabled = gitlab.Bool(plan.ThrottleUnauthenticatedWebEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.ThrottleUnauthenticatedWebPeriodInSeconds, state.ThrottleUnauthenticatedWebPeriodInSeconds) {
+		options.ThrottleUnauthenticatedWebPeriodInSeconds = gitlab.Int(int(plan.ThrottleUnauthenticatedWebPeriodInSeconds.ValueInt64()))
+	}
+
+	if attributeChanged(plan.ThrottleUnauthenticatedWebRequestsPerPeriod, state.ThrottleUnauthenticatedWebRequestsPerPeriod) {
+		options.ThrottleUnauthenticatedWebRequestsPerPeriod = gitlab.Int(int(plan.ThrottleUnauthenticatedWebRequestsPerPeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.TimeTrackingLimitToHours, state.TimeTrackingLimitToHours) {
+		options.TimeTrackingLimitToHours = gitlab.Bool(plan.TimeTrackingLimitToHours.ValueBool())
+	}
+
+	if attributeChanged(plan.TwoFactorGracePeriod, state.TwoFactorGracePeriod) {
+		options.TwoFactorGracePeriod = gitlab.Int(int(plan.TwoFactorGracePeriod.ValueInt64()))
+	}
+
+	if attributeChanged(plan.UniqueIpsLimitEnabled, state.UniqueIpsLimitEnabled) {
+		options.UniqueIPsLimitEnabled = gitlab.Bool(plan.UniqueIpsLimitEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.UniqueIpsLimitPerUser, state.UniqueIpsLimitPerUser) {
+		options.UniqueIPsLimitPerUser = gitlab.Int(int(plan.UniqueIpsLimitPerUser.ValueInt64()))
+	}
+
+	if attributeChanged(plan.UniqueIpsLimitTimeWindow, state.UniqueIpsLimitTimeWindow) {
+		options.UniqueIPsLimitTimeWindow = gitlab.Int(int(plan.UniqueIpsLimitTimeWindow.ValueInt64()))
+	}
+
+	if attributeChanged(plan.UsagePingEnabled, state.UsagePingEnabled) {
+		options.UsagePingEnabled = gitlab.Bool(plan.UsagePingEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.UserDeactivationEmailsEnabled, state.UserDeactivationEmailsEnabled) {
+		options.UserDeactivationEmailsEnabled = gitlab.Bool(plan.UserDeactivationEmailsEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.UserDefaultExternal, state.UserDefaultExternal) {
+		options.UserDefaultExternal = gitlab.Bool(plan.UserDefaultExternal.ValueBool())
+	}
+
+	if attributeChanged(plan.UserDefaultInternalRegex, state.UserDefaultInternalRegex) {
+		options.UserDefaultInternalRegex = gitlab.String(plan.UserDefaultInternalRegex.ValueString())
+	}
+
+	if attributeChanged(plan.UserOauthApplications, state.UserOauthApplications) {
+		options.UserOauthApplications = gitlab.Bool(plan.UserOauthApplications.ValueBool())
+	}
+
+	if attributeChanged(plan.UserShowAddSshKeyMessage, state.UserShowAddSshKeyMessage) {
+		options.UserShowAddSSHKeyMessage = gitlab.Bool(plan.UserShowAddSshKeyMessage.ValueBool())
+	}
+
+	if attributeChanged(plan.VersionCheckEnabled, state.VersionCheckEnabled) {
+		options.VersionCheckEnabled = gitlab.Bool(plan.VersionCheckEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.WhatsNewVariant, state.WhatsNewVariant) {
+		options.WhatsNewVariant = gitlab.String(plan.WhatsNewVariant.ValueString())
+	}
+
+	if attributeChanged(plan.WebIdeClientsidePreviewEnabled, state.WebIdeClientsidePreviewEnabled) {
+		options.WebIDEClientsidePreviewEnabled = gitlab.Bool(plan.WebIdeClientsidePreviewEnabled.ValueBool())
+	}
+
+	if attributeChanged(plan.WikiPageMaxContentBytes, state.WikiPageMaxContentBytes) {
+		options.WikiPageMaxContentBytes = gitlab.Int(int(plan.WikiPageMaxContentBytes.ValueInt64()))
+	}
+
+	return &options, diags
+}
+
+// stringListValue returns a list value of the given strings. A nil slice results in an empty list.
+func stringListValue(ctx context.Context, values []string) (types.List, diag.Diagnostics) {
+	if values == nil {
+		values = []string{}
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+// stringListElements returns the elements of a list of strings and appends any error to the diagnostics.
+func stringListElements(ctx context.Context, list types.List, diags *diag.Diagnostics) []string {
+	values := make([]string, 0, len(list.Elements()))
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+// intsToStrings converts the IDs which are stored as a list of strings in the state.
+func intsToStrings(values []int) []string {
+	if values == nil {
+		return nil
+	}
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		strs = append(strs, strconv.Itoa(value))
+	}
+	return strs
+}
+
+// stringsToInts converts the IDs which are stored as a list of strings in the state.
+func stringsToInts(values []string) ([]int, error) {
+	ints := make([]int, 0, len(values))
+	for _, value := range values {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid ID: %w", value, err)
+		}
+		ints = append(ints, i)
+	}
+	return ints, nil
+}
+
+func visibilityValuesToStrings(values []gitlab.VisibilityValue) []string {
+	if values == nil {
+		return nil
+	}
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		strs = append(strs, string(value))
+	}
+	return strs
+}
+
+func stringsToVisibilityValues(values []string) []gitlab.VisibilityValue {
+	visibilityValues := make([]gitlab.VisibilityValue, 0, len(values))
+	for _, value := range values {
+		visibilityValues = append(visibilityValues, gitlab.VisibilityValue(value))
+	}
+	return visibilityValues
+}
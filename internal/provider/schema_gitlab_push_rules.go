@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// gitlabPushRulesModel describes the data model of the push rules of a project or group.
+type gitlabPushRulesModel struct {
+	AuthorEmailRegex           types.String `tfsdk:"author_email_regex"`
+	BranchNameRegex            types.String `tfsdk:"branch_name_regex"`
+	CommitMessageRegex         types.String `tfsdk:"commit_message_regex"`
+	CommitMessageNegativeRegex types.String `tfsdk:"commit_message_negative_regex"`
+	FileNameRegex              types.String `tfsdk:"file_name_regex"`
+	CommitCommitterCheck       types.Bool   `tfsdk:"commit_committer_check"`
+	DenyDeleteTag              types.Bool   `tfsdk:"deny_delete_tag"`
+	MemberCheck                types.Bool   `tfsdk:"member_check"`
+	PreventSecrets             types.Bool   `tfsdk:"prevent_secrets"`
+	RejectUnsignedCommits      types.Bool   `tfsdk:"reject_unsigned_commits"`
+	MaxFileSize                types.Int64  `tfsdk:"max_file_size"`
+}
+
+var gitlabPushRulesAttributeTypes = map[string]attr.Type{
+	"author_email_regex":            types.StringType,
+	"branch_name_regex":             types.StringType,
+	"commit_message_regex":          types.StringType,
+	"commit_message_negative_regex": types.StringType,
+	"file_name_regex":               types.StringType,
+	"commit_committer_check":        types.BoolType,
+	"deny_delete_tag":               types.BoolType,
+	"member_check":                  types.BoolType,
+	"prevent_secrets":               types.BoolType,
+	"reject_unsigned_commits":       types.BoolType,
+	"max_file_size":                 types.Int64Type,
+}
+
+// gitlabPushRulesAttributes returns the schema of the push rules, which is shared by the `push_rules` attribute
+// of the `gitlab_project` resource and the `gitlab_project_push_rules` and `gitlab_group_push_rules` resources.
+// Attributes which aren't configured are reset to their defaults.
+func gitlabPushRulesAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"author_email_regex": schema.StringAttribute{
+			MarkdownDescription: "All commit author emails must match this regex, e.g. `@my-company.com$`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"branch_name_regex": schema.StringAttribute{
+			MarkdownDescription: "All branch names must match this regex, e.g. `(feature|hotfix)\\/*`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"commit_message_regex": schema.StringAttribute{
+			MarkdownDescription: "All commit messages must match this regex, e.g. `Fixed \\d+\\..*`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"commit_message_negative_regex": schema.StringAttribute{
+			MarkdownDescription: "No commit message is allowed to match this regex, for example `ssh\\:\\/\\/`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"file_name_regex": schema.StringAttribute{
+			MarkdownDescription: "All commited filenames must not match this regex, e.g. `(jar|exe)$`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{defaultString("")},
+		},
+		"commit_committer_check": schema.BoolAttribute{
+			MarkdownDescription: "Users can only push commits to this repository that were committed with one of their own verified emails.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"deny_delete_tag": schema.BoolAttribute{
+			MarkdownDescription: "Deny deleting a tag.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"member_check": schema.BoolAttribute{
+			MarkdownDescription: "Restrict commits by author (email) to existing GitLab users.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"prevent_secrets": schema.BoolAttribute{
+			MarkdownDescription: "GitLab will reject any files that are likely to contain secrets.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"reject_unsigned_commits": schema.BoolAttribute{
+			MarkdownDescription: "Reject commit when it’s not signed through GPG.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Bool{defaultBool(false)},
+		},
+		"max_file_size": schema.Int64Attribute{
+			MarkdownDescription: "Maximum file size (MB).",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers:       []planmodifier.Int64{defaultInt64(0)},
+			Validators:          []validator.Int64{int64validator.AtLeast(0)},
+		},
+	}
+}
+
+// projectPushRulesToModel returns the data model of the push rules of a project.
+func projectPushRulesToModel(pushRules *gitlab.ProjectPushRules) gitlabPushRulesModel {
+	return gitlabPushRulesModel{
+		AuthorEmailRegex:           types.StringValue(pushRules.AuthorEmailRegex),
+		BranchNameRegex:            types.StringValue(pushRules.BranchNameRegex),
+		CommitMessageRegex:         types.StringValue(pushRules.CommitMessageRegex),
+		CommitMessageNegativeRegex: types.StringValue(pushRules.CommitMessageNegativeRegex),
+		FileNameRegex:              types.StringValue(pushRules.FileNameRegex),
+		CommitCommitterCheck:       types.BoolValue(pushRules.CommitCommitterCheck),
+		DenyDeleteTag:              types.BoolValue(pushRules.DenyDeleteTag),
+		MemberCheck:                types.BoolValue(pushRules.MemberCheck),
+		PreventSecrets:             types.BoolValue(pushRules.PreventSecrets),
+		RejectUnsignedCommits:      types.BoolValue(pushRules.RejectUnsignedCommits),
+		MaxFileSize:                types.Int64Value(int64(pushRules.MaxFileSize)),
+	}
+}
+
+// groupPushRulesToModel returns the data model of the push rules of a group.
+func groupPushRulesToModel(pushRules *gitlab.GroupPushRules) gitlabPushRulesModel {
+	return gitlabPushRulesModel{
+		AuthorEmailRegex:           types.StringValue(pushRules.AuthorEmailRegex),
+		BranchNameRegex:            types.StringValue(pushRules.BranchNameRegex),
+		CommitMessageRegex:         types.StringValue(pushRules.CommitMessageRegex),
+		CommitMessageNegativeRegex: types.StringValue(pushRules.CommitMessageNegativeRegex),
+		FileNameRegex:              types.StringValue(pushRules.FileNameRegex),
+		CommitCommitterCheck:       types.BoolValue(pushRules.CommitCommitterCheck),
+		DenyDeleteTag:              types.BoolValue(pushRules.DenyDeleteTag),
+		MemberCheck:                types.BoolValue(pushRules.MemberCheck),
+		PreventSecrets:             types.BoolValue(pushRules.PreventSecrets),
+		RejectUnsignedCommits:      types.BoolValue(pushRules.RejectUnsignedCommits),
+		MaxFileSize:                types.Int64Value(int64(pushRules.MaxFileSize)),
+	}
+}
+
+// editOrAddProjectPushRules sets the push rules of the project to the given values.
+// Only the values which differ from the existing push rules are sent.
+func editOrAddProjectPushRules(ctx context.Context, client *gitlab.Client, projectID string, data gitlabPushRulesModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "editing push rules for project", map[string]interface{}{"project": projectID})
+	pushRules, _, err := client.Projects.GetProjectPushRules(projectID, gitlab.WithContext(ctx))
+	if err != nil && !api.Is404(err) {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Failed to get push rules for project %q: %s", projectID, err.Error()))
+		return diags
+	}
+
+	// NOTE: push rules id `0` indicates that there haven't been any push rules set.
+	if pushRules == nil || pushRules.ID == 0 {
+		tflog.Debug(ctx, "creating new push rules for project", map[string]interface{}{"project": projectID})
+		_, _, err = client.Projects.AddProjectPushRule(projectID, &gitlab.AddProjectPushRuleOptions{
+			AuthorEmailRegex:           gitlab.String(data.AuthorEmailRegex.ValueString()),
+			BranchNameRegex:            gitlab.String(data.BranchNameRegex.ValueString()),
+			CommitMessageRegex:         gitlab.String(data.CommitMessageRegex.ValueString()),
+			CommitMessageNegativeRegex: gitlab.String(data.CommitMessageNegativeRegex.ValueString()),
+			FileNameRegex:              gitlab.String(data.FileNameRegex.ValueString()),
+			CommitCommitterCheck:       gitlab.Bool(data.CommitCommitterCheck.ValueBool()),
+			DenyDeleteTag:              gitlab.Bool(data.DenyDeleteTag.ValueBool()),
+			MemberCheck:                gitlab.Bool(data.MemberCheck.ValueBool()),
+			PreventSecrets:             gitlab.Bool(data.PreventSecrets.ValueBool()),
+			RejectUnsignedCommits:      gitlab.Bool(data.RejectUnsignedCommits.ValueBool()),
+			MaxFileSize:                gitlab.Int(int(data.MaxFileSize.ValueInt64())),
+		}, gitlab.WithContext(ctx))
+	} else {
+		options := gitlab.EditProjectPushRuleOptions{}
+		if data.AuthorEmailRegex.ValueString() != pushRules.AuthorEmailRegex {
+			options.AuthorEmailRegex = gitlab.String(data.AuthorEmailRegex.ValueString())
+		}
+		if data.BranchNameRegex.ValueString() != pushRules.BranchNameRegex {
+			options.BranchNameRegex = gitlab.String(data.BranchNameRegex.ValueString())
+		}
+		if data.CommitMessageRegex.ValueString() != pushRules.CommitMessageRegex {
+			options.CommitMessageRegex = gitlab.String(data.CommitMessageRegex.ValueString())
+		}
+		if data.CommitMessageNegativeRegex.ValueString() != pushRules.CommitMessageNegativeRegex {
+			options.CommitMessageNegativeRegex = gitlab.String(data.CommitMessageNegativeRegex.ValueString())
+		}
+		if data.FileNameRegex.ValueString() != pushRules.FileNameRegex {
+			options.FileNameRegex = gitlab.String(data.FileNameRegex.ValueString())
+		}
+		if data.CommitCommitterCheck.ValueBool() != pushRules.CommitCommitterCheck {
+			options.CommitCommitterCheck = gitlab.Bool(data.CommitCommitterCheck.ValueBool())
+		}
+		if data.DenyDeleteTag.ValueBool() != pushRules.DenyDeleteTag {
+			options.DenyDeleteTag = gitlab.Bool(data.DenyDeleteTag.ValueBool())
+		}
+		if data.MemberCheck.ValueBool() != pushRules.MemberCheck {
+			options.MemberCheck = gitlab.Bool(data.MemberCheck.ValueBool())
+		}
+		if data.PreventSecrets.ValueBool() != pushRules.PreventSecrets {
+			options.PreventSecrets = gitlab.Bool(data.PreventSecrets.ValueBool())
+		}
+		if data.RejectUnsignedCommits.ValueBool() != pushRules.RejectUnsignedCommits {
+			options.RejectUnsignedCommits = gitlab.Bool(data.RejectUnsignedCommits.ValueBool())
+		}
+		if int(data.MaxFileSize.ValueInt64()) != pushRules.MaxFileSize {
+			options.MaxFileSize = gitlab.Int(int(data.MaxFileSize.ValueInt64()))
+		}
+
+		if (options == gitlab.EditProjectPushRuleOptions{}) {
+			tflog.Debug(ctx, "push rules of project are already up-to-date", map[string]interface{}{"project": projectID})
+			return diags
+		}
+		tflog.Debug(ctx, "editing existing push rules for project", map[string]interface{}{"project": projectID})
+		_, _, err = client.Projects.EditProjectPushRule(projectID, &options, gitlab.WithContext(ctx))
+	}
+
+	if err != nil {
+		if api.Is404(err) {
+			diags.AddError("GitLab Feature not available", "Project push rules are not supported in your version of GitLab")
+			return diags
+		}
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Failed to edit push rules for project %q: %s", projectID, err.Error()))
+	}
+	return diags
+}
+
+// editOrAddGroupPushRules sets the push rules of the group to the given values.
+// Only the values which differ from the existing push rules are sent.
+func editOrAddGroupPushRules(ctx context.Context, client *gitlab.Client, groupID string, data gitlabPushRulesModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "editing push rules for group", map[string]interface{}{"group": groupID})
+	pushRules, _, err := client.Groups.GetGroupPushRules(groupID, gitlab.WithContext(ctx))
+	if err != nil && !api.Is404(err) {
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Failed to get push rules for group %q: %s", groupID, err.Error()))
+		return diags
+	}
+
+	// NOTE: push rules id `0` indicates that there haven't been any push rules set.
+	if pushRules == nil || pushRules.ID == 0 {
+		tflog.Debug(ctx, "creating new push rules for group", map[string]interface{}{"group": groupID})
+		_, _, err = client.Groups.AddGroupPushRule(groupID, &gitlab.AddGroupPushRuleOptions{
+			AuthorEmailRegex:           gitlab.String(data.AuthorEmailRegex.ValueString()),
+			BranchNameRegex:            gitlab.String(data.BranchNameRegex.ValueString()),
+			CommitMessageRegex:         gitlab.String(data.CommitMessageRegex.ValueString()),
+			CommitMessageNegativeRegex: gitlab.String(data.CommitMessageNegativeRegex.ValueString()),
+			FileNameRegex:              gitlab.String(data.FileNameRegex.ValueString()),
+			CommitCommitterCheck:       gitlab.Bool(data.CommitCommitterCheck.ValueBool()),
+			DenyDeleteTag:              gitlab.Bool(data.DenyDeleteTag.ValueBool()),
+			MemberCheck:                gitlab.Bool(data.MemberCheck.ValueBool()),
+			PreventSecrets:             gitlab.Bool(data.PreventSecrets.ValueBool()),
+			RejectUnsignedCommits:      gitlab.Bool(data.RejectUnsignedCommits.ValueBool()),
+			MaxFileSize:                gitlab.Int(int(data.MaxFileSize.ValueInt64())),
+		}, gitlab.WithContext(ctx))
+	} else {
+		options := gitlab.EditGroupPushRuleOptions{}
+		if data.AuthorEmailRegex.ValueString() != pushRules.AuthorEmailRegex {
+			options.AuthorEmailRegex = gitlab.String(data.AuthorEmailRegex.ValueString())
+		}
+		if data.BranchNameRegex.ValueString() != pushRules.BranchNameRegex {
+			options.BranchNameRegex = gitlab.String(data.BranchNameRegex.ValueString())
+		}
+		if data.CommitMessageRegex.ValueString() != pushRules.CommitMessageRegex {
+			options.CommitMessageRegex = gitlab.String(data.CommitMessageRegex.ValueString())
+		}
+		if data.CommitMessageNegativeRegex.ValueString() != pushRules.CommitMessageNegativeRegex {
+			options.CommitMessageNegativeRegex = gitlab.String(data.CommitMessageNegativeRegex.ValueString())
+		}
+		if data.FileNameRegex.ValueString() != pushRules.FileNameRegex {
+			options.FileNameRegex = gitlab.String(data.FileNameRegex.ValueString())
+		}
+		if data.CommitCommitterCheck.ValueBool() != pushRules.CommitCommitterCheck {
+			options.CommitCommitterCheck = gitlab.Bool(data.CommitCommitterCheck.ValueBool())
+		}
+		if data.DenyDeleteTag.ValueBool() != pushRules.DenyDeleteTag {
+			options.DenyDeleteTag = gitlab.Bool(data.DenyDeleteTag.ValueBool())
+		}
+		if data.MemberCheck.ValueBool() != pushRules.MemberCheck {
+			options.MemberCheck = gitlab.Bool(data.MemberCheck.ValueBool())
+		}
+		if data.PreventSecrets.ValueBool() != pushRules.PreventSecrets {
+			options.PreventSecrets = gitlab.Bool(data.PreventSecrets.ValueBool())
+		}
+		if data.RejectUnsignedCommits.ValueBool() != pushRules.RejectUnsignedCommits {
+			options.RejectUnsignedCommits = gitlab.Bool(data.RejectUnsignedCommits.ValueBool())
+		}
+		if int(data.MaxFileSize.ValueInt64()) != pushRules.MaxFileSize {
+			options.MaxFileSize = gitlab.Int(int(data.MaxFileSize.ValueInt64()))
+		}
+
+		if (options == gitlab.EditGroupPushRuleOptions{}) {
+			tflog.Debug(ctx, "push rules of group are already up-to-date", map[string]interface{}{"group": groupID})
+			return diags
+		}
+		tflog.Debug(ctx, "editing existing push rules for group", map[string]interface{}{"group": groupID})
+		_, _, err = client.Groups.EditGroupPushRule(groupID, &options, gitlab.WithContext(ctx))
+	}
+
+	if err != nil {
+		if api.Is404(err) {
+			diags.AddError("GitLab Feature not available", "Group push rules are not supported in your version of GitLab")
+			return diags
+		}
+		diags.AddError("GitLab API error occurred", fmt.Sprintf("Failed to edit push rules for group %q: %s", groupID, err.Error()))
+	}
+	return diags
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 type CustomAttributeGetter func(int, string, ...gitlab.RequestOptionFunc) (*gitlab.CustomAttribute, *gitlab.Response, error)
@@ -29,7 +31,7 @@ func CreateCustomAttributeResource(idName string, createGetter CreateGetter, cre
 	}
 
 	readFunc := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		client := meta.(*gitlab.Client)
+		client := meta.(*api.ProviderData).Client
 		getter := createGetter(client)
 		log.Printf("[DEBUG] read Custom Attribute %s", d.Id())
 
@@ -48,7 +50,7 @@ func CreateCustomAttributeResource(idName string, createGetter CreateGetter, cre
 	}
 
 	setFunc := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		client := meta.(*gitlab.Client)
+		client := meta.(*api.ProviderData).Client
 		setter := createSetter(client)
 
 		id := d.Get(idName).(int)
@@ -69,7 +71,7 @@ func CreateCustomAttributeResource(idName string, createGetter CreateGetter, cre
 	}
 
 	deleteFunc := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		client := meta.(*gitlab.Client)
+		client := meta.(*api.ProviderData).Client
 		deleter := createDeleter(client)
 		log.Printf("[DEBUG] delete Custom Attribute %s", d.Id())
 
@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerDataSource("gitlab_applications", func() *schema.Resource {
+	applicationSchema := datasourceSchemaFromResourceSchema(gitlabApplicationGetSchema(), nil, nil, "scopes", "secret")
+	applicationSchema["id"] = &schema.Schema{
+		Description: "The ID of the application.",
+		Type:        schema.TypeInt,
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_applications`" + ` data source allows to retrieve all instance-wide OAuth applications.
+
+-> This data source requires administration privileges.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/applications.html#list-all-applications)`,
+
+		ReadContext: dataSourceGitlabApplicationsRead,
+		Schema: map[string]*schema.Schema{
+			"applications": {
+				Description: "The list of applications.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: applicationSchema,
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabApplicationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+
+	applications, err := listAllGitlabApplications(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("applications")
+	if err := d.Set("applications", flattenGitlabApplications(applications)); err != nil {
+		return diag.Errorf("failed to set applications to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabApplications(applications []*gitlab.Application) (values []map[string]interface{}) {
+	for _, application := range applications {
+		stateMap := gitlabApplicationToStateMap(application)
+		stateMap["id"] = application.ID
+		values = append(values, stateMap)
+	}
+	return values
+}
@@ -0,0 +1,41 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataGitlabApplications_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("acctest")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_application" "this" {
+					name         = "%s"
+					redirect_url = "https://example.com/callback"
+					scopes       = ["openid"]
+					confidential = false
+				}
+
+				data "gitlab_applications" "this" {
+					depends_on = [gitlab_application.this]
+				}`, name),
+				Check: resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_applications.this", "applications.*", map[string]string{
+					"name":         name,
+					"redirect_url": "https://example.com/callback",
+					"confidential": "false",
+				}),
+			},
+		},
+	})
+}
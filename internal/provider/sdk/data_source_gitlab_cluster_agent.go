@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 var _ = registerDataSource("gitlab_cluster_agent", func() *schema.Resource {
@@ -23,7 +25,7 @@ var _ = registerDataSource("gitlab_cluster_agent", func() *schema.Resource {
 })
 
 func dataSourceGitlabClusterAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project := d.Get("project").(string)
 	agentID := d.Get("agent_id").(int)
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 var _ = registerDataSource("gitlab_cluster_agents", func() *schema.Resource {
@@ -38,7 +40,7 @@ var _ = registerDataSource("gitlab_cluster_agents", func() *schema.Resource {
 })
 
 func dataSourceGitlabClusterAgentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project := d.Get("project").(string)
 	options := gitlab.ListAgentsOptions{
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 var _ = registerDataSource("gitlab_group_variable", func() *schema.Resource {
@@ -21,7 +23,7 @@ var _ = registerDataSource("gitlab_group_variable", func() *schema.Resource {
 })
 
 func dataSourceGitlabGroupVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
 	environmentScope := d.Get("environment_scope").(string)
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 var _ = registerDataSource("gitlab_group_variables", func() *schema.Resource {
@@ -42,7 +44,7 @@ var _ = registerDataSource("gitlab_group_variables", func() *schema.Resource {
 })
 
 func dataSourceGitlabGroupVariablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group := d.Get("group").(string)
 	environmentScope := d.Get("environment_scope").(string)
 
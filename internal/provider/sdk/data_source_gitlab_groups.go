@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 var _ = registerDataSource("gitlab_groups", func() *schema.Resource {
@@ -131,7 +133,7 @@ var _ = registerDataSource("gitlab_groups", func() *schema.Resource {
 })
 
 func dataSourceGitlabGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	listGroupsOptions, id, err := expandGitlabGroupsOptions(d)
 	if err != nil {
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 var _ = registerDataSource("gitlab_instance_variable", func() *schema.Resource {
@@ -20,7 +22,7 @@ var _ = registerDataSource("gitlab_instance_variable", func() *schema.Resource {
 })
 
 func dataSourceGitlabInstanceVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	key := d.Get("key").(string)
 
 	variable, _, err := client.InstanceVariables.GetVariable(key, nil, gitlab.WithContext(ctx))
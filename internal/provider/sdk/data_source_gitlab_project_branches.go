@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/hashstructure/v2"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 var _ = registerDataSource("gitlab_project_branches", func() *schema.Resource {
@@ -87,7 +89,7 @@ var _ = registerDataSource("gitlab_project_branches", func() *schema.Resource {
 })
 
 func dataSourceGitlabProjectBranchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	log.Printf("[INFO] Reading Gitlab branches")
 
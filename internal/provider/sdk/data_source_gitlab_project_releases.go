@@ -0,0 +1,90 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure/v2"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerDataSource("gitlab_project_releases", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_releases`" + ` data source allows details of the releases of a project to be retrieved.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/releases/#list-releases)`,
+
+		ReadContext: dataSourceGitlabProjectReleasesRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"order_by": {
+				Description:  "Return releases ordered by `released_at` or `created_at` fields. Default is `released_at`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"released_at", "created_at"}, false),
+			},
+			"sort": {
+				Description:  "Return releases sorted in `asc` or `desc` order. Default is `desc`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+			},
+			"releases": {
+				Description: "List of releases of the project.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: gitlabReleaseGetDataSourceSchema(nil),
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectReleasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+
+	project := d.Get("project").(string)
+	options := gitlab.ListReleasesOptions{}
+
+	if v, ok := d.GetOk("order_by"); ok {
+		options.OrderBy = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sort"); ok {
+		options.Sort = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(&options, hashstructure.FormatV1, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] get gitlab releases from project: %s", project)
+	releases, err := api.ListReleases(ctx, client, project, &options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", project, optionsHash))
+	if err = d.Set("releases", flattenGitlabReleases(project, releases)); err != nil {
+		return diag.Errorf("Failed to set releases to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabReleases(project string, releases []*api.Release) (values []map[string]interface{}) {
+	for _, release := range releases {
+		values = append(values, gitlabReleaseToStateMap(project, release, release.Assets.Links))
+	}
+	return values
+}
@@ -0,0 +1,39 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccDataGitlabProjectReleases_basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+	releases := testutil.CreateReleases(t, project, 3)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "gitlab_project_releases" "this" {
+					project  = "%d"
+					order_by = "created_at"
+					sort     = "asc"
+				}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_releases.this", "releases.#", "3"),
+					resource.TestCheckResourceAttr("data.gitlab_project_releases.this", "releases.0.tag_name", releases[0].TagName),
+					resource.TestCheckResourceAttr("data.gitlab_project_releases.this", "releases.0.name", releases[0].Name),
+					resource.TestCheckResourceAttr("data.gitlab_project_releases.this", "releases.0.links.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_project_releases.this", "releases.2.tag_name", releases[2].TagName),
+				),
+			},
+		},
+	})
+}
@@ -33,8 +33,8 @@ func TestAccDataGitlabProject_basic(t *testing.T) {
 			{
 				SkipFunc: testutil.IsRunningInCE,
 				Config:   testAccDataGitlabProjectConfigPushRules(projectname),
-				Check: testAccDataSourceGitlabProject("gitlab_project.test", "data.gitlab_project.foo",
-					[]string{"push_rules.0.author_email_regex"}),
+				Check: resource.TestCheckResourceAttrPair("gitlab_project.test", "push_rules.author_email_regex",
+					"data.gitlab_project.foo", "push_rules.0.author_email_regex"),
 			},
 		},
 	})
@@ -99,7 +99,7 @@ resource "gitlab_project" "test"{
 	path = "%[1]s"
 	description = "Terraform acceptance tests"
 	visibility_level = "public"
-    push_rules {
+    push_rules = {
         author_email_regex = "foo"
     }
 }
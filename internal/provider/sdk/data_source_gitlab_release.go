@@ -0,0 +1,48 @@
+package sdk
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerDataSource("gitlab_release", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_release`" + ` data source allows get details of a release of a project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/releases/#get-a-release-by-a-tag-name)`,
+
+		ReadContext: dataSourceGitlabReleaseRead,
+		Schema:      gitlabReleaseGetDataSourceSchema([]string{"project", "tag_name"}),
+	}
+})
+
+// gitlabReleaseGetDataSourceSchema returns the schema of a release in a data source, where all asset links of the release are returned.
+func gitlabReleaseGetDataSourceSchema(arguments []string) map[string]*schema.Schema {
+	s := datasourceSchemaFromResourceSchema(gitlabReleaseGetSchema(), arguments, nil, "ref")
+	s["links"].Description = "The asset links of the release."
+	return s
+}
+
+func dataSourceGitlabReleaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+	project := d.Get("project").(string)
+	tagName := d.Get("tag_name").(string)
+
+	log.Printf("[DEBUG] read release project/tagName: %s/%s", project, tagName)
+	release, _, err := api.GetRelease(ctx, client, project, tagName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.BuildTwoPartID(&project, &tagName))
+	stateMap := gitlabReleaseToStateMap(project, release, release.Assets.Links)
+	if err := setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -45,7 +45,7 @@ var _ = registerDataSource("gitlab_release_links", func() *schema.Resource {
 })
 
 func dataSourceGitlabReleaseLinksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project := d.Get("project").(string)
 	tagName := d.Get("tag_name").(string)
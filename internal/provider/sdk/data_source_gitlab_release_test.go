@@ -0,0 +1,37 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccDataGitlabRelease_basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+	releases := testutil.CreateReleases(t, project, 1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "gitlab_release" "this" {
+					project  = "%s"
+					tag_name = "%s"
+				}`, project.PathWithNamespace, releases[0].TagName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_release.this", "name", releases[0].Name),
+					resource.TestCheckResourceAttr("data.gitlab_release.this", "commit_sha", releases[0].Commit.ID),
+					resource.TestCheckResourceAttrSet("data.gitlab_release.this", "released_at"),
+					resource.TestCheckResourceAttr("data.gitlab_release.this", "links.#", "2"),
+				),
+			},
+		},
+	})
+}
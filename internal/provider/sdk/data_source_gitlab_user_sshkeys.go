@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 var _ = registerDataSource("gitlab_user_sshkeys", func() *schema.Resource {
@@ -50,7 +52,7 @@ var _ = registerDataSource("gitlab_user_sshkeys", func() *schema.Resource {
 })
 
 func dataSourceGitlabUserKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	log.Printf("[INFO] Reading Gitlab user")
 
 	options := gitlab.ListSSHKeysForUserOptions{
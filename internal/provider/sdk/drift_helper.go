@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// driftDetector detects the attributes of an audited resource which changed outside of Terraform in its Read function.
+// It must be created before the refreshed attributes are set.
+type driftDetector struct {
+	d          *schema.ResourceData
+	attributes []string
+	previous   map[string]interface{}
+}
+
+// newDriftDetector remembers the state of the attributes before they are refreshed.
+// The drift is only detected on a refresh, not when the resource is read after it's created, updated or imported.
+func newDriftDetector(d *schema.ResourceData, attributes ...string) *driftDetector {
+	detector := &driftDetector{d: d, attributes: attributes, previous: make(map[string]interface{})}
+
+	state := d.GetRawState()
+	if !d.GetRawPlan().IsNull() || state.IsNull() {
+		return detector
+	}
+	for _, attribute := range attributes {
+		if !state.GetAttr(attribute).IsNull() {
+			detector.previous[attribute] = d.Get(attribute)
+		}
+	}
+	return detector
+}
+
+// diagnostics returns a warning naming the author of the most recent change of the resource,
+// if the provider is configured with `drift_report` and any of the attributes changed.
+func (dd *driftDetector) diagnostics(ctx context.Context, data *api.ProviderData, resourceType string, resource api.AuditedResource) diag.Diagnostics {
+	if !data.DriftReport || dd.d.Id() == "" {
+		return nil
+	}
+
+	changed := dd.changedAttributes()
+	if len(changed) == 0 {
+		return nil
+	}
+
+	summary, detail := api.DriftWarning(ctx, data, resource, resourceType, changed)
+	return diag.Diagnostics{{Severity: diag.Warning, Summary: summary, Detail: detail}}
+}
+
+// changedAttributes returns the attributes whose refreshed value differs from the value remembered before the refresh.
+func (dd *driftDetector) changedAttributes() []string {
+	var changed []string
+	for _, attribute := range dd.attributes {
+		if previous, ok := dd.previous[attribute]; ok && !driftValuesEqual(previous, dd.d.Get(attribute)) {
+			changed = append(changed, attribute)
+		}
+	}
+	return changed
+}
+
+// driftValuesEqual compares the values of an attribute, sets are compared by their elements.
+func driftValuesEqual(previous, refreshed interface{}) bool {
+	if previousSet, ok := previous.(*schema.Set); ok {
+		if refreshedSet, ok := refreshed.(*schema.Set); ok {
+			return previousSet.Equal(refreshedSet)
+		}
+	}
+	return reflect.DeepEqual(previous, refreshed)
+}
@@ -0,0 +1,98 @@
+package sdk
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var driftTestResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"access_level": {Type: schema.TypeString, Optional: true},
+		"labels":       {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	},
+}
+
+func driftTestState(accessLevel cty.Value, labels cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"id":           cty.StringVal("42"),
+		"access_level": accessLevel,
+		"labels":       labels,
+	})
+}
+
+func TestDriftDetector_changedAttributes(t *testing.T) {
+	stateAttributes := map[string]string{
+		"id":           "42",
+		"access_level": "developer",
+		"labels.#":     "2",
+		"labels.0":     "bug",
+		"labels.1":     "feature",
+	}
+	refreshedState := driftTestState(cty.StringVal("developer"), cty.SetVal([]cty.Value{cty.StringVal("bug"), cty.StringVal("feature")}))
+
+	cases := []struct {
+		name        string
+		state       *terraform.InstanceState
+		accessLevel string
+		labels      []interface{}
+		expected    []string
+	}{
+		{
+			name:        "refresh without changes",
+			state:       &terraform.InstanceState{ID: "42", Attributes: stateAttributes, RawState: refreshedState},
+			accessLevel: "developer",
+			labels:      []interface{}{"feature", "bug"},
+		},
+		{
+			name:        "refresh with changes",
+			state:       &terraform.InstanceState{ID: "42", Attributes: stateAttributes, RawState: refreshedState},
+			accessLevel: "maintainer",
+			labels:      []interface{}{"bug"},
+			expected:    []string{"access_level", "labels"},
+		},
+		{
+			name:        "read after create",
+			state:       &terraform.InstanceState{ID: "42", Attributes: stateAttributes, RawPlan: refreshedState},
+			accessLevel: "maintainer",
+			labels:      []interface{}{"bug"},
+		},
+		{
+			name:        "read after update",
+			state:       &terraform.InstanceState{ID: "42", Attributes: stateAttributes, RawState: refreshedState, RawPlan: refreshedState},
+			accessLevel: "maintainer",
+			labels:      []interface{}{"bug"},
+		},
+		{
+			name: "read after import",
+			state: &terraform.InstanceState{
+				ID:         "42",
+				Attributes: map[string]string{"id": "42"},
+				RawState:   driftTestState(cty.NullVal(cty.String), cty.NullVal(cty.Set(cty.String))),
+			},
+			accessLevel: "maintainer",
+			labels:      []interface{}{"bug"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := driftTestResource.Data(tc.state)
+			detector := newDriftDetector(d, "access_level", "labels")
+
+			if err := d.Set("access_level", tc.accessLevel); err != nil {
+				t.Fatal(err)
+			}
+			if err := d.Set("labels", tc.labels); err != nil {
+				t.Fatal(err)
+			}
+
+			if changed := detector.changedAttributes(); !reflect.DeepEqual(changed, tc.expected) {
+				t.Errorf("expected the changed attributes %v, got %v", tc.expected, changed)
+			}
+		})
+	}
+}
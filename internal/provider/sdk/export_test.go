@@ -0,0 +1,11 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import "github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+// SetProviderServerV6 replaces the factory of the provider server for the acceptance tests.
+func SetProviderServerV6(factory func() (tfprotov6.ProviderServer, error)) {
+	newProviderServerV6 = factory
+}
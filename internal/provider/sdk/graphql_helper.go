@@ -1,26 +1,28 @@
 package sdk
 
 import (
-	"context"
+	"errors"
 
-	"github.com/xanzy/go-gitlab"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
-// Helper method for modifying client requests appropriately for sending a GraphQL call instead of a REST call.
-func SendGraphQLRequest(ctx context.Context, client *gitlab.Client, query GraphQLQuery, response interface{}) (interface{}, error) {
-	request, err := client.NewRequest("POST", "", query, nil)
-	if err != nil {
-		return nil, err
-	}
-	// Overwrite the path of the existing request, as otherwise the go-gitlab client appends /api/v4 instead.
-	request.URL.Path = "/api/graphql"
-	if _, err = client.Do(request, response); err != nil {
-		return nil, err
+// graphQLErrorToDiagnostics converts an error returned by api.SendGraphQLRequest into diagnostics.
+// Each entry of the GraphQL `errors` array becomes its own diagnostic, including the path of the failed field.
+func graphQLErrorToDiagnostics(err error) diag.Diagnostics {
+	var graphQLErrors api.GraphQLErrors
+	if !errors.As(err, &graphQLErrors) {
+		return diag.FromErr(err)
 	}
-	return response, nil
-}
 
-// Represents a GraphQL call to the API. All GraphQL calls are a string passed to the "query" parameter, so they should be included here.
-type GraphQLQuery struct {
-	Query string `json:"query"`
+	var diags diag.Diagnostics
+	for _, e := range graphQLErrors {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "GraphQL request failed",
+			Detail:   e.Error(),
+		})
+	}
+	return diags
 }
@@ -5,26 +5,53 @@ package sdk
 
 import (
 	"context"
-	"log"
 	"testing"
 
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
 )
 
 func TestAcc_GraphQL_basic(t *testing.T) {
-
-	query := GraphQLQuery{
-		`query {currentUser {name, bot, gitpodEnabled, groupCount, id, namespace{id}, publicEmail, username}}`,
+	request := api.GraphQLRequest{
+		Query: `query {currentUser {name, bot, gitpodEnabled, groupCount, id, namespace{id}, publicEmail, username}}`,
 	}
 
 	var response CurrentUserResponse
-	_, err := SendGraphQLRequest(context.Background(), testutil.TestGitlabClient, query, &response)
-	if err != nil {
-		log.Println(err)
-		t.Fail()
+	if err := api.SendGraphQLRequest(context.Background(), testutil.TestGitlabClient, request, &response); err != nil {
+		t.Fatal(err)
+	}
+
+	if response.CurrentUser.Name != "Administrator" {
+		t.Fatalf("got current user %q, expected %q", response.CurrentUser.Name, "Administrator")
+	}
+}
+
+func TestAcc_GraphQL_variables(t *testing.T) {
+	request := api.GraphQLRequest{
+		Query:         `query user($username: String!) {user(username: $username) {id, username}}`,
+		OperationName: "user",
+		Variables:     map[string]interface{}{"username": "root"},
+	}
+
+	var response struct {
+		User GraphQLUser `json:"user"`
+	}
+	if err := api.SendGraphQLRequest(context.Background(), testutil.TestGitlabClient, request, &response); err != nil {
+		t.Fatal(err)
+	}
+
+	if response.User.ID != api.BuildGlobalID("User", 1) {
+		t.Fatalf("got user %q, expected %q", response.User.ID, api.BuildGlobalID("User", 1))
+	}
+}
+
+func TestAcc_GraphQL_errors(t *testing.T) {
+	request := api.GraphQLRequest{
+		Query: `query {currentUser {doesNotExist}}`,
 	}
 
-	if response.Data.CurrentUser.Name != "Administrator" {
-		t.Fail()
+	err := api.SendGraphQLRequest(context.Background(), testutil.TestGitlabClient, request, nil)
+	if diags := graphQLErrorToDiagnostics(err); !diags.HasError() {
+		t.Fatalf("expected the GraphQL errors to be converted to diagnostics, got: %v", err)
 	}
 }
@@ -0,0 +1,58 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func testAccCheckGitlabGroupExists(n string, group *gitlab.Group) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		groupID := rs.Primary.ID
+		if groupID == "" {
+			return fmt.Errorf("No group ID is set")
+		}
+
+		gotGroup, _, err := testutil.TestGitlabClient.Groups.GetGroup(groupID, nil)
+		if err != nil {
+			return err
+		}
+		*group = *gotGroup
+		return nil
+	}
+}
+
+func testAccCheckGitlabGroupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group" {
+			continue
+		}
+
+		group, _, err := testutil.TestGitlabClient.Groups.GetGroup(rs.Primary.ID, nil)
+		if err == nil {
+			if group != nil && fmt.Sprintf("%d", group.ID) == rs.Primary.ID {
+				if group.MarkedForDeletionOn == nil {
+					return fmt.Errorf("Group still exists")
+				}
+			}
+		}
+		if !api.Is404(err) {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -53,6 +54,16 @@ func New(version string) func() *schema.Provider {
 					Description: "The OAuth2 Token, Project, Group, Personal Access Token or CI Job Token used to connect to GitLab. The OAuth method is used in this provider for authentication (using Bearer authorization token). See https://docs.gitlab.com/ee/api/#authentication for details. It may be sourced from the `GITLAB_TOKEN` environment variable.",
 					Sensitive:   true,
 				},
+				"token_command": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "A command which outputs the token used to connect to GitLab, e.g. to read it from a secrets manager. The command is run with `sh -c` (`cmd /C` on Windows) when the provider is configured and again whenever the GitLab API rejects the token with a `401`, which allows rotating the token without restarting Terraform. Leading and trailing whitespace is removed from the output. Cannot be combined with `token`, `token_file` or `oauth`, the `GITLAB_TOKEN` environment variable is ignored when this is set.",
+				},
+				"token_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to a file which contains the token used to connect to GitLab. The file is read when the provider is configured and again whenever the GitLab API rejects the token with a `401`, which allows rotating the token without restarting Terraform. Leading and trailing whitespace is removed from the content. Cannot be combined with `token`, `token_command` or `oauth`, the `GITLAB_TOKEN` environment variable is ignored when this is set.",
+				},
 				"base_url": {
 					Type:        schema.TypeString,
 					Optional:    true,
@@ -83,6 +94,139 @@ func New(version string) func() *schema.Provider {
 					Optional:    true,
 					Description: "(Experimental) By default the provider does a dummy request to get the current user in order to verify that the provider configuration is correct and the GitLab API is reachable. Set this to `false` to skip this check. This may be useful if the GitLab instance does not yet exist and is created within the same terraform module. This is an experimental feature and may change in the future. Please make sure to always keep backups of your state.",
 				},
+				"proxy_url": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The URL of the proxy used for all requests to the GitLab instance, e.g. `http://proxy.example.com:3128`. By default, the proxy is configured with the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				},
+				"headers": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Additional headers which are added to all requests to the GitLab instance, e.g. the headers required by an identity-aware proxy in front of it.",
+				},
+				"request_timeout": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The maximum time a single request to the GitLab instance may take, including reading the response body, given as duration string like `30s` or `1m`. Retried requests are limited individually. By default, requests don't time out.",
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  "The maximum number of requests per second sent to the GitLab instance. Requests exceeding the limit are delayed. This is useful to not trigger the rate limits of the GitLab instance with large configurations. By default, requests are not limited.",
+				},
+				"max_concurrent_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The maximum number of concurrent requests sent to the GitLab instance. By default, the number of concurrent requests is only limited by the parallelism of Terraform.",
+				},
+				"drift_report": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "When set to true, resources which changed outside of Terraform are reported with a warning naming the author and time of the most recent change, taken from the audit events of their project or group. It's supported by `gitlab_branch_protection`, `gitlab_project_membership`, `gitlab_group_membership` and `gitlab_project_protected_environment`. The audit events API requires GitLab Premium. Defaults to `false`.",
+				},
+				"retry": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configures how requests to the GitLab API are retried on rate limiting (`429`) and transient server errors (`502`, `503` and `504`). The `Retry-After` and `RateLimit-Reset` response headers are honoured when present. If not set, the default retry behavior of the underlying GitLab client is used.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The maximum number of attempts for a single request, including the initial one. Defaults to `5`.",
+							},
+							"min_backoff": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The minimum time to wait between attempts, given as duration string like `500ms` or `2s`. Defaults to `1s`.",
+							},
+							"max_backoff": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The maximum time to wait between attempts when the response does not include a `Retry-After` or `RateLimit-Reset` header, given as duration string like `30s` or `1m`. Defaults to `30s`.",
+							},
+						},
+					},
+				},
+				"ci_job": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configures the provider to authenticate as the GitLab CI job it runs in. The `base_url` defaults to the `CI_API_V4_URL` of the job. If `token_exchange_url` is set, the OIDC id_token of the job is exchanged for a short-lived access token, otherwise the `CI_JOB_TOKEN` is used, which can only access a few API endpoints, see https://docs.gitlab.com/ee/ci/jobs/ci_job_token.html. Cannot be combined with `token`, the `GITLAB_TOKEN` environment variable is ignored when this block is set.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"token_exchange_url": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The URL of an OAuth 2.0 Token Exchange (RFC 8693) endpoint which exchanges the OIDC id_token of the job for a short-lived GitLab access token.",
+							},
+							"id_token_variable": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The name of the environment variable which contains the OIDC id_token of the job, as configured with the `id_tokens` keyword in the CI configuration. Defaults to the deprecated `CI_JOB_JWT_V2` and `CI_JOB_JWT` variables.",
+							},
+							"audience": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The audience of the requested access token, sent to the token exchange endpoint.",
+							},
+						},
+					},
+				},
+				"oauth": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configures the provider to authenticate with OAuth2 access tokens obtained from the token endpoint of the GitLab instance. The access tokens are refreshed transparently when they expire. The `refresh_token` grant is used if `refresh_token` is set, the `password` grant if `username` and `password` are set and the `client_credentials` grant otherwise. Cannot be combined with `token`, the `GITLAB_TOKEN` environment variable is ignored when this block is set.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"token_url": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The OAuth2 token endpoint. Defaults to the `/oauth/token` endpoint of the GitLab instance configured with `base_url`.",
+							},
+							"client_id": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The OAuth2 client ID of the GitLab application.",
+							},
+							"client_secret": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "The OAuth2 client secret of the GitLab application.",
+							},
+							"scopes": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "The OAuth2 scopes to request for the access tokens, e.g. `api`.",
+							},
+							"refresh_token": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "The OAuth2 refresh token used to obtain access tokens with the `refresh_token` grant.",
+							},
+							"username": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The username used to obtain access tokens with the `password` grant.",
+							},
+							"password": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "The password used to obtain access tokens with the `password` grant.",
+							},
+						},
+					},
+				},
 			},
 
 			DataSourcesMap: resourceFactoriesToMap(allDataSources),
@@ -105,8 +249,40 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			ClientCert:    d.Get("client_cert").(string),
 			ClientKey:     d.Get("client_key").(string),
 			EarlyAuthFail: d.Get("early_auth_check").(bool),
+
+			TokenCommand: d.Get("token_command").(string),
+			TokenFile:    d.Get("token_file").(string),
+
+			ProxyURL: d.Get("proxy_url").(string),
+
+			RequestsPerSecond:     d.Get("requests_per_second").(float64),
+			MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+
+			DriftReport: d.Get("drift_report").(bool),
+		}
+		if v, ok := d.GetOk("oauth"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			oauth := v.([]interface{})[0].(map[string]interface{})
+			config.OAuth = &api.OAuthConfig{
+				TokenURL:     oauth["token_url"].(string),
+				ClientID:     oauth["client_id"].(string),
+				ClientSecret: oauth["client_secret"].(string),
+				Scopes:       *stringListToStringSlice(oauth["scopes"].([]interface{})),
+				RefreshToken: oauth["refresh_token"].(string),
+				Username:     oauth["username"].(string),
+				Password:     oauth["password"].(string),
+			}
 		}
-		if _, ok := d.GetOk("token"); !ok {
+		if v, ok := d.GetOk("ci_job"); ok && len(v.([]interface{})) > 0 {
+			config.CIJob = &api.CIJobConfig{}
+			if ciJob, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+				config.CIJob.TokenExchangeURL = ciJob["token_exchange_url"].(string)
+				config.CIJob.IDTokenVariable = ciJob["id_token_variable"].(string)
+				config.CIJob.Audience = ciJob["audience"].(string)
+			}
+		}
+		// The GITLAB_TOKEN environment variable is only used if no other authentication method is configured.
+		// Conflicting authentication methods are rejected by NewGitLabClient, like in the framework provider.
+		if _, ok := d.GetOk("token"); !ok && config.OAuth == nil && config.CIJob == nil && config.TokenCommand == "" && config.TokenFile == "" {
 			config.Token = os.Getenv("GITLAB_TOKEN")
 		}
 		if _, ok := d.GetOk("base_url"); !ok {
@@ -118,18 +294,41 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			config.EarlyAuthFail = true
 		}
 
+		if v, ok := d.GetOk("headers"); ok {
+			config.Headers = make(map[string]string)
+			for k, v := range v.(map[string]interface{}) {
+				config.Headers[k] = v.(string)
+			}
+		}
+		if v, ok := d.GetOk("request_timeout"); ok {
+			requestTimeout, err := api.ParseRequestTimeout(v.(string))
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			config.RequestTimeout = requestTimeout
+		}
+
+		if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			retry := v.([]interface{})[0].(map[string]interface{})
+			retryConfig, err := api.NewRetryConfig(retry["max_attempts"].(int), retry["min_backoff"].(string), retry["max_backoff"].(string))
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			config.Retry = retryConfig
+		}
+
 		// Configure our logger masking
 		ctx = utils.ApplyLogMaskingToContext(ctx)
 
-		gitlabClient, err := config.NewGitLabClient(ctx)
+		providerData, err := config.NewProviderData(ctx)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
 		userAgent := p.UserAgent("terraform-provider-gitlab", version)
-		gitlabClient.UserAgent = userAgent
+		providerData.Client.UserAgent = userAgent
 
-		return gitlabClient, nil
+		return providerData, nil
 	}
 }
 
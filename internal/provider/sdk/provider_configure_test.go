@@ -0,0 +1,28 @@
+package sdk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestProvider_configureRejectsMultipleAuthMethods(t *testing.T) {
+	p := New("test")()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"base_url": "https://gitlab.example.com/api/v4/",
+		"oauth": []interface{}{
+			map[string]interface{}{"client_id": "client"},
+		},
+		"ci_job": []interface{}{
+			map[string]interface{}{"audience": "gitlab"},
+		},
+	}))
+	if !diags.HasError() {
+		t.Fatal("expected an error for the oauth and ci_job blocks being configured together")
+	}
+	if !strings.Contains(diags[0].Summary, "Only one of") {
+		t.Fatalf("expected the conflicting authentication methods to be reported, got: %s", diags[0].Summary)
+	}
+}
@@ -0,0 +1,26 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/sdk"
+)
+
+// The acceptance tests of the SDK resources use resources which are implemented with the plugin framework,
+// thus they need the muxed provider server. The provider package can't be imported by the sdk package itself.
+func init() {
+	sdk.SetProviderServerV6(func() (tfprotov6.ProviderServer, error) {
+		providerServer, err := provider.NewMuxedProviderServer(context.Background(), "test")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create mux provider server for testing: %v", err)
+		}
+		return providerServer(), nil
+	})
+}
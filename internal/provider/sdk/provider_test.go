@@ -15,14 +15,17 @@ import (
 // to create a provider server to which the CLI can reattach.
 var providerFactoriesV6 = map[string]func() (tfprotov6.ProviderServer, error){
 	"gitlab": func() (tfprotov6.ProviderServer, error) {
-		provider, err := NewV6(context.Background(), "test")
-		if err != nil {
-			return nil, err
-		}
-		return provider, nil
+		return newProviderServerV6()
 	},
 }
 
+// newProviderServerV6 creates the provider server for the acceptance tests.
+// It's replaced by the muxed provider server in provider_mux_test.go, so that the tests
+// can use the resources which are implemented with the plugin framework, e.g. `gitlab_project`.
+var newProviderServerV6 = func() (tfprotov6.ProviderServer, error) {
+	return NewV6(context.Background(), "test")
+}
+
 func TestProvider(t *testing.T) {
 	t.Parallel()
 
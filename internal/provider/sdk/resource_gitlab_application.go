@@ -0,0 +1,143 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+var _ = registerResource("gitlab_application", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_application`" + ` resource allows to manage the lifecycle of an instance-wide OAuth application.
+
+-> This resource requires administration privileges.
+
+~> The GitLab API doesn't support updating applications, so changing any of the attributes recreates the application with a new client ID and secret.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/applications.html)`,
+
+		CreateContext: resourceGitlabApplicationCreate,
+		ReadContext:   resourceGitlabApplicationRead,
+		UpdateContext: resourceGitlabApplicationUpdate,
+		DeleteContext: resourceGitlabApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: gitlabApplicationScopesCustomizeDiff,
+		Schema:        gitlabApplicationGetSchema(),
+	}
+})
+
+func resourceGitlabApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+
+	scopes := *stringSetToStringSlice(d.Get("scopes").(*schema.Set))
+	options := &gitlab.CreateApplicationOptions{
+		Name:         gitlab.String(d.Get("name").(string)),
+		RedirectURI:  gitlab.String(d.Get("redirect_url").(string)),
+		Scopes:       gitlab.String(strings.Join(scopes, " ")),
+		Confidential: gitlab.Bool(d.Get("confidential").(bool)),
+	}
+
+	log.Printf("[DEBUG] create gitlab application %s (scopes: %s)", *options.Name, *options.Scopes)
+	application, _, err := client.Applications.CreateApplication(options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(application.ID))
+	// the secret is only returned on creation, hence it's not set in the read.
+	d.Set("secret", application.Secret)
+
+	return resourceGitlabApplicationRead(ctx, d, meta)
+}
+
+func resourceGitlabApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+
+	applicationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("%s cannot be converted to int", d.Id())
+	}
+
+	log.Printf("[DEBUG] read gitlab application %d", applicationID)
+	application, err := findGitlabApplication(ctx, client, applicationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if application == nil {
+		log.Printf("[DEBUG] gitlab application %d not found, removing from state", applicationID)
+		d.SetId("")
+		return nil
+	}
+
+	stateMap := gitlabApplicationToStateMap(application)
+	if err = setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceGitlabApplicationUpdate only stores the configured scopes of an imported application in the state,
+// because the GitLab API doesn't support updating applications and all other changes recreate the application.
+func resourceGitlabApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] store the scopes of the imported gitlab application %s", d.Id())
+	return resourceGitlabApplicationRead(ctx, d, meta)
+}
+
+func resourceGitlabApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+
+	applicationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("%s cannot be converted to int", d.Id())
+	}
+
+	log.Printf("[DEBUG] delete gitlab application %d", applicationID)
+	if _, err = client.Applications.DeleteApplication(applicationID, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// findGitlabApplication returns the application with the given ID, or nil if it doesn't exist.
+// The GitLab API doesn't support getting a single application, so all applications are listed.
+func findGitlabApplication(ctx context.Context, client *gitlab.Client, applicationID int) (*gitlab.Application, error) {
+	applications, err := listAllGitlabApplications(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	for _, application := range applications {
+		if application.ID == applicationID {
+			return application, nil
+		}
+	}
+	return nil, nil
+}
+
+func listAllGitlabApplications(ctx context.Context, client *gitlab.Client) ([]*gitlab.Application, error) {
+	options := &gitlab.ListApplicationsOptions{
+		Page:    1,
+		PerPage: 100,
+	}
+
+	var applications []*gitlab.Application
+	for options.Page != 0 {
+		paginatedApplications, resp, err := client.Applications.ListApplications(options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		applications = append(applications, paginatedApplications...)
+		options.Page = resp.NextPage
+	}
+	return applications, nil
+}
@@ -0,0 +1,126 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabApplication_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("acctest")
+	var applicationID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_application" "this" {
+					name         = "%s"
+					redirect_url = "https://example.com/callback"
+					scopes       = ["openid", "read_user"]
+				}`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_application.this", "application_id"),
+					resource.TestCheckResourceAttrSet("gitlab_application.this", "secret"),
+					resource.TestCheckResourceAttr("gitlab_application.this", "confidential", "true"),
+					func(s *terraform.State) error {
+						applicationID = s.RootModule().Resources["gitlab_application.this"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// verify import, the scopes and the secret cannot be read from the GitLab API
+				ResourceName:       "gitlab_application.this",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported application, got %d", len(states))
+					}
+					attributes := states[0].Attributes
+					if attributes["name"] != name || attributes["redirect_url"] != "https://example.com/callback" || attributes["confidential"] != "true" || attributes["application_id"] == "" {
+						return fmt.Errorf("unexpected attributes of the imported application: %v", attributes)
+					}
+					if attributes["scopes.#"] != "" && attributes["scopes.#"] != "0" {
+						return fmt.Errorf("expected no scopes for the imported application, got %s", attributes["scopes.#"])
+					}
+					return nil
+				},
+			},
+			{
+				// the scopes missing from the state of the imported application are stored without recreating it
+				Config: fmt.Sprintf(`
+				resource "gitlab_application" "this" {
+					name         = "%s"
+					redirect_url = "https://example.com/callback"
+					scopes       = ["openid", "read_user"]
+				}`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_application.this", "scopes.#", "2"),
+					resource.TestCheckResourceAttrPtr("gitlab_application.this", "id", &applicationID),
+				),
+			},
+			{
+				// changes of the stored scopes are detected
+				Config: fmt.Sprintf(`
+				resource "gitlab_application" "this" {
+					name         = "%s"
+					redirect_url = "https://example.com/callback"
+					scopes       = ["openid"]
+				}`, name),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// changing the application recreates it
+				Config: fmt.Sprintf(`
+				resource "gitlab_application" "this" {
+					name         = "%s-updated"
+					redirect_url = "https://example.com/callback"
+					scopes       = ["api"]
+					confidential = false
+				}`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_application.this", "name", name+"-updated"),
+					resource.TestCheckResourceAttr("gitlab_application.this", "confidential", "false"),
+					resource.TestCheckResourceAttrSet("gitlab_application.this", "secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabApplicationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_application" {
+			continue
+		}
+		applicationID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		application, err := findGitlabApplication(context.Background(), testutil.TestGitlabClient, applicationID)
+		if err != nil {
+			return err
+		}
+		if application != nil {
+			return errors.New("Application still exists")
+		}
+	}
+	return nil
+}
@@ -152,7 +152,7 @@ var commitSchema = &schema.Resource{
 }
 
 func resourceGitlabBranchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	name := d.Get("name").(string)
 	project := d.Get("project").(string)
 	ref := d.Get("ref").(string)
@@ -160,6 +160,13 @@ func resourceGitlabBranchCreate(ctx context.Context, d *schema.ResourceData, met
 		Branch: &name, Ref: &ref,
 	}
 
+	// NOTE: creating a branch concurrently to commits to it may lead to ref update conflicts.
+	unlock, err := lockRepositoryBranch(ctx, client, project, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
 	log.Printf("[DEBUG] create gitlab branch %s for project %s with ref %s", name, project, ref)
 	branch, resp, err := client.Branches.CreateBranch(project, branchOptions, gitlab.WithContext(ctx))
 	if err != nil {
@@ -172,7 +179,7 @@ func resourceGitlabBranchCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceGitlabBranchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, name, err := utils.ParseTwoPartID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -207,11 +214,17 @@ func resourceGitlabBranchRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabBranchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, name, err := utils.ParseTwoPartID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	unlock, err := lockRepositoryBranch(ctx, client, project, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
 	log.Printf("[DEBUG] delete gitlab branch %s", name)
 	resp, err := client.Branches.DeleteBranch(project, name, gitlab.WithContext(ctx))
 	if err != nil {
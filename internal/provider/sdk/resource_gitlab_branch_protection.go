@@ -126,7 +126,7 @@ var _ = registerResource("gitlab_branch_protection", func() *schema.Resource {
 })
 
 func resourceGitlabBranchProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	branch := d.Get("branch").(string)
 
@@ -191,7 +191,7 @@ func resourceGitlabBranchProtectionCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabBranchProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, branch, err := projectAndBranchFromID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -207,6 +207,9 @@ func resourceGitlabBranchProtectionRead(ctx context.Context, d *schema.ResourceD
 		return nil
 	}
 
+	drift := newDriftDetector(d, "push_access_level", "merge_access_level", "unprotect_access_level", "allow_force_push",
+		"allowed_to_push", "allowed_to_merge", "allowed_to_unprotect", "code_owner_approval_required")
+
 	d.Set("project", project)
 	d.Set("branch", pb.Name)
 
@@ -251,14 +254,18 @@ func resourceGitlabBranchProtectionRead(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(utils.BuildTwoPartID(&project, &pb.Name))
 
-	return nil
+	return drift.diagnostics(ctx, meta.(*api.ProviderData), "gitlab_branch_protection", api.AuditedResource{
+		Project:       project,
+		TargetType:    "ProtectedBranch",
+		TargetDetails: pb.Name,
+	})
 }
 
 func resourceGitlabBranchProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// NOTE: At the time of writing, the only value that does not force re-creation is code_owner_approval_required,
 	// so therefore that is the only update that needs to be handled.
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	branch := d.Get("branch").(string)
 	codeOwnerApprovalRequired := d.Get("code_owner_approval_required").(bool)
@@ -291,7 +298,7 @@ func resourceGitlabBranchProtectionUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabBranchProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	branch := d.Get("branch").(string)
 
@@ -40,7 +40,7 @@ var _ = registerResource("gitlab_cluster_agent", func() *schema.Resource {
 })
 
 func resourceGitlabClusterAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project := d.Get("project").(string)
 	options := gitlab.RegisterAgentOptions{
@@ -58,7 +58,7 @@ func resourceGitlabClusterAgentCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabClusterAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, agentID, err := resourceGitlabClusterAgentParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -83,7 +83,7 @@ func resourceGitlabClusterAgentRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabClusterAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, agentID, err := resourceGitlabClusterAgentParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
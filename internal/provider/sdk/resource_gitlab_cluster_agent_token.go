@@ -35,7 +35,7 @@ var _ = registerResource("gitlab_cluster_agent_token", func() *schema.Resource {
 })
 
 func resourceGitlabClusterAgentTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project := d.Get("project").(string)
 	agentID := d.Get("agent_id").(int)
@@ -60,7 +60,7 @@ func resourceGitlabClusterAgentTokenCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabClusterAgentTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, agentID, tokenID, err := resourceGitlabClusterAgentTokenParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -85,7 +85,7 @@ func resourceGitlabClusterAgentTokenRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabClusterAgentTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, agentID, tokenID, err := resourceGitlabClusterAgentTokenParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -62,7 +62,7 @@ var _ = registerResource("gitlab_deploy_key", func() *schema.Resource {
 })
 
 func resourceGitlabDeployKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	options := &gitlab.AddDeployKeyOptions{
 		Title:   gitlab.String(d.Get("title").(string)),
@@ -83,7 +83,7 @@ func resourceGitlabDeployKeyCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabDeployKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 
 	deployKeyID, err := strconv.Atoi(d.Id())
@@ -111,7 +111,7 @@ func resourceGitlabDeployKeyRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceGitlabDeployKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 
 	deployKeyID, err := strconv.Atoi(d.Id())
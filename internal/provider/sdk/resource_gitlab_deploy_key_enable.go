@@ -63,7 +63,7 @@ var _ = registerResource("gitlab_deploy_key_enable", func() *schema.Resource {
 })
 
 func resourceGitlabDeployKeyEnableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 
 	key_id, err := strconv.Atoi(d.Get("key_id").(string))
@@ -92,7 +92,7 @@ func resourceGitlabDeployKeyEnableCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabDeployKeyEnableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project, deployKeyID, err := resourceGitLabDeployKeyEnableParseId(d.Id())
 	if err != nil {
@@ -121,7 +121,7 @@ func resourceGitlabDeployKeyEnableRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabDeployKeyEnableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project, deployKeyID, err := resourceGitLabDeployKeyEnableParseId(d.Id())
 	if err != nil {
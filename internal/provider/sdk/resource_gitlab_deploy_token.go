@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 )
 
 var _ = registerResource("gitlab_deploy_token", func() *schema.Resource {
@@ -102,7 +104,7 @@ func expiresAtSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 }
 
 func resourceGitlabDeployTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, isProject := d.GetOk("project")
 	group, isGroup := d.GetOk("group")
 
@@ -160,7 +162,7 @@ func resourceGitlabDeployTokenCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabDeployTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, isProject := d.GetOk("project")
 	group, isGroup := d.GetOk("group")
 	deployTokenID, err := strconv.Atoi(d.Id())
@@ -230,7 +232,7 @@ func resourceGitlabDeployTokenRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabDeployTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, isProject := d.GetOk("project")
 	group, isGroup := d.GetOk("group")
 	deployTokenID, err := strconv.Atoi(d.Id())
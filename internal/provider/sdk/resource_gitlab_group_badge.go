@@ -58,7 +58,7 @@ var _ = registerResource("gitlab_group_badge", func() *schema.Resource {
 })
 
 func resourceGitlabGroupBadgeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	groupID := d.Get("group").(string)
 	options := &gitlab.AddGroupBadgeOptions{
 		LinkURL:  gitlab.String(d.Get("link_url").(string)),
@@ -80,7 +80,7 @@ func resourceGitlabGroupBadgeCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabGroupBadgeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	ids := strings.Split(d.Id(), ":")
 	groupID := ids[0]
 	badgeID, err := strconv.Atoi(ids[1])
@@ -105,7 +105,7 @@ func resourceGitlabGroupBadgeRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitlabGroupBadgeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	ids := strings.Split(d.Id(), ":")
 	groupID := ids[0]
 	badgeID, err := strconv.Atoi(ids[1])
@@ -129,7 +129,7 @@ func resourceGitlabGroupBadgeUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabGroupBadgeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	ids := strings.Split(d.Id(), ":")
 	groupID := ids[0]
 	badgeID, err := strconv.Atoi(ids[1])
@@ -125,7 +125,7 @@ var _ = registerResource("gitlab_group_cluster", func() *schema.Resource {
 })
 
 func resourceGitlabGroupClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group := d.Get("group").(string)
 
 	pk := gitlab.AddGroupPlatformKubernetesOptions{
@@ -175,7 +175,7 @@ func resourceGitlabGroupClusterCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabGroupClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	group, clusterId, err := groupIdAndClusterIdFromId(d.Id())
 	if err != nil {
@@ -217,7 +217,7 @@ func resourceGitlabGroupClusterRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabGroupClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	group, clusterId, err := groupIdAndClusterIdFromId(d.Id())
 	if err != nil {
@@ -272,7 +272,7 @@ func resourceGitlabGroupClusterUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabGroupClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group, clusterId, err := groupIdAndClusterIdFromId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
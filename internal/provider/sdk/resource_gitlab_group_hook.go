@@ -24,14 +24,14 @@ var _ = registerResource("gitlab_group_hook", func() *schema.Resource {
 		UpdateContext: resourceGitlabGroupHookUpdate,
 		DeleteContext: resourceGitlabGroupHookDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateTwoPartIDWithLookup(lookupGroupID, api.LookupGroupHookID),
 		},
 		Schema: gitlabGroupHookSchema(),
 	}
 })
 
 func resourceGitlabGroupHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group := d.Get("group").(string)
 	options := &gitlab.AddGroupHookOptions{
 		URL:                      gitlab.String(d.Get("url").(string)),
@@ -76,7 +76,7 @@ func resourceGitlabGroupHookRead(ctx context.Context, d *schema.ResourceData, me
 	}
 	log.Printf("[DEBUG] read gitlab group hook %s/%d", group, hookID)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	hook, _, err := client.Groups.GetGroupHook(group, hookID, gitlab.WithContext(ctx))
 	if err != nil {
 		if api.Is404(err) {
@@ -100,7 +100,7 @@ func resourceGitlabGroupHookUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	options := &gitlab.EditGroupHookOptions{
 		URL:                      gitlab.String(d.Get("url").(string)),
 		PushEvents:               gitlab.Bool(d.Get("push_events").(bool)),
@@ -141,7 +141,7 @@ func resourceGitlabGroupHookDelete(ctx context.Context, d *schema.ResourceData,
 	}
 	log.Printf("[DEBUG] Delete gitlab group hook %s/%d", group, hookID)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	_, err = client.Groups.DeleteGroupHook(group, hookID, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
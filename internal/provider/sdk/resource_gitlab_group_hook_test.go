@@ -27,10 +27,10 @@ func TestAccGitlabGroupHook_basic(t *testing.T) {
 			{
 				Config: fmt.Sprintf(`
 					resource "gitlab_group_hook" "this" {
-						group = "%s"
+						group = "%d"
 						url = "http://example.com"
 					}
-				`, testGroup.FullPath),
+				`, testGroup.ID),
 			},
 			// Verify Import
 			{
@@ -43,7 +43,7 @@ func TestAccGitlabGroupHook_basic(t *testing.T) {
 			{
 				Config: fmt.Sprintf(`
 					resource "gitlab_group_hook" "this" {
-						group = "%s"
+						group = "%d"
 						url = "http://example.com"
 
 						token                      = "supersecret"
@@ -63,7 +63,7 @@ func TestAccGitlabGroupHook_basic(t *testing.T) {
 						releases_events            = true
 						subgroup_events            = true
 					}
-				`, testGroup.FullPath),
+				`, testGroup.ID),
 			},
 			// Verify Import
 			{
@@ -76,10 +76,10 @@ func TestAccGitlabGroupHook_basic(t *testing.T) {
 			{
 				Config: fmt.Sprintf(`
 					resource "gitlab_group_hook" "this" {
-						group = "%s"
+						group = "%d"
 						url = "http://example.com"
 					}
-				`, testGroup.FullPath),
+				`, testGroup.ID),
 			},
 			// Verify Import
 			{
@@ -88,6 +88,14 @@ func TestAccGitlabGroupHook_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"token"},
 			},
+			// Verify Import by the full path of the group and the hook URL
+			{
+				ResourceName:            "gitlab_group_hook.this",
+				ImportStateId:           fmt.Sprintf("%s:http://example.com", testGroup.FullPath),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
 		},
 	})
 }
@@ -54,7 +54,7 @@ var _ = registerResource("gitlab_group_label", func() *schema.Resource {
 })
 
 func resourceGitlabGroupLabelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group := d.Get("group").(string)
 	options := &gitlab.CreateGroupLabelOptions{
 		Name:  gitlab.String(d.Get("name").(string)),
@@ -77,7 +77,7 @@ func resourceGitlabGroupLabelCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabGroupLabelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group := d.Get("group").(string)
 	labelName := d.Id()
 	log.Printf("[DEBUG] read gitlab group label %s/%s", group, labelName)
@@ -98,7 +98,7 @@ func resourceGitlabGroupLabelRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitlabGroupLabelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group := d.Get("group").(string)
 	options := &gitlab.UpdateGroupLabelOptions{
 		Name:  gitlab.String(d.Get("name").(string)),
@@ -120,7 +120,7 @@ func resourceGitlabGroupLabelUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabGroupLabelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group := d.Get("group").(string)
 	log.Printf("[DEBUG] Delete gitlab group label %s", d.Id())
 	options := &gitlab.DeleteGroupLabelOptions{
@@ -132,7 +132,7 @@ func resourceGitlabGroupLabelDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabGroupLabelImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	parts := strings.SplitN(d.Id(), ":", 2)
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("invalid label id (should be <group ID>:<label name>): %s", d.Id())
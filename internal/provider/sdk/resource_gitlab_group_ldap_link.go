@@ -76,7 +76,7 @@ var _ = registerResource("gitlab_group_ldap_link", func() *schema.Resource {
 })
 
 func resourceGitlabGroupLdapLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	groupId := d.Get("group_id").(string)
 	cn := d.Get("cn").(string)
@@ -117,7 +117,7 @@ func resourceGitlabGroupLdapLinkCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabGroupLdapLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	groupId := d.Get("group_id").(string)
 
 	// Try to fetch all group links from GitLab
@@ -163,7 +163,7 @@ func resourceGitlabGroupLdapLinkRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabGroupLdapLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	groupId := d.Get("group_id").(string)
 	cn := d.Get("cn").(string)
 	ldap_provider := d.Get("ldap_provider").(string)
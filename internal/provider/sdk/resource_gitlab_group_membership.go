@@ -28,7 +28,7 @@ var _ = registerResource("gitlab_group_membership", func() *schema.Resource {
 		UpdateContext: resourceGitlabGroupMembershipUpdate,
 		DeleteContext: resourceGitlabGroupMembershipDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateTwoPartIDWithLookup(lookupGroupID, lookupUserID),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,7 +73,7 @@ var _ = registerResource("gitlab_group_membership", func() *schema.Resource {
 })
 
 func resourceGitlabGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	userId := d.Get("user_id").(int)
 	groupId := d.Get("group_id").(string)
@@ -97,7 +97,7 @@ func resourceGitlabGroupMembershipCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	id := d.Id()
 	log.Printf("[DEBUG] read gitlab group groupMember %s", id)
 
@@ -116,8 +116,13 @@ func resourceGitlabGroupMembershipRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	drift := newDriftDetector(d, "access_level", "expires_at")
 	resourceGitlabGroupMembershipSetToState(d, groupMember, &groupId)
-	return nil
+	return drift.diagnostics(ctx, meta.(*api.ProviderData), "gitlab_group_membership", api.AuditedResource{
+		Group:      groupId,
+		TargetType: "User",
+		TargetID:   strconv.Itoa(userId),
+	})
 }
 
 func groupIdAndUserIdFromId(id string) (string, int, error) {
@@ -133,7 +138,7 @@ func groupIdAndUserIdFromId(id string) (string, int, error) {
 }
 
 func resourceGitlabGroupMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	userId := d.Get("user_id").(int)
 	groupId := d.Get("group_id").(string)
@@ -155,7 +160,7 @@ func resourceGitlabGroupMembershipUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	id := d.Id()
 	groupId, userId, err := groupIdAndUserIdFromId(id)
@@ -50,6 +50,18 @@ func TestAccGitlabGroupMembership_basic(t *testing.T) {
 					accessLevel: "developer",
 				})),
 			},
+
+			// Verify import by the group path and the username
+			{
+				ResourceName:      "gitlab_group_membership.foo",
+				ImportStateId:     fmt.Sprintf("foo%d:listest%d", rInt, rInt),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"skip_subresources_on_destroy",
+					"unassign_issuables_on_destroy",
+				},
+			},
 		},
 	})
 }
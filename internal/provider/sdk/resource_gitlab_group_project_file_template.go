@@ -55,7 +55,7 @@ For more information about which file types are available as templates, view
 })
 
 func resourceGitLabGroupProjectFileTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	groupID := d.Get("group_id").(int)
 	group, _, err := client.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
@@ -80,7 +80,7 @@ func resourceGitLabGroupProjectFileTemplateRead(ctx context.Context, d *schema.R
 }
 
 func resourceGitLabGroupProjectFileTemplateCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	groupID := d.Get("group_id").(int)
 	projectID := gitlab.Int(d.Get("file_template_project_id").(int))
@@ -99,7 +99,7 @@ func resourceGitLabGroupProjectFileTemplateCreateOrUpdate(ctx context.Context, d
 }
 
 func resourceGitLabGroupProjectFileTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	groupID := d.Get("group_id").(int)
 	options := &gitlab.UpdateGroupOptions{}
 
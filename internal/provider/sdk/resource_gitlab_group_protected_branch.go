@@ -0,0 +1,267 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerResource("gitlab_group_protected_branch", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_protected_branch`" + ` resource allows to manage the lifecycle of a protected branch of a group.
+The protection applies to the branches of all projects in the group and its subgroups.
+
+-> This resource requires a GitLab Premium or Ultimate instance and a top-level group.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_protected_branches.html)`,
+
+		CreateContext: resourceGitlabGroupProtectedBranchCreate,
+		ReadContext:   resourceGitlabGroupProtectedBranchRead,
+		UpdateContext: resourceGitlabGroupProtectedBranchUpdate,
+		DeleteContext: resourceGitlabGroupProtectedBranchDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeDiffRequireFeatureForResource("group_protected_branches", "gitlab_group_protected_branch"),
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"branch": {
+				Description: "Name of the branch or wildcard.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"merge_access_level": {
+				Description:      fmt.Sprintf("Access levels allowed to merge. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidProtectedBranchTagAccessLevelNames)),
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidProtectedBranchTagAccessLevelNames, false)),
+				Optional:         true,
+				Default:          api.AccessLevelValueToName[gitlab.MaintainerPermissions],
+			},
+			"push_access_level": {
+				Description:      fmt.Sprintf("Access levels allowed to push. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidProtectedBranchTagAccessLevelNames)),
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidProtectedBranchTagAccessLevelNames, false)),
+				Optional:         true,
+				Default:          api.AccessLevelValueToName[gitlab.MaintainerPermissions],
+			},
+			"unprotect_access_level": {
+				Description:      fmt.Sprintf("Access levels allowed to unprotect. Valid values are: %s.", utils.RenderValueListForDocs(api.ValidProtectedBranchUnprotectAccessLevelNames)),
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(api.ValidProtectedBranchUnprotectAccessLevelNames, false)),
+				Optional:         true,
+				Default:          api.AccessLevelValueToName[gitlab.MaintainerPermissions],
+			},
+			"allow_force_push": {
+				Description: "Can be set to true to allow users with push access to force push.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"allowed_to_push":      schemaAllowedTo(),
+			"allowed_to_merge":     schemaAllowedTo(),
+			"allowed_to_unprotect": schemaAllowedTo(),
+			"code_owner_approval_required": {
+				Description: "Can be set to true to require code owner approval before merging.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"branch_protection_id": {
+				Description: "The ID of the branch protection (not the branch name).",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabGroupProtectedBranchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+	group := d.Get("group").(string)
+	branch := d.Get("branch").(string)
+
+	log.Printf("[DEBUG] create gitlab group protected branch %q for group %s", branch, group)
+
+	mergeAccessLevel := api.AccessLevelNameToValue[d.Get("merge_access_level").(string)]
+	pushAccessLevel := api.AccessLevelNameToValue[d.Get("push_access_level").(string)]
+	unprotectAccessLevel := api.AccessLevelNameToValue[d.Get("unprotect_access_level").(string)]
+
+	allowForcePush := d.Get("allow_force_push").(bool)
+	codeOwnerApprovalRequired := d.Get("code_owner_approval_required").(bool)
+
+	allowedToPush := expandBranchPermissionOptions(d.Get("allowed_to_push").(*schema.Set).List())
+	allowedToMerge := expandBranchPermissionOptions(d.Get("allowed_to_merge").(*schema.Set).List())
+	allowedToUnprotect := expandBranchPermissionOptions(d.Get("allowed_to_unprotect").(*schema.Set).List())
+
+	pb, _, err := api.ProtectGroupBranch(ctx, client, group, &gitlab.ProtectRepositoryBranchesOptions{
+		Name:                      &branch,
+		PushAccessLevel:           &pushAccessLevel,
+		MergeAccessLevel:          &mergeAccessLevel,
+		UnprotectAccessLevel:      &unprotectAccessLevel,
+		AllowForcePush:            &allowForcePush,
+		AllowedToPush:             &allowedToPush,
+		AllowedToMerge:            &allowedToMerge,
+		AllowedToUnprotect:        &allowedToUnprotect,
+		CodeOwnerApprovalRequired: &codeOwnerApprovalRequired,
+	})
+	if err != nil {
+		if api.Is404(err) {
+			return diag.Errorf("error protecting branch %q on group %q, group protected branches require a top-level group: %v", branch, group, err)
+		}
+		return diag.Errorf("error protecting branch %q on group %q: %v", branch, group, err)
+	}
+
+	d.SetId(utils.BuildTwoPartID(&group, &pb.Name))
+
+	return resourceGitlabGroupProtectedBranchRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupProtectedBranchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+	group, branch, err := utils.ParseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab group protected branch %s for group %s", branch, group)
+
+	pb, _, err := api.GetGroupProtectedBranch(ctx, client, group, branch)
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] gitlab group protected branch %s for group %s not found, removing from state", branch, group)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading protected branch %q of group %q: %v", branch, group, err)
+	}
+
+	drift := newDriftDetector(d, "push_access_level", "merge_access_level", "unprotect_access_level", "allow_force_push",
+		"allowed_to_push", "allowed_to_merge", "allowed_to_unprotect", "code_owner_approval_required")
+
+	d.Set("group", group)
+	d.Set("branch", pb.Name)
+
+	if pushAccessLevel, err := firstValidAccessLevel(pb.PushAccessLevels); err == nil {
+		if err := d.Set("push_access_level", api.AccessLevelValueToName[*pushAccessLevel]); err != nil {
+			return diag.Errorf("error setting push_access_level: %v", err)
+		}
+	}
+
+	if mergeAccessLevel, err := firstValidAccessLevel(pb.MergeAccessLevels); err == nil {
+		if err := d.Set("merge_access_level", api.AccessLevelValueToName[*mergeAccessLevel]); err != nil {
+			return diag.Errorf("error setting merge_access_level: %v", err)
+		}
+	}
+
+	if unprotectAccessLevel, err := firstValidAccessLevel(pb.UnprotectAccessLevels); err == nil {
+		if err := d.Set("unprotect_access_level", api.AccessLevelValueToName[*unprotectAccessLevel]); err != nil {
+			return diag.Errorf("error setting unprotect_access_level: %v", err)
+		}
+	}
+
+	if err := d.Set("allow_force_push", pb.AllowForcePush); err != nil {
+		return diag.Errorf("error setting allow_force_push: %v", err)
+	}
+
+	if err := d.Set("allowed_to_push", flattenNonZeroBranchAccessDescriptions(pb.PushAccessLevels)); err != nil {
+		return diag.Errorf("error setting allowed_to_push: %v", err)
+	}
+	if err := d.Set("allowed_to_merge", flattenNonZeroBranchAccessDescriptions(pb.MergeAccessLevels)); err != nil {
+		return diag.Errorf("error setting allowed_to_merge: %v", err)
+	}
+	if err := d.Set("allowed_to_unprotect", flattenNonZeroBranchAccessDescriptions(pb.UnprotectAccessLevels)); err != nil {
+		return diag.Errorf("error setting allowed_to_unprotect: %v", err)
+	}
+
+	if err := d.Set("code_owner_approval_required", pb.CodeOwnerApprovalRequired); err != nil {
+		return diag.Errorf("error setting code_owner_approval_required: %v", err)
+	}
+
+	d.Set("branch_protection_id", pb.ID)
+
+	return drift.diagnostics(ctx, meta.(*api.ProviderData), "gitlab_group_protected_branch", api.AuditedResource{
+		Group:         group,
+		TargetType:    "ProtectedBranch",
+		TargetDetails: pb.Name,
+	})
+}
+
+func resourceGitlabGroupProtectedBranchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+	group := d.Get("group").(string)
+	branch := d.Get("branch").(string)
+
+	log.Printf("[DEBUG] update gitlab group protected branch %s for group %s", branch, group)
+
+	options := &api.UpdateGroupProtectedBranchOptions{}
+	if d.HasChange("allow_force_push") {
+		options.AllowForcePush = gitlab.Bool(d.Get("allow_force_push").(bool))
+	}
+	if d.HasChange("code_owner_approval_required") {
+		options.CodeOwnerApprovalRequired = gitlab.Bool(d.Get("code_owner_approval_required").(bool))
+	}
+
+	// The access levels can't be changed directly, instead the current ones are destroyed and the new ones added.
+	if d.HasChanges("push_access_level", "merge_access_level", "unprotect_access_level") {
+		levels, _, err := api.GetGroupProtectedBranchAccessLevels(ctx, client, group, branch)
+		if err != nil {
+			return diag.Errorf("error reading protected branch %q of group %q: %v", branch, group, err)
+		}
+		if d.HasChange("push_access_level") {
+			options.AllowedToPush = groupProtectedBranchAccessLevelOptions(levels.PushAccessLevels, d.Get("push_access_level").(string))
+		}
+		if d.HasChange("merge_access_level") {
+			options.AllowedToMerge = groupProtectedBranchAccessLevelOptions(levels.MergeAccessLevels, d.Get("merge_access_level").(string))
+		}
+		if d.HasChange("unprotect_access_level") {
+			options.AllowedToUnprotect = groupProtectedBranchAccessLevelOptions(levels.UnprotectAccessLevels, d.Get("unprotect_access_level").(string))
+		}
+	}
+
+	if _, _, err := api.UpdateGroupProtectedBranch(ctx, client, group, branch, options); err != nil {
+		return diag.Errorf("error updating protected branch %q of group %q: %v", branch, group, err)
+	}
+
+	return resourceGitlabGroupProtectedBranchRead(ctx, d, meta)
+}
+
+// groupProtectedBranchAccessLevelOptions returns the options to replace the access levels of a protected branch,
+// which aren't granted to a specific user or group, with the given access level.
+func groupProtectedBranchAccessLevelOptions(current []*api.ProtectedBranchAccessLevel, accessLevelName string) []*api.ProtectedBranchAccessOptions {
+	var options []*api.ProtectedBranchAccessOptions
+	for _, level := range current {
+		if level.UserID != 0 || level.GroupID != 0 {
+			continue
+		}
+		options = append(options, &api.ProtectedBranchAccessOptions{ID: gitlab.Int(level.ID), Destroy: gitlab.Bool(true)})
+	}
+	accessLevel := api.AccessLevelNameToValue[accessLevelName]
+	return append(options, &api.ProtectedBranchAccessOptions{AccessLevel: &accessLevel})
+}
+
+func resourceGitlabGroupProtectedBranchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+	group := d.Get("group").(string)
+	branch := d.Get("branch").(string)
+
+	log.Printf("[DEBUG] delete gitlab group protected branch %s for group %s", branch, group)
+
+	if _, err := api.UnprotectGroupBranch(ctx, client, group, branch); err != nil && !api.Is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabGroupProtectedBranch_basic(t *testing.T) {
+	testutil.SkipIfCE(t)
+
+	group := testutil.CreateGroups(t, 1)[0]
+	subgroup := testutil.CreateSubGroups(t, group, 1)[0]
+	var protectionID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabGroupProtectedBranchDestroy(group.ID, "main"),
+		Steps: []resource.TestStep{
+			// Create a group protected branch with default options
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_group_protected_branch" "this" {
+					group  = %d
+					branch = "main"
+				}`, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_protected_branch.this", "push_access_level", "maintainer"),
+					resource.TestCheckResourceAttr("gitlab_group_protected_branch.this", "merge_access_level", "maintainer"),
+					resource.TestCheckResourceAttrSet("gitlab_group_protected_branch.this", "branch_protection_id"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_protected_branch.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Replace the protection with a subgroup allowed to push
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_group_protected_branch" "this" {
+					group              = %d
+					branch             = "main"
+					push_access_level  = "no one"
+					merge_access_level = "developer"
+					allow_force_push   = true
+
+					allowed_to_push {
+						group_id = %d
+					}
+				}`, group.ID, subgroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_protected_branch.this", "push_access_level", "no one"),
+					resource.TestCheckResourceAttr("gitlab_group_protected_branch.this", "allowed_to_push.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_group_protected_branch.this", "allow_force_push", "true"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_protected_branch.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Require code owner approval in-place
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_group_protected_branch" "this" {
+					group                        = %d
+					branch                       = "main"
+					push_access_level            = "no one"
+					merge_access_level           = "developer"
+					allow_force_push             = true
+					code_owner_approval_required = true
+
+					allowed_to_push {
+						group_id = %d
+					}
+				}`, group.ID, subgroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_protected_branch.this", "code_owner_approval_required", "true"),
+					testAccCaptureGitlabGroupProtectedBranchID(&protectionID),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_protected_branch.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the access levels and force push in-place
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_group_protected_branch" "this" {
+					group                        = %d
+					branch                       = "main"
+					push_access_level            = "developer"
+					merge_access_level           = "maintainer"
+					unprotect_access_level       = "developer"
+					allow_force_push             = false
+					code_owner_approval_required = true
+
+					allowed_to_push {
+						group_id = %d
+					}
+				}`, group.ID, subgroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_protected_branch.this", "push_access_level", "developer"),
+					resource.TestCheckResourceAttr("gitlab_group_protected_branch.this", "merge_access_level", "maintainer"),
+					resource.TestCheckResourceAttr("gitlab_group_protected_branch.this", "unprotect_access_level", "developer"),
+					resource.TestCheckResourceAttr("gitlab_group_protected_branch.this", "allow_force_push", "false"),
+					resource.TestCheckResourceAttr("gitlab_group_protected_branch.this", "allowed_to_push.#", "1"),
+					resource.TestCheckResourceAttrPtr("gitlab_group_protected_branch.this", "branch_protection_id", &protectionID),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_protected_branch.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCaptureGitlabGroupProtectedBranchID remembers the ID of the branch protection to check that later steps don't re-create it.
+func testAccCaptureGitlabGroupProtectedBranchID(id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["gitlab_group_protected_branch.this"]
+		if !ok {
+			return errors.New("gitlab_group_protected_branch.this not found")
+		}
+		*id = rs.Primary.Attributes["branch_protection_id"]
+		return nil
+	}
+}
+
+func testAccCheckGitlabGroupProtectedBranchDestroy(groupID int, branch string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, _, err := api.GetGroupProtectedBranch(context.Background(), testutil.TestGitlabClient, fmt.Sprint(groupID), branch)
+		if err == nil {
+			return errors.New("branch is still protected")
+		}
+		if !api.Is404(err) {
+			return fmt.Errorf("unable to get group protected branch: %w", err)
+		}
+		return nil
+	}
+}
+
+func TestAccGitlabGroupProtectedBranch_requiresPremium(t *testing.T) {
+	group := testutil.CreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: testutil.IsRunningInEE,
+				Config: fmt.Sprintf(`
+				resource "gitlab_group_protected_branch" "this" {
+					group  = "%d"
+					branch = "main"
+				}`, group.ID),
+				ExpectError: regexp.MustCompile(`resource "gitlab_group_protected_branch" requires GitLab >= 15.9 / Premium`),
+			},
+		},
+	})
+}
@@ -59,7 +59,7 @@ var _ = registerResource("gitlab_group_saml_link", func() *schema.Resource {
 })
 
 func resourceGitlabGroupSamlLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	group := d.Get("group").(string)
 	samlGroupName := d.Get("saml_group_name").(string)
@@ -81,7 +81,7 @@ func resourceGitlabGroupSamlLinkCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabGroupSamlLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group, samlGroupName, parse_err := utils.ParseTwoPartID(d.Id())
 	if parse_err != nil {
 		return diag.FromErr(parse_err)
@@ -107,7 +107,7 @@ func resourceGitlabGroupSamlLinkRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabGroupSamlLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group, samlGroupName, parse_err := utils.ParseTwoPartID(d.Id())
 	if parse_err != nil {
 		return diag.FromErr(parse_err)
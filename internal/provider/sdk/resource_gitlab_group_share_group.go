@@ -26,7 +26,7 @@ var _ = registerResource("gitlab_group_share_group", func() *schema.Resource {
 		ReadContext:   resourceGitlabGroupShareGroupRead,
 		DeleteContext: resourceGitlabGroupShareGroupDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateTwoPartIDWithLookup(lookupGroupID, lookupGroupID),
 		},
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -69,7 +69,7 @@ func resourceGitlabGroupShareGroupCreate(ctx context.Context, d *schema.Resource
 		ExpiresAt:   gitlab.String(d.Get("expires_at").(string)),
 	}
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	log.Printf("[DEBUG] create gitlab group share for %d in %s", shareGroupId, groupId)
 
 	_, _, err := client.GroupMembers.ShareWithGroup(groupId, options, gitlab.WithContext(ctx))
@@ -84,7 +84,7 @@ func resourceGitlabGroupShareGroupCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabGroupShareGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	id := d.Id()
 	log.Printf("[DEBUG] read gitlab shared groups %s", id)
 
@@ -129,7 +129,7 @@ func resourceGitlabGroupShareGroupRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabGroupShareGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	id := d.Id()
 
 	groupId, sharedGroupId, err := groupIdsFromId(id)
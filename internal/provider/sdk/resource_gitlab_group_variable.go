@@ -32,7 +32,7 @@ var _ = registerResource("gitlab_group_variable", func() *schema.Resource {
 })
 
 func resourceGitlabGroupVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
@@ -64,7 +64,7 @@ func resourceGitlabGroupVariableCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabGroupVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	group, key, err := utils.ParseTwoPartID(d.Id())
 	if err != nil {
@@ -103,7 +103,7 @@ func resourceGitlabGroupVariableRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabGroupVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
@@ -136,7 +136,7 @@ func resourceGitlabGroupVariableUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabGroupVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
 	environmentScope := d.Get("environment_scope").(string)
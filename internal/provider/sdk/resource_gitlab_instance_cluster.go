@@ -123,7 +123,7 @@ var _ = registerResource("gitlab_instance_cluster", func() *schema.Resource {
 })
 
 func resourceGitlabInstanceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	pk := gitlab.AddPlatformKubernetesOptions{
 		APIURL: gitlab.String(d.Get("kubernetes_api_url").(string)),
@@ -172,7 +172,7 @@ func resourceGitlabInstanceClusterCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabInstanceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	clusterId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -214,7 +214,7 @@ func resourceGitlabInstanceClusterRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabInstanceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	clusterId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -273,7 +273,7 @@ func resourceGitlabInstanceClusterUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabInstanceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	clusterId, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -29,7 +29,7 @@ var _ = registerResource("gitlab_instance_variable", func() *schema.Resource {
 })
 
 func resourceGitlabInstanceVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
@@ -56,7 +56,7 @@ func resourceGitlabInstanceVariableCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabInstanceVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	key := d.Id()
 
@@ -86,7 +86,7 @@ func resourceGitlabInstanceVariableRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabInstanceVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
@@ -110,7 +110,7 @@ func resourceGitlabInstanceVariableUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabInstanceVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	key := d.Get("key").(string)
 	log.Printf("[DEBUG] Delete gitlab instance level CI variable %s", key)
 
@@ -65,10 +65,10 @@ var _ = registerResource("gitlab_managed_license", func() *schema.Resource {
 })
 
 func resourceGitlabManagedLicenseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 
-	approvalStatus, err := stringToApprovalStatus(ctx, client, d.Get("approval_status").(string))
+	approvalStatus, err := stringToApprovalStatus(ctx, meta.(*api.ProviderData).Capabilities, d.Get("approval_status").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -92,7 +92,7 @@ func resourceGitlabManagedLicenseCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabManagedLicenseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, licenseId, err := projectIdAndLicenseIdFromId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -108,7 +108,7 @@ func resourceGitlabManagedLicenseDelete(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabManagedLicenseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, licenseId, err := projectIdAndLicenseIdFromId(d.Id())
 	if err != nil {
 		diag.FromErr(err)
@@ -133,13 +133,13 @@ func resourceGitlabManagedLicenseRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabManagedLicenseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, licenseId, err := projectIdAndLicenseIdFromId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	approvalStatus, err := stringToApprovalStatus(ctx, client, d.Get("approval_status").(string))
+	approvalStatus, err := stringToApprovalStatus(ctx, meta.(*api.ProviderData).Capabilities, d.Get("approval_status").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -161,9 +161,9 @@ func resourceGitlabManagedLicenseUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 // Convert the incoming string into the proper constant value for passing into the API.
-func stringToApprovalStatus(ctx context.Context, client *gitlab.Client, s string) (*gitlab.LicenseApprovalStatusValue, error) {
+func stringToApprovalStatus(ctx context.Context, capabilities *api.Capabilities, s string) (*gitlab.LicenseApprovalStatusValue, error) {
 	var value gitlab.LicenseApprovalStatusValue
-	notSupported, err := api.IsGitLabVersionAtLeast(ctx, client, "15.0")()
+	notSupported, err := capabilities.IsVersionAtLeast(ctx, "15.0")
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch GitLab version: %+v", err)
 	}
@@ -121,7 +121,7 @@ func testAccCheckGitlabManagedLicenseStatus(resource string, status string, lice
 		}
 
 		for _, gotLicense := range licenses {
-			approvalStatus, err := stringToApprovalStatus(context.TODO(), testutil.TestGitlabClient, status)
+			approvalStatus, err := stringToApprovalStatus(context.TODO(), api.NewCapabilities(testutil.TestGitlabClient), status)
 			if err != nil {
 				return err
 			}
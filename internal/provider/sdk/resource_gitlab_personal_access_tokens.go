@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
 )
 
@@ -96,7 +97,7 @@ var _ = registerResource("gitlab_personal_access_token", func() *schema.Resource
 })
 
 func resourceGitlabPersonalAccessTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	currentUserAdmin, err := isCurrentUserAdmin(ctx, client)
 	if err != nil {
@@ -137,7 +138,7 @@ func resourceGitlabPersonalAccessTokenCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceGitlabPersonalAccessTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	userID, tokenID, err := resourceGitLabPersonalAccessTokenParseId(d.Id())
 	if err != nil {
@@ -175,7 +176,7 @@ func resourceGitlabPersonalAccessTokenRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabPersonalAccessTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	_, tokenID, err := resourceGitLabPersonalAccessTokenParseId(d.Id())
 	if err != nil {
@@ -99,7 +99,7 @@ var _ = registerResource("gitlab_project_access_token", func() *schema.Resource
 })
 
 func resourceGitlabProjectAccessTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	accessLevelId := api.AccessLevelNameToValue[d.Get("access_level").(string)]
 	project := d.Get("project").(string)
 
@@ -138,7 +138,7 @@ func resourceGitlabProjectAccessTokenRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("Error parsing ID: %s", d.Id())
 	}
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	projectAccessTokenID, err := strconv.Atoi(PATstring)
 	if err != nil {
@@ -180,7 +180,7 @@ func resourceGitlabProjectAccessTokenDelete(ctx context.Context, d *schema.Resou
 		return diag.Errorf("Error parsing ID: %s", d.Id())
 	}
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	projectAccessTokenID, err := strconv.Atoi(patString)
 	if err != nil {
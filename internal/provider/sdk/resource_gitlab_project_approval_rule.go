@@ -97,7 +97,7 @@ var _ = registerResource("gitlab_project_approval_rule", func() *schema.Resource
 })
 
 func resourceGitlabProjectApprovalRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project := d.Get("project").(string)
 
@@ -185,7 +185,7 @@ func resourceGitlabProjectApprovalRuleRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	rule, _, err := client.Projects.GetProjectApprovalRule(projectID, ruleID, gitlab.WithContext(ctx))
 	if err != nil {
@@ -238,7 +238,7 @@ func resourceGitlabProjectApprovalRuleUpdate(ctx context.Context, d *schema.Reso
 
 	tflog.Debug(ctx, `Updating gitlab project-level rule`, map[string]interface{}{"project": projectID, "options": options})
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	_, _, err = client.Projects.UpdateProjectApprovalRule(projectID, ruleIDInt, &options, gitlab.WithContext(ctx))
 	if err != nil {
@@ -261,7 +261,7 @@ func resourceGitlabProjectApprovalRuleDelete(ctx context.Context, d *schema.Reso
 
 	tflog.Debug(ctx, `Deleting gitlab project-level rule`, map[string]interface{}{"ruleId": ruleIDInt, "project": project})
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	_, err = client.Projects.DeleteProjectApprovalRule(project, ruleIDInt, gitlab.WithContext(ctx))
 	if err != nil {
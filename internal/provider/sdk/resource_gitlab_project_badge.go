@@ -64,7 +64,7 @@ var _ = registerResource("gitlab_project_badge", func() *schema.Resource {
 })
 
 func resourceGitlabProjectBadgeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	projectID := d.Get("project").(string)
 	options := &gitlab.AddProjectBadgeOptions{
 		LinkURL:  gitlab.String(d.Get("link_url").(string)),
@@ -87,7 +87,7 @@ func resourceGitlabProjectBadgeCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabProjectBadgeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	projectID, badgeID, err := resourceGitlabProjectBadgeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -110,7 +110,7 @@ func resourceGitlabProjectBadgeRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabProjectBadgeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	projectID, badgeID, err := resourceGitlabProjectBadgeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -133,7 +133,7 @@ func resourceGitlabProjectBadgeUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabProjectBadgeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	projectID, badgeID, err := resourceGitlabProjectBadgeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -130,7 +130,7 @@ var _ = registerResource("gitlab_project_cluster", func() *schema.Resource {
 })
 
 func resourceGitlabProjectClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 
 	pk := gitlab.AddPlatformKubernetesOptions{
@@ -184,7 +184,7 @@ func resourceGitlabProjectClusterCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabProjectClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project, clusterId, err := projectIdAndClusterIdFromId(d.Id())
 	if err != nil {
@@ -227,7 +227,7 @@ func resourceGitlabProjectClusterRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabProjectClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project, clusterId, err := projectIdAndClusterIdFromId(d.Id())
 	if err != nil {
@@ -286,7 +286,7 @@ func resourceGitlabProjectClusterUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabProjectClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, clusterId, err := projectIdAndClusterIdFromId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
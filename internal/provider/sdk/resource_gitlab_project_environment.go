@@ -96,7 +96,7 @@ func resourceGitlabProjectEnvironmentCreate(ctx context.Context, d *schema.Resou
 
 	log.Printf("[DEBUG] Project %s create gitlab environment %q", project, *options.Name)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	environment, _, err := client.Environments.CreateEnvironment(project, &options, gitlab.WithContext(ctx))
 	if err != nil {
@@ -121,7 +121,7 @@ func resourceGitlabProjectEnvironmentRead(ctx context.Context, d *schema.Resourc
 
 	log.Printf("[DEBUG] Project %s read gitlab environment %d", project, environmentID)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	environment, _, err := client.Environments.GetEnvironment(project, environmentID, gitlab.WithContext(ctx))
 	if err != nil {
@@ -163,7 +163,7 @@ func resourceGitlabProjectEnvironmentUpdate(ctx context.Context, d *schema.Resou
 
 	log.Printf("[DEBUG] Project %s update gitlab environment %d", project, environmentID)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	if _, _, err := client.Environments.EditEnvironment(project, environmentID, options, gitlab.WithContext(ctx)); err != nil {
 		return diag.Errorf("error editing gitlab project %s environment %d: %v", project, environmentID, err)
@@ -173,7 +173,7 @@ func resourceGitlabProjectEnvironmentUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceGitlabProjectEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, environmentID, err := resourceGitlabProjectEnvironmentParseID(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -64,7 +64,7 @@ func resourceGitlabProjectFreezePeriodCreate(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Project %s create gitlab project-level freeze period %+v", projectID, options)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	FreezePeriod, _, err := client.FreezePeriods.CreateFreezePeriodOptions(projectID, &options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
@@ -77,7 +77,7 @@ func resourceGitlabProjectFreezePeriodCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceGitlabProjectFreezePeriodRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	projectID, freezePeriodID, err := projectIDAndFreezePeriodIDFromID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -104,7 +104,7 @@ func resourceGitlabProjectFreezePeriodRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectFreezePeriodUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	projectID, freezePeriodID, err := projectIDAndFreezePeriodIDFromID(d.Id())
 	options := &gitlab.UpdateFreezePeriodOptions{}
 
@@ -135,7 +135,7 @@ func resourceGitlabProjectFreezePeriodUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceGitlabProjectFreezePeriodDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	projectID, freezePeriodID, err := projectIDAndFreezePeriodIDFromID(d.Id())
 	log.Printf("[DEBUG] Delete gitlab FreezePeriod %s", d.Id())
 
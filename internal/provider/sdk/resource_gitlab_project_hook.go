@@ -5,12 +5,12 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
 )
 
 var _ = registerResource("gitlab_project_hook", func() *schema.Resource {
@@ -31,7 +31,7 @@ var _ = registerResource("gitlab_project_hook", func() *schema.Resource {
 })
 
 func resourceGitlabProjectHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	options := &gitlab.AddProjectHookOptions{
 		URL:                      gitlab.String(d.Get("url").(string)),
@@ -69,7 +69,7 @@ func resourceGitlabProjectHookCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabProjectHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	hookId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -95,7 +95,7 @@ func resourceGitlabProjectHookRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabProjectHookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	hookId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -134,7 +134,7 @@ func resourceGitlabProjectHookUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabProjectHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	hookId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -151,12 +151,20 @@ func resourceGitlabProjectHookDelete(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabProjectHookStateImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	s := strings.Split(d.Id(), ":")
-	if len(s) != 2 {
+	client := meta.(*api.ProviderData).Client
+
+	project, id, err := utils.ParseTwoPartIDWithLookup(ctx, d.Id(),
+		func(ctx context.Context, project string) (string, error) {
+			return api.LookupProjectID(ctx, client, project)
+		},
+		func(ctx context.Context, project string, hook string) (string, error) {
+			return api.LookupProjectHookID(ctx, client, project, hook)
+		},
+	)
+	if err != nil {
 		d.SetId("")
-		return nil, fmt.Errorf("Invalid Project Hook import format; expected '{project_id}:{hook_id}'")
+		return nil, fmt.Errorf("Invalid Project Hook import ID %q; expected '{project}:{hook}': %w", d.Id(), err)
 	}
-	project, id := s[0], s[1]
 
 	d.SetId(id)
 	d.Set("project", project)
@@ -81,6 +81,20 @@ func TestAccGitlabProjectHook_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"token"},
 			},
+			// Verify import by the full path of the project and the hook URL
+			{
+				ResourceName: "gitlab_project_hook.foo",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					project, ok := s.RootModule().Resources["gitlab_project.foo"]
+					if !ok {
+						return "", fmt.Errorf("Not Found: gitlab_project.foo")
+					}
+					return fmt.Sprintf("%s:https://example.com/hook-%d", project.Primary.Attributes["path_with_namespace"], rInt), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
 		},
 	})
 }
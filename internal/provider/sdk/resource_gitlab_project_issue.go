@@ -53,7 +53,7 @@ var _ = registerResource("gitlab_project_issue", func() *schema.Resource {
 })
 
 func resourceGitlabProjectIssueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 
 	options := &gitlab.CreateIssueOptions{
@@ -129,7 +129,7 @@ func resourceGitlabProjectIssueCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabProjectIssueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, issueIID, err := resourceGitLabProjectIssueParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -153,7 +153,7 @@ func resourceGitlabProjectIssueRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabProjectIssueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, issueIID, err := resourceGitLabProjectIssueParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -214,7 +214,7 @@ func resourceGitlabProjectIssueUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabProjectIssueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, issueIID, err := resourceGitLabProjectIssueParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -34,7 +34,7 @@ var _ = registerResource("gitlab_project_issue_board", func() *schema.Resource {
 })
 
 func resourceGitlabProjectIssueBoardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project := d.Get("project").(string)
 	options := gitlab.CreateIssueBoardOptions{
@@ -82,7 +82,7 @@ func resourceGitlabProjectIssueBoardCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectIssueBoardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, issueBoardID, err := resourceGitlabProjectIssueBoardParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -107,7 +107,7 @@ func resourceGitlabProjectIssueBoardRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabProjectIssueBoardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, issueBoardID, err := resourceGitlabProjectIssueBoardParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -158,7 +158,7 @@ func resourceGitlabProjectIssueBoardUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectIssueBoardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, issueBoardID, err := resourceGitlabProjectIssueBoardParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -63,7 +63,7 @@ var _ = registerResource("gitlab_project_level_mr_approvals", func() *schema.Res
 })
 
 func resourceGitlabProjectLevelMRApprovalsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	projectId := d.Get("project_id").(int)
 
@@ -86,7 +86,7 @@ func resourceGitlabProjectLevelMRApprovalsCreate(ctx context.Context, d *schema.
 }
 
 func resourceGitlabProjectLevelMRApprovalsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	projectId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -116,7 +116,7 @@ func resourceGitlabProjectLevelMRApprovalsRead(ctx context.Context, d *schema.Re
 }
 
 func resourceGitlabProjectLevelMRApprovalsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	options := &gitlab.ChangeApprovalConfigurationOptions{}
 
 	projectId := d.Id()
@@ -146,7 +146,7 @@ func resourceGitlabProjectLevelMRApprovalsUpdate(ctx context.Context, d *schema.
 }
 
 func resourceGitlabProjectLevelMRApprovalsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	projectId := d.Id()
 
 	options := &gitlab.ChangeApprovalConfigurationOptions{
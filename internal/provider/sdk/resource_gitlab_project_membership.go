@@ -28,7 +28,7 @@ var _ = registerResource("gitlab_project_membership", func() *schema.Resource {
 		UpdateContext: resourceGitlabProjectMembershipUpdate,
 		DeleteContext: resourceGitlabProjectMembershipDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateTwoPartIDWithLookup(lookupProjectID, lookupUserID),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -61,7 +61,7 @@ var _ = registerResource("gitlab_project_membership", func() *schema.Resource {
 })
 
 func resourceGitlabProjectMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	userId := d.Get("user_id").(int)
 	projectId := d.Get("project_id").(string)
@@ -85,7 +85,7 @@ func resourceGitlabProjectMembershipCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	id := d.Id()
 	log.Printf("[DEBUG] read gitlab project projectMember %s", id)
 
@@ -104,8 +104,13 @@ func resourceGitlabProjectMembershipRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	drift := newDriftDetector(d, "access_level", "expires_at")
 	resourceGitlabProjectMembershipSetToState(d, projectMember, &projectId)
-	return nil
+	return drift.diagnostics(ctx, meta.(*api.ProviderData), "gitlab_project_membership", api.AuditedResource{
+		Project:    projectId,
+		TargetType: "User",
+		TargetID:   strconv.Itoa(userId),
+	})
 }
 
 func projectIdAndUserIdFromId(id string) (string, int, error) {
@@ -121,7 +126,7 @@ func projectIdAndUserIdFromId(id string) (string, int, error) {
 }
 
 func resourceGitlabProjectMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	userId := d.Get("user_id").(int)
 	projectId := d.Get("project_id").(string)
@@ -142,7 +147,7 @@ func resourceGitlabProjectMembershipUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	id := d.Id()
 	projectId, userId, err := projectIdAndUserIdFromId(id)
@@ -36,7 +36,7 @@ var _ = registerResource("gitlab_project_milestone", func() *schema.Resource {
 })
 
 func resourceGitlabProjectMilestoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	title := d.Get("title").(string)
 
@@ -84,7 +84,7 @@ func resourceGitlabProjectMilestoneCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabProjectMilestoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, milestoneID, err := resourceGitLabProjectMilestoneParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -110,7 +110,7 @@ func resourceGitlabProjectMilestoneRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabProjectMilestoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, milestoneID, err := resourceGitLabProjectMilestoneParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -154,7 +154,7 @@ func resourceGitlabProjectMilestoneUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabProjectMilestoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, milestoneID, err := resourceGitLabProjectMilestoneParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
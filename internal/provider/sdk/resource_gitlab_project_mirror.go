@@ -95,7 +95,7 @@ import_url, mirror, and mirror_trigger_builds properties on the gitlab_project r
 })
 
 func resourceGitlabProjectMirrorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	projectID := d.Get("project").(string)
 	URL := d.Get("url").(string)
@@ -124,7 +124,7 @@ func resourceGitlabProjectMirrorCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabProjectMirrorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	mirrorID := d.Get("mirror_id").(int)
 	projectID := d.Get("project").(string)
@@ -147,12 +147,12 @@ func resourceGitlabProjectMirrorUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabProjectMirrorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	mirrorID := d.Get("mirror_id").(int)
 	projectID := d.Get("project").(string)
 
-	isDeleteSupported, err := api.IsGitLabVersionAtLeast(ctx, client, "14.10")()
+	isDeleteSupported, err := meta.(*api.ProviderData).Capabilities.IsVersionAtLeast(ctx, "14.10")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -179,8 +179,6 @@ func resourceGitlabProjectMirrorDelete(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabProjectMirrorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-
 	ids := strings.Split(d.Id(), ":")
 	projectID := ids[0]
 	rawMirrorID := ids[1]
@@ -189,7 +187,7 @@ func resourceGitlabProjectMirrorRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] read gitlab project mirror %s id %v", projectID, mirrorID)
-	mirror, err := resourceGitLabProjectMirrorGetMirror(ctx, client, projectID, mirrorID)
+	mirror, err := resourceGitLabProjectMirrorGetMirror(ctx, meta.(*api.ProviderData), projectID, mirrorID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -213,8 +211,10 @@ func resourceGitlabProjectMirrorSetToState(d *schema.ResourceData, projectMirror
 	d.Set("url", projectMirror.URL)
 }
 
-func resourceGitLabProjectMirrorGetMirror(ctx context.Context, client *gitlab.Client, projectID string, mirrorID int) (*gitlab.ProjectMirror, error) {
-	isGetProjectMirrorSupported, err := api.IsGitLabVersionAtLeast(ctx, client, "14.10")()
+func resourceGitLabProjectMirrorGetMirror(ctx context.Context, data *api.ProviderData, projectID string, mirrorID int) (*gitlab.ProjectMirror, error) {
+	client := data.Client
+
+	isGetProjectMirrorSupported, err := data.Capabilities.IsVersionAtLeast(ctx, "14.10")
 	if err != nil {
 		return nil, err
 	}
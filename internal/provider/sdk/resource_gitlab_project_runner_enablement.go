@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
 )
 
@@ -41,7 +42,7 @@ var _ = registerResource("gitlab_project_runner_enablement", func() *schema.Reso
 })
 
 func resourceGitlabProjectRunnerEnablementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	projectID := d.Get("project").(string)
 	runnerID := d.Get("runner_id").(int)
 	options := &gitlab.EnableProjectRunnerOptions{
@@ -62,7 +63,7 @@ func resourceGitlabProjectRunnerEnablementCreate(ctx context.Context, d *schema.
 }
 
 func resourceGitlabProjectRunnerEnablementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, runnerID, err := projectAndRunnerFromID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -120,7 +121,7 @@ func projectAndRunnerFromID(id string) (string, int, error) {
 }
 
 func resourceGitlabProjectRunnerEnablementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	projectID, runnerID, err := projectAndRunnerFromID(d.Id())
 	if err != nil {
@@ -24,7 +24,7 @@ var _ = registerResource("gitlab_project_share_group", func() *schema.Resource {
 		ReadContext:   resourceGitlabProjectShareGroupRead,
 		DeleteContext: resourceGitlabProjectShareGroupDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateTwoPartIDWithLookup(lookupProjectID, lookupGroupID),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -70,7 +70,7 @@ var _ = registerResource("gitlab_project_share_group", func() *schema.Resource {
 })
 
 func resourceGitlabProjectShareGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	groupId := d.Get("group_id").(int)
 	projectId := d.Get("project_id").(string)
@@ -100,7 +100,7 @@ func resourceGitlabProjectShareGroupCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectShareGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	id := d.Id()
 	log.Printf("[DEBUG] read gitlab project projectMember %s", id)
 
@@ -151,7 +151,7 @@ func projectIdAndGroupIdFromId(id string) (string, int, error) {
 }
 
 func resourceGitlabProjectShareGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	id := d.Id()
 	projectId, groupId, err := projectIdAndGroupIdFromId(id)
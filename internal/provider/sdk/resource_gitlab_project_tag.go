@@ -44,7 +44,7 @@ var _ = registerResource("gitlab_project_tag", func() *schema.Resource {
 })
 
 func resourceGitlabProjectTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	name := d.Get("name").(string)
 	project := d.Get("project").(string)
 	ref := d.Get("ref").(string)
@@ -53,6 +53,13 @@ func resourceGitlabProjectTagCreate(ctx context.Context, d *schema.ResourceData,
 		TagName: &name, Ref: &ref, Message: &message,
 	}
 
+	// NOTE: creating a tag concurrently to commits to the ref it's created from may lead to ref update conflicts.
+	unlock, err := lockRepositoryBranch(ctx, client, project, ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
 	log.Printf("[DEBUG] create gitlab tag %s/%s with ref %s", project, name, ref)
 	_, resp, err := client.Tags.CreateTag(project, tagOptions, gitlab.WithContext(ctx))
 	if err != nil {
@@ -65,7 +72,7 @@ func resourceGitlabProjectTagCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabProjectTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, name, err := utils.ParseTwoPartID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -99,7 +106,7 @@ func resourceGitlabProjectTagRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitlabProjectTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, name, err := utils.ParseTwoPartID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
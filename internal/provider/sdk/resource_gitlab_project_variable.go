@@ -32,7 +32,7 @@ var _ = registerResource("gitlab_project_variable", func() *schema.Resource {
 })
 
 func resourceGitlabProjectVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
@@ -66,7 +66,7 @@ func resourceGitlabProjectVariableCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabProjectVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	var (
 		project          string
@@ -110,7 +110,7 @@ func resourceGitlabProjectVariableRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabProjectVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
@@ -138,7 +138,7 @@ func resourceGitlabProjectVariableUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabProjectVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
 	environmentScope := d.Get("environment_scope").(string)
@@ -177,6 +177,49 @@ EOF
 	})
 }
 
+func TestAccGitlabProjectVariable_recorded(t *testing.T) {
+	// The interactions are recorded in testdata/cassettes, so that the test can be replayed without a GitLab instance.
+	testutil.UseCassette(t)
+
+	testProject := testutil.CreateProject(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccGitlabProjectVariableCheckAllVariablesDestroyed(testProject),
+		Steps: []resource.TestStep{
+			// Create a project variable.
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variable" "foo" {
+  project = %d
+  key = "my_key"
+  value = "my_value"
+}
+`, testProject.ID),
+				Check: testAccCheckGitlabProjectVariableExists("gitlab_project_variable.foo"),
+			},
+			// Update its value and attributes.
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variable" "foo" {
+  project = %d
+  key = "my_key"
+  value = "my_value_2"
+  protected = true
+  variable_type = "file"
+}
+`, testProject.ID),
+				Check: testAccCheckGitlabProjectVariableExists("gitlab_project_variable.foo"),
+			},
+			{
+				ResourceName:      "gitlab_project_variable.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccGitlabProjectVariable_scoped(t *testing.T) {
 	testProject := testutil.CreateProject(t)
 
@@ -0,0 +1,281 @@
+package sdk
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerResource("gitlab_release", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_release`" + ` resource allows to manage the lifecycle of a release of a project.
+
+-> The asset links configured with ` + "`links`" + ` can be combined with ` + "`gitlab_release_link`" + ` resources for the same release,
+   as long as each link is only managed by one of them. The links of an imported release are only managed by this resource
+   once they are configured in ` + "`links`" + `.
+
+~> Destroying the release doesn't delete its tag, but it deletes all asset links of the release.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/releases/)`,
+
+		CreateContext: resourceGitlabReleaseCreate,
+		ReadContext:   resourceGitlabReleaseRead,
+		UpdateContext: resourceGitlabReleaseUpdate,
+		DeleteContext: resourceGitlabReleaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: gitlabReleaseGetSchema(),
+	}
+})
+
+func resourceGitlabReleaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+	project := d.Get("project").(string)
+	tagName := d.Get("tag_name").(string)
+
+	options := &gitlab.CreateReleaseOptions{
+		TagName: gitlab.String(tagName),
+	}
+	if ref, ok := d.GetOk("ref"); ok {
+		options.Ref = gitlab.String(ref.(string))
+	}
+	if name, ok := d.GetOk("name"); ok {
+		options.Name = gitlab.String(name.(string))
+	}
+	if description, ok := d.GetOk("description"); ok {
+		options.Description = gitlab.String(description.(string))
+	}
+	if milestones, ok := d.GetOk("milestones"); ok {
+		options.Milestones = stringSetToStringSlice(milestones.(*schema.Set))
+	}
+	if releasedAt, ok := d.GetOk("released_at"); ok {
+		parsedReleasedAt, err := time.Parse(time.RFC3339, releasedAt.(string))
+		if err != nil {
+			return diag.Errorf("invalid released_at %q: %v", releasedAt, err)
+		}
+		options.ReleasedAt = &parsedReleasedAt
+	}
+	if links := d.Get("links").(*schema.Set).List(); len(links) > 0 {
+		options.Assets = &gitlab.ReleaseAssetsOptions{}
+		for _, link := range links {
+			options.Assets.Links = append(options.Assets.Links, expandReleaseAssetLinkOptions(link.(map[string]interface{})))
+		}
+	}
+
+	log.Printf("[DEBUG] create release project/tagName: %s/%s", project, tagName)
+	release, _, err := client.Releases.CreateRelease(project, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to create release %q in project %q: %v", tagName, project, err)
+	}
+
+	d.SetId(utils.BuildTwoPartID(&project, &release.TagName))
+
+	return resourceGitlabReleaseRead(ctx, d, meta)
+}
+
+func resourceGitlabReleaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+	project, tagName, err := utils.ParseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read release project/tagName: %s/%s", project, tagName)
+	release, _, err := api.GetRelease(ctx, client, project, tagName)
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[WARN] release project/tagName: %s/%s not found, removing from state", project, tagName)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read release %q of project %q: %v", tagName, project, err)
+	}
+
+	links := managedReleaseLinks(d.Get("links").(*schema.Set).List(), release.Assets.Links)
+	stateMap := gitlabReleaseToStateMap(project, release, links)
+	if err = setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabReleaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+	project, tagName, err := utils.ParseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description", "milestones", "released_at") {
+		// the name and description are always sent, because the API clears them otherwise.
+		options := &gitlab.UpdateReleaseOptions{
+			Name:        gitlab.String(d.Get("name").(string)),
+			Description: gitlab.String(d.Get("description").(string)),
+			Milestones:  stringSetToStringSlice(d.Get("milestones").(*schema.Set)),
+		}
+		if releasedAt, ok := d.GetOk("released_at"); ok {
+			parsedReleasedAt, err := time.Parse(time.RFC3339, releasedAt.(string))
+			if err != nil {
+				return diag.Errorf("invalid released_at %q: %v", releasedAt, err)
+			}
+			options.ReleasedAt = &parsedReleasedAt
+		}
+
+		log.Printf("[DEBUG] update release project/tagName: %s/%s", project, tagName)
+		if _, _, err := client.Releases.UpdateRelease(project, tagName, options, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("failed to update release %q of project %q: %v", tagName, project, err)
+		}
+	}
+
+	if d.HasChange("links") {
+		if err := updateReleaseLinks(ctx, client, project, tagName, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabReleaseRead(ctx, d, meta)
+}
+
+func resourceGitlabReleaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+	project, tagName, err := utils.ParseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete release project/tagName: %s/%s", project, tagName)
+	if _, _, err := client.Releases.DeleteRelease(project, tagName, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+		return diag.Errorf("failed to delete release %q of project %q: %v", tagName, project, err)
+	}
+	return nil
+}
+
+// managedReleaseLinks returns the links of a release which are managed by the `gitlab_release` resource.
+// These are the links in its state, identified by their ID, and the configured links which have no ID yet, identified by their name.
+// The other links of the release are left to `gitlab_release_link` resources.
+func managedReleaseLinks(stateLinks []interface{}, links []*gitlab.ReleaseLink) []*gitlab.ReleaseLink {
+	ids := make(map[int]bool)
+	names := make(map[string]bool)
+	for _, stateLink := range stateLinks {
+		link := stateLink.(map[string]interface{})
+		if id := link["link_id"].(int); id != 0 {
+			ids[id] = true
+		} else {
+			names[link["name"].(string)] = true
+		}
+	}
+
+	var managed []*gitlab.ReleaseLink
+	for _, link := range links {
+		if ids[link.ID] || names[link.Name] {
+			managed = append(managed, link)
+		}
+	}
+	return managed
+}
+
+// updateReleaseLinks changes the links of the release from their previous to their configured state.
+// Links are matched by their name, which is unique within a release. Existing links which weren't managed yet are adopted.
+func updateReleaseLinks(ctx context.Context, client *gitlab.Client, project string, tagName string, d *schema.ResourceData) error {
+	oldLinks, newLinks := d.GetChange("links")
+	oldByName := releaseLinksByName(oldLinks.(*schema.Set).List())
+	newByName := releaseLinksByName(newLinks.(*schema.Set).List())
+
+	// remove the links first, so that their names and URLs can be reused by other links.
+	for name, oldLink := range oldByName {
+		if _, ok := newByName[name]; ok {
+			continue
+		}
+		linkID := oldLink["link_id"].(int)
+		log.Printf("[DEBUG] delete release link project/tagName/linkID: %s/%s/%d", project, tagName, linkID)
+		if _, _, err := client.ReleaseLinks.DeleteReleaseLink(project, tagName, linkID, gitlab.WithContext(ctx)); err != nil && !api.Is404(err) {
+			return err
+		}
+	}
+
+	existing, err := listAllReleaseLinks(ctx, client, project, tagName)
+	if err != nil {
+		return err
+	}
+
+	for name, newLink := range newByName {
+		linkID := 0
+		if oldLink, ok := oldByName[name]; ok {
+			linkID = oldLink["link_id"].(int)
+		} else if existingLink, ok := existing[name]; ok {
+			linkID = existingLink.ID
+		}
+
+		options := expandReleaseAssetLinkOptions(newLink)
+		if linkID == 0 {
+			log.Printf("[DEBUG] create release link project/tagName/name: %s/%s/%s", project, tagName, name)
+			if _, _, err := client.ReleaseLinks.CreateReleaseLink(project, tagName, &gitlab.CreateReleaseLinkOptions{
+				Name:     options.Name,
+				URL:      options.URL,
+				FilePath: options.FilePath,
+				LinkType: options.LinkType,
+			}, gitlab.WithContext(ctx)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Printf("[DEBUG] update release link project/tagName/linkID: %s/%s/%d", project, tagName, linkID)
+		if _, _, err := client.ReleaseLinks.UpdateReleaseLink(project, tagName, linkID, &gitlab.UpdateReleaseLinkOptions{
+			Name:     options.Name,
+			URL:      options.URL,
+			FilePath: options.FilePath,
+			LinkType: options.LinkType,
+		}, gitlab.WithContext(ctx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func releaseLinksByName(links []interface{}) map[string]map[string]interface{} {
+	byName := make(map[string]map[string]interface{}, len(links))
+	for _, link := range links {
+		l := link.(map[string]interface{})
+		byName[l["name"].(string)] = l
+	}
+	return byName
+}
+
+func listAllReleaseLinks(ctx context.Context, client *gitlab.Client, project string, tagName string) (map[string]*gitlab.ReleaseLink, error) {
+	options := gitlab.ListReleaseLinksOptions{PerPage: 100, Page: 1}
+	links := make(map[string]*gitlab.ReleaseLink)
+	for options.Page != 0 {
+		page, resp, err := client.ReleaseLinks.ListReleaseLinks(project, tagName, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, link := range page {
+			links[link.Name] = link
+		}
+		options.Page = resp.NextPage
+	}
+	return links, nil
+}
+
+func expandReleaseAssetLinkOptions(link map[string]interface{}) *gitlab.ReleaseAssetLinkOptions {
+	options := &gitlab.ReleaseAssetLinkOptions{
+		Name: gitlab.String(link["name"].(string)),
+		URL:  gitlab.String(link["url"].(string)),
+	}
+	if filePath := link["filepath"].(string); filePath != "" {
+		options.FilePath = gitlab.String(filePath)
+	}
+	if linkType := link["link_type"].(string); linkType != "" {
+		linkTypeValue := gitlab.LinkTypeValue(linkType)
+		options.LinkType = &linkTypeValue
+	}
+	return options
+}
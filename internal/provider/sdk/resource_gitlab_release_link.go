@@ -31,7 +31,7 @@ var _ = registerResource("gitlab_release_link", func() *schema.Resource {
 })
 
 func resourceGitlabReleaseLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	tagName := d.Get("tag_name").(string)
 	name := d.Get("name").(string)
@@ -61,7 +61,7 @@ func resourceGitlabReleaseLinkCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabReleaseLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, tagName, linkID, err := resourceGitLabReleaseLinkParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -87,7 +87,7 @@ func resourceGitlabReleaseLinkRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabReleaseLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, tagName, linkID, err := resourceGitLabReleaseLinkParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -119,7 +119,7 @@ func resourceGitlabReleaseLinkUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabReleaseLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, tagName, linkID, err := resourceGitLabReleaseLinkParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,200 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+func TestAccGitlabRelease_basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+	milestones := testutil.AddProjectMilestones(t, project, 2)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabReleaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				// create a release with required values only
+				Config: fmt.Sprintf(`
+				resource "gitlab_release" "this" {
+					project  = "%s"
+					tag_name = "v1.0.0"
+					ref      = "%s"
+				}`, project.PathWithNamespace, project.DefaultBranch),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_release.this", "name", "v1.0.0"),
+					resource.TestCheckResourceAttrSet("gitlab_release.this", "released_at"),
+					resource.TestCheckResourceAttrSet("gitlab_release.this", "commit_sha"),
+					resource.TestCheckResourceAttr("gitlab_release.this", "links.#", "0"),
+				),
+			},
+			{
+				// verify import
+				ResourceName:            "gitlab_release.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref"},
+			},
+			{
+				// update all attributes and add links
+				Config: fmt.Sprintf(`
+				resource "gitlab_release" "this" {
+					project     = "%s"
+					tag_name    = "v1.0.0"
+					ref         = "%s"
+					name        = "Release 1.0.0"
+					description = "The first release"
+					milestones  = ["%s", "%s"]
+					released_at = "2022-01-01T10:00:00+02:00"
+
+					links {
+						name = "binary"
+						url  = "https://example.com/binary"
+					}
+
+					links {
+						name      = "runbook"
+						url       = "https://example.com/runbook"
+						filepath  = "/runbook"
+						link_type = "runbook"
+					}
+				}`, project.PathWithNamespace, project.DefaultBranch, milestones[0].Title, milestones[1].Title),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_release.this", "name", "Release 1.0.0"),
+					resource.TestCheckResourceAttr("gitlab_release.this", "description", "The first release"),
+					resource.TestCheckResourceAttr("gitlab_release.this", "milestones.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_release.this", "released_at", "2022-01-01T08:00:00Z"),
+					resource.TestCheckResourceAttr("gitlab_release.this", "links.#", "2"),
+				),
+			},
+			{
+				// verify import
+				ResourceName:            "gitlab_release.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref", "links"},
+			},
+			{
+				// update a link and remove the other one
+				Config: fmt.Sprintf(`
+				resource "gitlab_release" "this" {
+					project     = "%s"
+					tag_name    = "v1.0.0"
+					ref         = "%s"
+					name        = "Release 1.0.0"
+					description = "The first release"
+
+					links {
+						name = "binary"
+						url  = "https://example.com/binary-v2"
+					}
+				}`, project.PathWithNamespace, project.DefaultBranch),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_release.this", "milestones.#", "0"),
+					resource.TestCheckResourceAttr("gitlab_release.this", "links.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_release.this", "links.*", map[string]string{
+						"name": "binary",
+						"url":  "https://example.com/binary-v2",
+					}),
+					testAccCheckGitlabReleaseLinkCount(project.ID, "v1.0.0", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabRelease_withReleaseLinkResource(t *testing.T) {
+	project := testutil.CreateProject(t)
+
+	config := func(links string) string {
+		return fmt.Sprintf(`
+		resource "gitlab_release" "this" {
+			project  = "%d"
+			tag_name = "v1.0.0"
+			ref      = "%s"
+
+			%s
+		}
+
+		resource "gitlab_release_link" "this" {
+			project  = gitlab_release.this.project
+			tag_name = gitlab_release.this.tag_name
+			name     = "managed-separately"
+			url      = "https://example.com/managed-separately"
+		}`, project.ID, project.DefaultBranch, links)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabReleaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+				links {
+					name = "inline"
+					url  = "https://example.com/inline"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_release.this", "links.#", "1"),
+					testAccCheckGitlabReleaseLinkCount(project.ID, "v1.0.0", 2),
+				),
+			},
+			{
+				// removing the inline link leaves the link of the `gitlab_release_link` resource alone
+				Config: config(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_release.this", "links.#", "0"),
+					testAccCheckGitlabReleaseLinkCount(project.ID, "v1.0.0", 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabReleaseLinkCount(project int, tagName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		links, _, err := testutil.TestGitlabClient.ReleaseLinks.ListReleaseLinks(project, tagName, &gitlab.ListReleaseLinksOptions{})
+		if err != nil {
+			return err
+		}
+		if len(links) != expected {
+			return fmt.Errorf("expected %d release links, got %d", expected, len(links))
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabReleaseDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_release" {
+			continue
+		}
+		project, tagName, err := utils.ParseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		release, _, err := api.GetRelease(context.Background(), testutil.TestGitlabClient, project, tagName)
+		if err == nil && release != nil {
+			return errors.New("Release still exists")
+		}
+		if !api.Is404(err) {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
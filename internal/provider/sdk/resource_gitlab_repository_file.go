@@ -2,7 +2,6 @@ package sdk
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
@@ -19,29 +18,15 @@ import (
 
 const encoding = "base64"
 
-// NOTE: this lock is a bit of a hack to prevent parallel calls to the GitLab Repository Files API.
-//
-//	If it is called concurrently, the API will return a 400 error along the lines of:
-//	```
-//	(400 Bad Request) DELETE https://gitlab.com/api/v4/projects/30716/repository/files/somefile.yaml: 400
-//	{message: 9:Could not update refs/heads/master. Please refresh and try again..}
-//	```
-//
-//	This lock only solves half of the problem, where the provider is responsible for
-//	the concurrency. The other half is if the API is called outside of terraform at the same time
-//	this resource makes calls to the API.
-//	To mitigate this, simple retries are used.
-var resourceGitlabRepositoryFileApiLock = newLock()
-
 var _ = registerResource("gitlab_repository_file", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_repository_file`" + ` resource allows to manage the lifecycle of a file within a repository.
 
 -> **Timeouts** Default timeout for *Create*, *Update* and *Delete* is one minute and can be configured in the ` + "`timeouts`" + ` block.
 
--> **Implementation Detail** GitLab is unable to handle concurrent calls to the GitLab repository files API for the same project.
-   Therefore, this resource queues every call to the repository files API no matter of the project, which may slow down the terraform
-   execution time for some configurations. In addition, retries are performed in case a refresh is required because another application
+-> **Implementation Detail** GitLab is unable to handle concurrent calls to the GitLab repository files API for the same branch of a project.
+   Therefore, this resource queues every call to the repository files API for the same project and branch, while calls for other projects
+   and branches run in parallel. In addition, retries are performed in case a refresh is required because another application
    changed the repository at the same time.
 
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/repository_files.html)`,
@@ -99,26 +84,22 @@ var _ = registerResource("gitlab_repository_file", func() *schema.Resource {
 
 func resourceGitlabRepositoryFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	project := d.Get("project").(string)
+	branch := d.Get("branch").(string)
 	filePath := d.Get("file_path").(string)
+	client := meta.(*api.ProviderData).Client
 
-	log.Printf("[DEBUG] gitlab_repository_file: waiting for lock to create %s/%s", project, filePath)
-	if err := resourceGitlabRepositoryFileApiLock.lock(ctx); err != nil {
+	log.Printf("[DEBUG] gitlab_repository_file: waiting for lock to create %s/%s on branch %s", project, filePath, branch)
+	unlock, err := lockRepositoryBranch(ctx, client, project, branch)
+	if err != nil {
 		return diag.FromErr(err)
 	}
-	defer resourceGitlabRepositoryFileApiLock.unlock()
-	log.Printf("[DEBUG] gitlab_repository_file: got lock to create %s/%s", project, filePath)
-
-	client := meta.(*gitlab.Client)
-	// NOTE: for backwards-compatibility reasons, we also support an already given base64 encoding,
-	//       otherwise we encode the `content` to base64.
-	content := d.Get("content").(string)
-	if _, err := base64.StdEncoding.DecodeString(content); err != nil {
-		log.Printf("[DEBUG] gitlab_repository_file: given content '%s' is not a valid base64 encoded string, encoding it ...", content)
-		content = base64.StdEncoding.EncodeToString([]byte(content))
-	}
+	defer unlock()
+	log.Printf("[DEBUG] gitlab_repository_file: got lock to create %s/%s on branch %s", project, filePath, branch)
+
+	content := gitlabRepositoryFileEncodeContent(d.Get("content").(string))
 
 	options := &gitlab.CreateFileOptions{
-		Branch:        gitlab.String(d.Get("branch").(string)),
+		Branch:        gitlab.String(branch),
 		Encoding:      gitlab.String(encoding),
 		AuthorEmail:   gitlab.String(d.Get("author_email").(string)),
 		AuthorName:    gitlab.String(d.Get("author_name").(string)),
@@ -191,7 +172,7 @@ func resourceGitlabRepositoryFileCreate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		repositoryFile, _, err := client.RepositoryFiles.CreateFile(project, filePath, options, gitlab.WithContext(ctx))
 		if err != nil {
 			if isRefreshError(err) {
@@ -211,7 +192,7 @@ func resourceGitlabRepositoryFileCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabRepositoryFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, branch, filePath, err := resourceGitLabRepositoryFileParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -233,15 +214,7 @@ func resourceGitlabRepositoryFileRead(ctx context.Context, d *schema.ResourceDat
 
 	configContent := d.Get("content").(string)
 	log.Printf("[DEBUG] gitlab_repository_file: comparing content of %s with %s", repositoryFile.Content, configContent)
-	// NOTE: for backwards-compatibility reasons, we also support an already given base64 encoding,
-	//       otherwise we encode the `content` to base64.
-	if _, err := base64.StdEncoding.DecodeString(configContent); err != nil {
-		// if `content` is config is not a base64 encoded string, we decode the one from the API, too
-		// in case it's base64 encoded, else we don't decode it.
-		if decodedContent, err := base64.StdEncoding.DecodeString(repositoryFile.Content); err == nil {
-			repositoryFile.Content = string(decodedContent)
-		}
-	}
+	repositoryFile.Content = gitlabRepositoryFileDecodeContent(configContent, repositoryFile.Content)
 
 	d.SetId(resourceGitLabRepositoryFileBuildId(project, branch, repositoryFile.FilePath))
 	d.Set("branch", repositoryFile.Ref)
@@ -259,26 +232,21 @@ func resourceGitlabRepositoryFileUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] gitlab_repository_file: waiting for lock to update %s/%s", project, filePath)
-	if err := resourceGitlabRepositoryFileApiLock.lock(ctx); err != nil {
+	client := meta.(*api.ProviderData).Client
+
+	log.Printf("[DEBUG] gitlab_repository_file: waiting for lock to update %s/%s on branch %s", project, filePath, branch)
+	unlock, err := lockRepositoryBranch(ctx, client, project, branch)
+	if err != nil {
 		return diag.FromErr(err)
 	}
-	defer resourceGitlabRepositoryFileApiLock.unlock()
-	log.Printf("[DEBUG] gitlab_repository_file: got lock to update %s/%s", project, filePath)
-
-	client := meta.(*gitlab.Client)
+	defer unlock()
+	log.Printf("[DEBUG] gitlab_repository_file: got lock to update %s/%s on branch %s", project, filePath, branch)
 
 	readOptions := &gitlab.GetFileOptions{
 		Ref: gitlab.String(branch),
 	}
 
-	// NOTE: for backwards-compatibility reasons, we also support an already given base64 encoding,
-	//       otherwise we encode the `content` to base64.
-	content := d.Get("content").(string)
-	if _, err := base64.StdEncoding.DecodeString(content); err != nil {
-		log.Printf("[DEBUG] gitlab_repository_file: given content '%s' is not a valid base64 encoded string, encoding it ...", content)
-		content = base64.StdEncoding.EncodeToString([]byte(content))
-	}
+	content := gitlabRepositoryFileEncodeContent(d.Get("content").(string))
 
 	updateOptions := &gitlab.UpdateFileOptions{
 		Branch:        gitlab.String(branch),
@@ -326,14 +294,15 @@ func resourceGitlabRepositoryFileDelete(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] gitlab_repository_file: waiting for lock to delete %s/%s", project, filePath)
-	if err := resourceGitlabRepositoryFileApiLock.lock(ctx); err != nil {
+	client := meta.(*api.ProviderData).Client
+
+	log.Printf("[DEBUG] gitlab_repository_file: waiting for lock to delete %s/%s on branch %s", project, filePath, branch)
+	unlock, err := lockRepositoryBranch(ctx, client, project, branch)
+	if err != nil {
 		return diag.FromErr(err)
 	}
-	defer resourceGitlabRepositoryFileApiLock.unlock()
-	log.Printf("[DEBUG] gitlab_repository_file: got lock to delete %s/%s", project, filePath)
-
-	client := meta.(*gitlab.Client)
+	defer unlock()
+	log.Printf("[DEBUG] gitlab_repository_file: got lock to delete %s/%s on branch %s", project, filePath, branch)
 
 	readOptions := &gitlab.GetFileOptions{
 		Ref: gitlab.String(branch),
@@ -0,0 +1,336 @@
+package sdk
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var _ = registerResource("gitlab_repository_files", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_repository_files`" + ` resource allows to manage multiple files within a repository with a single commit per change.
+
+In contrast to the ` + "`gitlab_repository_file`" + ` resource, all files are created, updated and deleted together
+in one atomic commit using the Commits API. Only files which actually changed compared to the current repository tree
+are part of the commit.
+
+-> **Timeouts** Default timeout for *Create*, *Update* and *Delete* is one minute and can be configured in the ` + "`timeouts`" + ` block.
+
+-> **Implementation Detail** GitLab is unable to handle concurrent commits to the same branch of a project.
+   Therefore, this resource queues every commit for the same project and branch with the ones of the ` + "`gitlab_repository_file`" + ` resource.
+   In addition, retries are performed in case a refresh is required because another application changed the repository at the same time.
+
+~> Do not manage the same file with this resource and the ` + "`gitlab_repository_file`" + ` resource or multiple ` + "`gitlab_repository_files`" + ` resources.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/commits.html#create-a-commit-with-multiple-files-and-actions)`,
+
+		CreateContext: resourceGitlabRepositoryFilesCreate,
+		ReadContext:   resourceGitlabRepositoryFilesRead,
+		UpdateContext: resourceGitlabRepositoryFilesUpdate,
+		DeleteContext: resourceGitlabRepositoryFilesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name or ID of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"branch": {
+				Description: "Name of the branch to which to commit to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"start_branch": {
+				Description: "Name of the branch to start the new commit from, if `branch` does not exist yet.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"commit_message": {
+				Description: "Commit message.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"author_email": {
+				Description: "Email of the commit author.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"author_name": {
+				Description: "Name of the commit author.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"files": {
+				Description: "Map of file paths to file contents. The file paths must be relative to the root of the project without a leading slash `/`. If a content is not yet base64 encoded, it will be encoded automatically, the same way as the `content` of the `gitlab_repository_file` resource.",
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.MapKeyMatch(
+					regexp.MustCompile(`^[^/]`), "file paths must not be empty or start with a slash `/`",
+				),
+			},
+			"last_commit_id": {
+				Description: "The ID of the last commit created by this resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabRepositoryFilesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+	project := d.Get("project").(string)
+	branch := d.Get("branch").(string)
+
+	if err := resourceGitlabRepositoryFilesCommit(ctx, d, client, nil, d.Get("files").(map[string]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.BuildTwoPartID(&project, &branch))
+	return resourceGitlabRepositoryFilesRead(ctx, d, meta)
+}
+
+func resourceGitlabRepositoryFilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+	project, branch, err := utils.ParseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] gitlab_repository_files: read tree of %s on branch %s", project, branch)
+	tree, err := resourceGitlabRepositoryFilesListTree(ctx, client, project, branch)
+	if err != nil {
+		if api.Is404(err) {
+			log.Printf("[DEBUG] gitlab_repository_files: branch %s of %s not found, removing from state", branch, project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	files := make(map[string]interface{})
+	for filePath, content := range d.Get("files").(map[string]interface{}) {
+		blobID, ok := tree[filePath]
+		if !ok {
+			log.Printf("[DEBUG] gitlab_repository_files: file %s not found, removing from state", filePath)
+			continue
+		}
+
+		// only fetch the files which changed compared to the state.
+		if blobID == gitBlobID(content.(string)) {
+			files[filePath] = content
+			continue
+		}
+
+		repositoryFile, _, err := client.RepositoryFiles.GetFile(project, filePath, &gitlab.GetFileOptions{Ref: gitlab.String(branch)}, gitlab.WithContext(ctx))
+		if err != nil {
+			if api.Is404(err) {
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		files[filePath] = gitlabRepositoryFileDecodeContent(content.(string), repositoryFile.Content)
+	}
+
+	d.Set("project", project)
+	d.Set("branch", branch)
+	if err := d.Set("files", files); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabRepositoryFilesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+
+	if d.HasChange("files") {
+		oldFiles, newFiles := d.GetChange("files")
+		if err := resourceGitlabRepositoryFilesCommit(ctx, d, client, oldFiles.(map[string]interface{}), newFiles.(map[string]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabRepositoryFilesRead(ctx, d, meta)
+}
+
+func resourceGitlabRepositoryFilesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.ProviderData).Client
+
+	if err := resourceGitlabRepositoryFilesCommit(ctx, d, client, d.Get("files").(map[string]interface{}), nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceGitlabRepositoryFilesCommit computes the actions required to get from the current tree to the wanted files
+// and pushes them in a single commit. Files which are in oldFiles, but not in newFiles are deleted.
+func resourceGitlabRepositoryFilesCommit(ctx context.Context, d *schema.ResourceData, client *gitlab.Client, oldFiles map[string]interface{}, newFiles map[string]interface{}, timeout time.Duration) error {
+	project := d.Get("project").(string)
+	branch := d.Get("branch").(string)
+
+	log.Printf("[DEBUG] gitlab_repository_files: waiting for lock to commit to %s on branch %s", project, branch)
+	unlock, err := lockRepositoryBranch(ctx, client, project, branch)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	log.Printf("[DEBUG] gitlab_repository_files: got lock to commit to %s on branch %s", project, branch)
+
+	options := &gitlab.CreateCommitOptions{
+		Branch:      gitlab.String(branch),
+		AuthorEmail: gitlab.String(d.Get("author_email").(string)),
+		AuthorName:  gitlab.String(d.Get("author_name").(string)),
+	}
+	commitMessage := d.Get("commit_message").(string)
+	if len(newFiles) == 0 {
+		commitMessage = fmt.Sprintf("[DELETE]: %s", commitMessage)
+	}
+	options.CommitMessage = gitlab.String(commitMessage)
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		// NOTE: we re-read the tree on every attempt, because a refresh is required if it changed in the meantime.
+		ref := branch
+		tree, err := resourceGitlabRepositoryFilesListTree(ctx, client, project, branch)
+		if err != nil && api.Is404(err) {
+			// the branch does not exist (anymore), so there is nothing to delete.
+			if len(newFiles) == 0 {
+				return nil
+			}
+
+			// the branch is created from the start branch or, in an empty repository, from scratch.
+			tree, err = map[string]string{}, nil
+			if startBranch, ok := d.GetOk("start_branch"); ok {
+				ref = startBranch.(string)
+				options.StartBranch = gitlab.String(ref)
+				tree, err = resourceGitlabRepositoryFilesListTree(ctx, client, project, ref)
+			}
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		options.Actions = resourceGitlabRepositoryFilesActions(tree, oldFiles, newFiles)
+		if len(options.Actions) == 0 {
+			log.Printf("[DEBUG] gitlab_repository_files: files in %s on ref %s are up-to-date, nothing to commit", project, ref)
+			return nil
+		}
+
+		log.Printf("[DEBUG] gitlab_repository_files: committing %d actions to %s on branch %s", len(options.Actions), project, branch)
+		commit, _, err := client.Commits.CreateCommit(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			if isRefreshError(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		d.Set("last_commit_id", commit.ID)
+		return nil
+	})
+}
+
+// resourceGitlabRepositoryFilesActions returns the commit actions required to get from
+// the given tree to the newFiles. Files which are in oldFiles, but not in newFiles are deleted.
+func resourceGitlabRepositoryFilesActions(tree map[string]string, oldFiles map[string]interface{}, newFiles map[string]interface{}) []*gitlab.CommitActionOptions {
+	actions := make([]*gitlab.CommitActionOptions, 0)
+
+	for filePath := range oldFiles {
+		if _, ok := newFiles[filePath]; ok {
+			continue
+		}
+		if _, ok := tree[filePath]; !ok {
+			continue
+		}
+		actions = append(actions, &gitlab.CommitActionOptions{
+			Action:   gitlab.FileAction(gitlab.FileDelete),
+			FilePath: gitlab.String(filePath),
+		})
+	}
+
+	for filePath, content := range newFiles {
+		action := gitlab.FileCreate
+		if blobID, ok := tree[filePath]; ok {
+			if blobID == gitBlobID(content.(string)) {
+				continue
+			}
+			action = gitlab.FileUpdate
+		}
+		actions = append(actions, &gitlab.CommitActionOptions{
+			Action:   gitlab.FileAction(action),
+			FilePath: gitlab.String(filePath),
+			Content:  gitlab.String(gitlabRepositoryFileEncodeContent(content.(string))),
+			Encoding: gitlab.String(encoding),
+		})
+	}
+
+	// sort the actions to get reproducible commits
+	sort.Slice(actions, func(i, j int) bool {
+		return *actions[i].FilePath < *actions[j].FilePath
+	})
+	return actions
+}
+
+// resourceGitlabRepositoryFilesListTree returns the blob IDs of all files in the given branch of the repository by path.
+// A 404 error is returned if the branch does not exist.
+func resourceGitlabRepositoryFilesListTree(ctx context.Context, client *gitlab.Client, project string, ref string) (map[string]string, error) {
+	if _, _, err := client.Branches.GetBranch(project, ref, gitlab.WithContext(ctx)); err != nil {
+		return nil, err
+	}
+
+	tree := make(map[string]string)
+	options := &gitlab.ListTreeOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100, Page: 1},
+		Ref:         gitlab.String(ref),
+		Recursive:   gitlab.Bool(true),
+	}
+	for options.Page != 0 {
+		nodes, resp, err := client.Repositories.ListTree(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			if node.Type == "blob" {
+				tree[node.Path] = node.ID
+			}
+		}
+		options.Page = resp.NextPage
+	}
+	return tree, nil
+}
+
+// gitBlobID computes the git object ID of a blob with the given file content,
+// which is used to compare the content with the tree of the repository without downloading the files.
+func gitBlobID(content string) string {
+	data := []byte(content)
+	// NOTE: the content may be given base64 encoded, see gitlabRepositoryFileEncodeContent.
+	if decoded, err := base64.StdEncoding.DecodeString(gitlabRepositoryFileEncodeContent(content)); err == nil {
+		data = decoded
+	}
+
+	h := sha1.New()
+	fmt.Fprintf(h, "blob %d\x00", len(data))
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,183 @@
+//go:build acceptance
+// +build acceptance
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil"
+)
+
+func TestAccGitlabRepositoryFiles_basic(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabRepositoryFilesDestroy(testProject.ID, "main", "ci/build.yml", "ci/test.yml", "ci/deploy.yml"),
+		Steps: []resource.TestStep{
+			// Create multiple files in a single commit
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_repository_files" "this" {
+				  project        = %d
+				  branch         = "main"
+				  commit_message = "feature: add CI templates"
+				  files = {
+				    "ci/build.yml" = "build: true"
+				    "ci/test.yml"  = "test: true"
+				  }
+				}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRepositoryFilesContent(testProject.ID, "main", map[string]string{
+						"ci/build.yml": "build: true",
+						"ci/test.yml":  "test: true",
+					}),
+					testAccCheckGitlabRepositoryFilesSingleCommit(testProject.ID, "main", "gitlab_repository_files.this"),
+					resource.TestCheckResourceAttr("gitlab_repository_files.this", "files.%", "2"),
+				),
+			},
+			// Update, delete and create files in a single commit
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_repository_files" "this" {
+				  project        = %d
+				  branch         = "main"
+				  commit_message = "feature: update CI templates"
+				  files = {
+				    "ci/build.yml"  = "build: false"
+				    "ci/deploy.yml" = "deploy: true"
+				  }
+				}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRepositoryFilesContent(testProject.ID, "main", map[string]string{
+						"ci/build.yml":  "build: false",
+						"ci/deploy.yml": "deploy: true",
+					}),
+					testAccCheckGitlabRepositoryFilesNotExist(testProject.ID, "main", "ci/test.yml"),
+					testAccCheckGitlabRepositoryFilesSingleCommit(testProject.ID, "main", "gitlab_repository_files.this"),
+					resource.TestCheckResourceAttr("gitlab_repository_files.this", "files.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabRepositoryFiles_base64Content(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		CheckDestroy:             testAccCheckGitlabRepositoryFilesDestroy(testProject.ID, "main", "meow.txt"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_repository_files" "this" {
+				  project        = %d
+				  branch         = "main"
+				  commit_message = "feature: add launch codes"
+				  files = {
+				    "meow.txt" = "bWVvdyBtZW93IG1lb3c="
+				  }
+				}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRepositoryFilesContent(testProject.ID, "main", map[string]string{
+						"meow.txt": "meow meow meow",
+					}),
+					resource.TestCheckResourceAttr("gitlab_repository_files.this", "files.meow.txt", "bWVvdyBtZW93IG1lb3c="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabRepositoryFiles_createOnNewBranch(t *testing.T) {
+	testProject := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactoriesV6,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_repository_files" "this" {
+				  project        = %d
+				  branch         = "feature"
+				  start_branch   = "main"
+				  commit_message = "feature: add files on a new branch"
+				  files = {
+				    "a.txt" = "a"
+				    "b.txt" = "b"
+				  }
+				}
+				`, testProject.ID),
+				Check: testAccCheckGitlabRepositoryFilesContent(testProject.ID, "feature", map[string]string{
+					"a.txt":     "a",
+					"b.txt":     "b",
+					"README.md": "",
+				}),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabRepositoryFilesContent(projectID int, branch string, files map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for filePath, content := range files {
+			gotContent, _, err := testutil.TestGitlabClient.RepositoryFiles.GetRawFile(projectID, filePath, &gitlab.GetRawFileOptions{Ref: gitlab.String(branch)})
+			if err != nil {
+				return fmt.Errorf("Cannot get file %q: %v", filePath, err)
+			}
+			// NOTE: an empty content only checks the existence of the file
+			if content != "" && string(gotContent) != content {
+				return fmt.Errorf("got content %q for file %q; want %q", string(gotContent), filePath, content)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabRepositoryFilesNotExist(projectID int, branch string, filePaths ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, filePath := range filePaths {
+			_, _, err := testutil.TestGitlabClient.RepositoryFiles.GetFile(projectID, filePath, &gitlab.GetFileOptions{Ref: gitlab.String(branch)})
+			if err == nil {
+				return fmt.Errorf("File %q still exists", filePath)
+			}
+			if !api.Is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabRepositoryFilesSingleCommit(projectID int, branch string, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		gotBranch, _, err := testutil.TestGitlabClient.Branches.GetBranch(projectID, branch)
+		if err != nil {
+			return err
+		}
+		if gotBranch.Commit.ID != rs.Primary.Attributes["last_commit_id"] {
+			return fmt.Errorf("expected last commit of branch %q to be %q, got %q", branch, rs.Primary.Attributes["last_commit_id"], gotBranch.Commit.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabRepositoryFilesDestroy(projectID int, branch string, filePaths ...string) resource.TestCheckFunc {
+	return testAccCheckGitlabRepositoryFilesNotExist(projectID, branch, filePaths...)
+}
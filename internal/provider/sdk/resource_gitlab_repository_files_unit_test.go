@@ -0,0 +1,62 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestGitlabRepositoryFiles_gitBlobID(t *testing.T) {
+	cases := []struct {
+		Content string
+		BlobID  string
+	}{
+		// echo -n "meow meow meow" | git hash-object --stdin
+		{Content: "meow meow meow", BlobID: "cb0cd6cbc2f9b9b70f373139eb28da69a79415f8"},
+		{Content: "bWVvdyBtZW93IG1lb3c=", BlobID: "cb0cd6cbc2f9b9b70f373139eb28da69a79415f8"},
+		// git hash-object -t blob /dev/null
+		{Content: "", BlobID: "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391"},
+	}
+
+	for _, tc := range cases {
+		if got := gitBlobID(tc.Content); got != tc.BlobID {
+			t.Fatalf("got blob id %q for content %q, expected %q", got, tc.Content, tc.BlobID)
+		}
+	}
+}
+
+func TestGitlabRepositoryFiles_actions(t *testing.T) {
+	tree := map[string]string{
+		"unchanged.txt": gitBlobID("unchanged"),
+		"changed.txt":   gitBlobID("old"),
+		"removed.txt":   gitBlobID("removed"),
+		"unmanaged.txt": gitBlobID("unmanaged"),
+	}
+	oldFiles := map[string]interface{}{
+		"unchanged.txt":       "unchanged",
+		"changed.txt":         "old",
+		"removed.txt":         "removed",
+		"already-removed.txt": "already-removed",
+	}
+	newFiles := map[string]interface{}{
+		"unchanged.txt": "unchanged",
+		"changed.txt":   "new",
+		"new.txt":       "new",
+	}
+
+	actions := resourceGitlabRepositoryFilesActions(tree, oldFiles, newFiles)
+
+	expected := map[string]gitlab.FileActionValue{
+		"changed.txt": gitlab.FileUpdate,
+		"new.txt":     gitlab.FileCreate,
+		"removed.txt": gitlab.FileDelete,
+	}
+	if len(actions) != len(expected) {
+		t.Fatalf("got %d actions, expected %d", len(actions), len(expected))
+	}
+	for _, action := range actions {
+		if expected[*action.FilePath] != *action.Action {
+			t.Fatalf("got action %q for %q, expected %q", *action.Action, *action.FilePath, expected[*action.FilePath])
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
 )
 
@@ -101,7 +102,7 @@ The runner will be registered at a group level if the token used is from a group
 })
 
 func resourceGitLabRunnerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	options := &gitlab.RegisterNewRunnerOptions{
 		Token: gitlab.String(d.Get("registration_token").(string)),
@@ -158,7 +159,7 @@ func resourceGitLabRunnerCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceGitLabRunnerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	runnerID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -186,7 +187,7 @@ func resourceGitLabRunnerRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitLabRunnerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	runnerID := d.Id()
 
 	options := &gitlab.UpdateRunnerDetailsOptions{}
@@ -236,7 +237,7 @@ func resourceGitLabRunnerUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceGitLabRunnerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	runnerID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
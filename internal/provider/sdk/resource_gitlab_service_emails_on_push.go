@@ -103,7 +103,7 @@ var _ = registerResource("gitlab_service_emails_on_push", func() *schema.Resourc
 })
 
 func resourceGitlabServiceEmailsOnPushCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	options := &gitlab.SetEmailsOnPushServiceOptions{
 		Recipients: gitlab.String(d.Get("recipients").(string)),
@@ -137,7 +137,7 @@ func resourceGitlabServiceEmailsOnPushCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceGitlabServiceEmailsOnPushRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] read gitlab emails on push service for project %s", project)
@@ -171,7 +171,7 @@ func resourceGitlabServiceEmailsOnPushRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabServiceEmailsOnPushDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] delete gitlab emails on push service for project %s", project)
@@ -70,7 +70,7 @@ var _ = registerResource("gitlab_service_external_wiki", func() *schema.Resource
 })
 
 func resourceGitlabServiceExternalWikiCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	d.SetId(project)
 
@@ -89,7 +89,7 @@ func resourceGitlabServiceExternalWikiCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceGitlabServiceExternalWikiRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] read gitlab external wiki service for project %s", project)
@@ -118,7 +118,7 @@ func resourceGitlabServiceExternalWikiRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabServiceExternalWikiDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] delete gitlab external wiki service for project %s", project)
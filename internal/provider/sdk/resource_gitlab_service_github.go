@@ -89,7 +89,7 @@ func resourceGitlabServiceGithubSetToState(d *schema.ResourceData, service *gitl
 }
 
 func resourceGitlabServiceGithubCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 
 	log.Printf("[DEBUG] create gitlab github service for project %s", project)
@@ -109,7 +109,7 @@ func resourceGitlabServiceGithubCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabServiceGithubRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 
 	log.Printf("[DEBUG] read gitlab github service for project %s", project)
@@ -137,7 +137,7 @@ func resourceGitlabServiceGithubUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabServiceGithubDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 
 	log.Printf("[DEBUG] delete gitlab github service for project %s", project)
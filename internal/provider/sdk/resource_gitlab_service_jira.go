@@ -146,7 +146,7 @@ var _ = registerResource("gitlab_service_jira", func() *schema.Resource {
 })
 
 func resourceGitlabServiceJiraCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project := d.Get("project").(string)
 
@@ -167,7 +167,7 @@ func resourceGitlabServiceJiraCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabServiceJiraRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] Read Gitlab Jira service %s", project)
@@ -188,7 +188,7 @@ func resourceGitlabServiceJiraRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("username", jiraService.Properties.Username)
 	d.Set("project_key", jiraService.Properties.ProjectKey)
 
-	hasJiraIssueTransitionIDFixed, err := api.IsGitLabVersionAtLeast(ctx, client, "15.2")()
+	hasJiraIssueTransitionIDFixed, err := meta.(*api.ProviderData).Capabilities.IsVersionAtLeast(ctx, "15.2")
 	if err != nil {
 		return diag.Errorf("failed to check if `jira_issue_transition_id` is properly supported in GitLab version: %v", err)
 	}
@@ -217,7 +217,7 @@ func resourceGitlabServiceJiraUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabServiceJiraDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	project := d.Get("project").(string)
 
@@ -112,7 +112,7 @@ var _ = registerResource("gitlab_service_microsoft_teams", func() *schema.Resour
 })
 
 func resourceGitlabServiceMicrosoftTeamsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	d.SetId(project)
 
@@ -141,7 +141,7 @@ func resourceGitlabServiceMicrosoftTeamsCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceGitlabServiceMicrosoftTeamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] Read Gitlab Microsoft Teams service for project %s", d.Id())
@@ -181,7 +181,7 @@ func resourceGitlabServiceMicrosoftTeamsUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceGitlabServiceMicrosoftTeamsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] Delete Gitlab Microsoft Teams service for project %s", d.Id())
@@ -63,7 +63,7 @@ func resourceGitlabServicePipelinesEmailSetToState(d *schema.ResourceData, servi
 }
 
 func resourceGitlabServicePipelinesEmailCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	d.SetId(project)
 	options := &gitlab.SetPipelinesEmailServiceOptions{
@@ -83,7 +83,7 @@ func resourceGitlabServicePipelinesEmailCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceGitlabServicePipelinesEmailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] read gitlab pipelines emails service for project %s", project)
@@ -104,7 +104,7 @@ func resourceGitlabServicePipelinesEmailRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceGitlabServicePipelinesEmailDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] delete gitlab pipelines email service for project %s", project)
@@ -196,7 +196,7 @@ var _ = registerResource("gitlab_service_slack", func() *schema.Resource {
 })
 
 func resourceGitlabServiceSlackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	d.SetId(project)
 
@@ -252,7 +252,7 @@ func resourceGitlabServiceSlackCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabServiceSlackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	id := d.Id()
 	project := d.Get("project").(string)
 	if id != project && project != "" {
@@ -318,7 +318,7 @@ func resourceGitlabServiceSlackUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabServiceSlackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] delete gitlab slack service for project %s", project)
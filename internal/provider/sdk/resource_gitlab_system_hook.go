@@ -82,7 +82,7 @@ var _ = registerResource("gitlab_system_hook", func() *schema.Resource {
 })
 
 func resourceGitlabSystemHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	options := &gitlab.AddHookOptions{
 		URL: gitlab.String(d.Get("url").(string)),
@@ -134,7 +134,7 @@ func resourceGitlabSystemHookCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabSystemHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	hookID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -162,7 +162,7 @@ func resourceGitlabSystemHookRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitlabSystemHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	hookID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
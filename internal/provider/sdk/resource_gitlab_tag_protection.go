@@ -51,7 +51,7 @@ var _ = registerResource("gitlab_tag_protection", func() *schema.Resource {
 })
 
 func resourceGitlabTagProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	tag := gitlab.String(d.Get("tag").(string))
 	createAccessLevel := tagProtectionAccessLevelID[d.Get("create_access_level").(string)]
@@ -83,7 +83,7 @@ func resourceGitlabTagProtectionCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabTagProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project, tag, err := projectAndTagFromID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -116,7 +116,7 @@ func resourceGitlabTagProtectionRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabTagProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	project := d.Get("project").(string)
 	tag := d.Get("tag").(string)
 
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
 
@@ -55,13 +56,16 @@ var _ = registerResource("gitlab_topic", func() *schema.Resource {
 				Optional:    true,
 			},
 		}, avatarableSchema()),
-		CustomizeDiff: avatarableDiff,
+		CustomizeDiff: customdiff.All(
+			avatarableDiff,
+			customizeDiffRequireFeature("topic_title", "title"),
+		),
 	}
 })
 
 func resourceGitlabTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	if err := resourceGitlabTopicEnsureTitleSupport(ctx, client, d); err != nil {
+	client := meta.(*api.ProviderData).Client
+	if err := resourceGitlabTopicEnsureTitleSupport(ctx, meta.(*api.ProviderData).Capabilities, d); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -100,7 +104,7 @@ func resourceGitlabTopicCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	topicID, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -127,9 +131,9 @@ func resourceGitlabTopicRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceGitlabTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	options := &gitlab.UpdateTopicOptions{}
-	if err := resourceGitlabTopicEnsureTitleSupport(ctx, client, d); err != nil {
+	if err := resourceGitlabTopicEnsureTitleSupport(ctx, meta.(*api.ProviderData).Capabilities, d); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -170,18 +174,18 @@ func resourceGitlabTopicUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	topicID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.Errorf("Failed to convert topic id %s to int: %s", d.Id(), err)
 	}
 	softDestroy := d.Get("soft_destroy").(bool)
 
-	deleteNotSupported, err := api.IsGitLabVersionLessThan(ctx, client, "14.9")()
+	deleteSupported, err := meta.(*api.ProviderData).Capabilities.IsVersionAtLeast(ctx, "14.9")
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if !softDestroy && deleteNotSupported {
+	if !softDestroy && !deleteSupported {
 		return diag.Errorf("GitLab 14.9 introduced the proper deletion of topics. Set `soft_destroy = true` to empty out a topic instead of deleting it.")
 	}
 
@@ -211,8 +215,8 @@ func resourceGitlabTopicDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceGitlabTopicEnsureTitleSupport(ctx context.Context, client *gitlab.Client, d *schema.ResourceData) error {
-	isTitleSupported, err := api.IsGitLabVersionAtLeast(ctx, client, "15.0")()
+func resourceGitlabTopicEnsureTitleSupport(ctx context.Context, capabilities *api.Capabilities, d *schema.ResourceData) error {
+	isTitleSupported, err := capabilities.SupportsFeature(ctx, "topic_title")
 	if err != nil {
 		return err
 	}
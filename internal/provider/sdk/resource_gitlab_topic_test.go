@@ -160,7 +160,7 @@ func TestAccGitlabTopic_titleSupport(t *testing.T) {
 						title = "Foo-%d"
 					}
 				`, rInt, rInt),
-				ExpectError: regexp.MustCompile(`title is not supported by your version of GitLab. At least GitLab 15.0 is required`),
+				ExpectError: regexp.MustCompile(`attribute "title" requires GitLab >= 15.0`),
 			},
 			{
 				SkipFunc: api.IsGitLabVersionAtLeast(context.TODO(), testutil.TestGitlabClient, "15.0"),
@@ -37,7 +37,7 @@ var _ = registerResource("gitlab_user", func() *schema.Resource {
 		UpdateContext: resourceGitlabUserUpdate,
 		DeleteContext: resourceGitlabUserDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceGitlabUserImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -143,7 +143,7 @@ func resourceGitlabUserSetToState(d *schema.ResourceData, user *gitlab.User) {
 }
 
 func resourceGitlabUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	options := &gitlab.CreateUserOptions{
 		Email:            gitlab.String(d.Get("email").(string)),
 		Password:         gitlab.String(d.Get("password").(string)),
@@ -189,7 +189,7 @@ func resourceGitlabUserCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	log.Printf("[DEBUG] import -- read gitlab user %s", d.Id())
 
 	id, _ := strconv.Atoi(d.Id())
@@ -209,7 +209,7 @@ func resourceGitlabUserRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceGitlabUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	options := &gitlab.ModifyUserOptions{}
 
@@ -290,7 +290,7 @@ func resourceGitlabUserUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 	log.Printf("[DEBUG] Delete gitlab user %s", d.Id())
 
 	id, _ := strconv.Atoi(d.Id())
@@ -320,3 +320,15 @@ func resourceGitlabUserDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return nil
 }
+
+func resourceGitlabUserImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*api.ProviderData).Client
+
+	userID, err := api.LookupUserID(ctx, client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(userID)
+	return []*schema.ResourceData{d}, nil
+}
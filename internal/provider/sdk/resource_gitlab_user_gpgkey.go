@@ -63,7 +63,7 @@ var _ = registerResource("gitlab_user_gpgkey", func() *schema.Resource {
 })
 
 func resourceGitlabUserGPGKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	options := &gitlab.AddGPGKeyOptions{
 		Key: gitlab.String(strings.TrimSpace(d.Get("key").(string))),
@@ -100,7 +100,7 @@ func resourceGitlabUserGPGKeyCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabUserGPGKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	userID, keyID, err := resourceGitlabUserGPGKeyParseID(d.Id())
 	if err != nil {
@@ -132,7 +132,7 @@ func resourceGitlabUserGPGKeyRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitlabUserGPGKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*api.ProviderData).Client
 
 	var isAdmin bool
 	_, keyID, err := resourceGitlabUserGPGKeyParseID(d.Id())
@@ -56,6 +56,17 @@ func TestAccGitlabUser_basic(t *testing.T) {
 					"skip_confirmation",
 				},
 			},
+			// Verify import by the username
+			{
+				ResourceName:      "gitlab_user.foo",
+				ImportStateId:     fmt.Sprintf("listest%d", rInt),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+					"skip_confirmation",
+				},
+			},
 			// Create a user with blocked state
 			{
 				Config: testAccGitlabUserConfigBlocked(rInt, password),
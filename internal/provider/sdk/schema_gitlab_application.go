@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+)
+
+var validApplicationScopes = []string{"api", "read_api", "read_user", "read_repository", "write_repository", "read_registry", "write_registry", "sudo", "admin_mode", "openid", "profile", "email"}
+
+func gitlabApplicationGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"application_id": {
+			Description: "The OAuth2 client ID of the application.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the application.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"redirect_url": {
+			Description: "The URL to redirect to after the authorization. Multiple URLs can be separated by newlines.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"scopes": {
+			Description: "The scopes of the application. **Note**: the scopes cannot be read from the GitLab API, so they are stored from the configuration by the first apply after an import.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(validApplicationScopes, false),
+			},
+		},
+		"confidential": {
+			Description: "Whether the application is used where the client secret can be kept confidential, e.g. by a backend service. Defaults to `true`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			ForceNew:    true,
+		},
+		"secret": {
+			Description: "The OAuth2 client secret of the application. **Note**: the secret is not available for imported resources.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+	}
+}
+
+func gitlabApplicationToStateMap(application *gitlab.Application) map[string]interface{} {
+	stateMap := make(map[string]interface{})
+	stateMap["application_id"] = application.ApplicationID
+	stateMap["name"] = application.ApplicationName
+	stateMap["redirect_url"] = application.CallbackURL
+	stateMap["confidential"] = application.Confidential
+	return stateMap
+}
+
+// gitlabApplicationScopesCustomizeDiff recreates the application if its scopes change.
+// The scopes cannot be read from the GitLab API, so they are missing from the state of an imported application.
+// In this case they are stored from the configuration by an update, instead of recreating the application.
+func gitlabApplicationScopesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	previous, _ := d.GetChange("scopes")
+	if d.Id() != "" && previous.(*schema.Set).Len() > 0 && d.HasChange("scopes") {
+		return d.ForceNew("scopes")
+	}
+	return nil
+}
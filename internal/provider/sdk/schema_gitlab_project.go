@@ -0,0 +1,119 @@
+package sdk
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+var (
+	validProjectAccessLevels = []string{
+		"disabled",
+		"private",
+		"enabled",
+	}
+	validProjectAutoDevOpsDeployStrategyValues = []string{
+		"continuous",
+		"manual",
+		"timed_incremental",
+	}
+)
+
+var validContainerExpirationPolicyAttributesCadenceValues = []string{
+	"1d", "7d", "14d", "1month", "3month",
+}
+
+var containerExpirationPolicyAttributesSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"cadence": {
+			Description:      fmt.Sprintf("The cadence of the policy. Valid values are: %s.", utils.RenderValueListForDocs(validContainerExpirationPolicyAttributesCadenceValues)),
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validContainerExpirationPolicyAttributesCadenceValues, false)),
+		},
+		"keep_n": {
+			Description:      "The number of images to keep.",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		},
+		"older_than": {
+			Description: "The number of days to keep images.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"name_regex_delete": {
+			Description: "The regular expression to match image names to delete. **Note**: the upstream API has some inconsistencies with the `name_regex` field here. It's basically unusable at the moment.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"name_regex_keep": {
+			Description: "The regular expression to match image names to keep.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"enabled": {
+			Description: "If true, the policy is enabled.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"next_run_at": {
+			Description: "The next time the policy will run.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+func flattenProjectPushRules(pushRules *gitlab.ProjectPushRules) (values []map[string]interface{}) {
+	if pushRules == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{
+		{
+			"author_email_regex":            pushRules.AuthorEmailRegex,
+			"branch_name_regex":             pushRules.BranchNameRegex,
+			"commit_message_regex":          pushRules.CommitMessageRegex,
+			"commit_message_negative_regex": pushRules.CommitMessageNegativeRegex,
+			"file_name_regex":               pushRules.FileNameRegex,
+			"commit_committer_check":        pushRules.CommitCommitterCheck,
+			"deny_delete_tag":               pushRules.DenyDeleteTag,
+			"member_check":                  pushRules.MemberCheck,
+			"prevent_secrets":               pushRules.PreventSecrets,
+			"reject_unsigned_commits":       pushRules.RejectUnsignedCommits,
+			"max_file_size":                 pushRules.MaxFileSize,
+		},
+	}
+}
+
+func flattenContainerExpirationPolicy(policy *gitlab.ContainerExpirationPolicy) (values []map[string]interface{}) {
+	if policy == nil {
+		return
+	}
+
+	values = []map[string]interface{}{
+		{
+			"cadence":           policy.Cadence,
+			"keep_n":            policy.KeepN,
+			"older_than":        policy.OlderThan,
+			"name_regex_delete": policy.NameRegexDelete,
+			"name_regex_keep":   policy.NameRegexKeep,
+			"enabled":           policy.Enabled,
+		},
+	}
+	if policy.NextRunAt != nil {
+		values[0]["next_run_at"] = policy.NextRunAt.Format(time.RFC3339)
+	}
+	return values
+}
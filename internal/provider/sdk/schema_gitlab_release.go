@@ -0,0 +1,171 @@
+package sdk
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+func gitlabReleaseGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Description: "The ID or full path of the project.",
+			Type:        schema.TypeString,
+			ForceNew:    true,
+			Required:    true,
+		},
+		"tag_name": {
+			Description: "The tag of the release. The tag is created from `ref` if it doesn't exist yet.",
+			Type:        schema.TypeString,
+			ForceNew:    true,
+			Required:    true,
+		},
+		"ref": {
+			Description: "The commit SHA, another tag name, or a branch name to create the tag from, if `tag_name` doesn't exist yet. It's only used to create the release.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return d.Id() != ""
+			},
+		},
+		"name": {
+			Description: "The name of the release. Defaults to the tag name.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"description": {
+			Description: "The description of the release. You can use Markdown.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"milestones": {
+			Description: "The titles of the milestones the release is associated with.",
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+		},
+		"released_at": {
+			Description:      "The date when the release is or was ready, in RFC3339 format. Defaults to the time of the creation. A date in the future marks an upcoming release.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			DiffSuppressFunc: releasedAtSuppressFunc,
+		},
+		"links": {
+			Description: "The asset links of the release. Only the links configured here are managed by this resource, other links of the release, e.g. of `gitlab_release_link` resources, are left as they are. Existing links with the same name are adopted.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: excludeElementsFromSchema(gitlabReleaseLinkGetSchema(), []string{"project", "tag_name"}),
+			},
+		},
+		"created_at": {
+			Description: "The date when the release was created, in RFC3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"upcoming_release": {
+			Description: "Whether the release is an upcoming release, because its `released_at` date is in the future.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"commit_sha": {
+			Description: "The SHA of the commit of the release.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"tag_path": {
+			Description: "The path of the tag of the release.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"evidences": {
+			Description: "The evidences collected for the release.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"sha": {
+						Description: "The SHA of the evidence.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"filepath": {
+						Description: "The URL of the evidence file.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"collected_at": {
+						Description: "The date when the evidence was collected, in RFC3339 format.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// releasedAtSuppressFunc suppresses the diff of dates which describe the same instant in different time zones,
+// because GitLab returns the dates in UTC.
+func releasedAtSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	oldDate, oldDateErr := time.Parse(time.RFC3339, old)
+	newDate, newDateErr := time.Parse(time.RFC3339, new)
+	if oldDateErr != nil || newDateErr != nil {
+		return false
+	}
+	return oldDate.Equal(newDate)
+}
+
+func gitlabReleaseToStateMap(project string, release *api.Release, links []*gitlab.ReleaseLink) map[string]interface{} {
+	stateMap := make(map[string]interface{})
+	stateMap["project"] = project
+	stateMap["tag_name"] = release.TagName
+	stateMap["name"] = release.Name
+	stateMap["description"] = release.Description
+
+	milestones := make([]string, 0, len(release.Milestones))
+	for _, milestone := range release.Milestones {
+		milestones = append(milestones, milestone.Title)
+	}
+	stateMap["milestones"] = milestones
+
+	if release.ReleasedAt != nil {
+		stateMap["released_at"] = release.ReleasedAt.Format(time.RFC3339)
+	}
+	if release.CreatedAt != nil {
+		stateMap["created_at"] = release.CreatedAt.Format(time.RFC3339)
+	}
+	stateMap["upcoming_release"] = release.UpcomingRelease
+	stateMap["commit_sha"] = release.Commit.ID
+	stateMap["tag_path"] = release.TagPath
+
+	stateLinks := make([]map[string]interface{}, 0, len(links))
+	for _, link := range links {
+		stateLink := gitlabReleaseLinkToStateMap(project, release.TagName, link)
+		delete(stateLink, "project")
+		delete(stateLink, "tag_name")
+		stateLinks = append(stateLinks, stateLink)
+	}
+	stateMap["links"] = stateLinks
+
+	evidences := make([]map[string]interface{}, 0, len(release.Evidences))
+	for _, evidence := range release.Evidences {
+		stateEvidence := map[string]interface{}{
+			"sha":      evidence.SHA,
+			"filepath": evidence.Filepath,
+		}
+		if evidence.CollectedAt != nil {
+			stateEvidence["collected_at"] = evidence.CollectedAt.Format(time.RFC3339)
+		}
+		evidences = append(evidences, stateEvidence)
+	}
+	stateMap["evidences"] = evidences
+
+	return stateMap
+}
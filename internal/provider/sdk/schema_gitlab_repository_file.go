@@ -1,6 +1,9 @@
 package sdk
 
 import (
+	"encoding/base64"
+	"log"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
 )
@@ -93,3 +96,25 @@ func gitlabRepositoryFileToStateMap(project string, repositoryFile *gitlab.File)
 	stateMap["last_commit_id"] = repositoryFile.LastCommitID
 	return stateMap
 }
+
+// gitlabRepositoryFileEncodeContent returns the given file content base64 encoded,
+// which is the only encoding supported by the GitLab API for files.
+// For backwards-compatibility reasons, an already base64 encoded content is returned as is.
+func gitlabRepositoryFileEncodeContent(content string) string {
+	if _, err := base64.StdEncoding.DecodeString(content); err != nil {
+		log.Printf("[DEBUG] gitlab_repository_file: given content '%s' is not a valid base64 encoded string, encoding it ...", content)
+		return base64.StdEncoding.EncodeToString([]byte(content))
+	}
+	return content
+}
+
+// gitlabRepositoryFileDecodeContent returns the file content from the API in the same encoding as the configured content.
+// If the configured content is not a base64 encoded string, the base64 encoded content from the API is decoded, too.
+func gitlabRepositoryFileDecodeContent(configContent string, apiContent string) string {
+	if _, err := base64.StdEncoding.DecodeString(configContent); err != nil {
+		if decodedContent, err := base64.StdEncoding.DecodeString(apiContent); err == nil {
+			return string(decodedContent)
+		}
+	}
+	return apiContent
+}
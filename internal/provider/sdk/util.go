@@ -3,16 +3,19 @@ package sdk
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/url"
 	"regexp"
-	"strconv"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
 )
 
 // extractIIDFromGlobalID extracts the internal model ID from a global GraphQL ID.
@@ -21,12 +24,7 @@ import (
 //
 // see https://docs.gitlab.com/ee/development/api_graphql_styleguide.html#global-ids
 func extractIIDFromGlobalID(globalID string) (int, error) {
-	parts := strings.Split(globalID, "/")
-	iid, err := strconv.Atoi(parts[len(parts)-1])
-	if err != nil {
-		return 0, fmt.Errorf("unable to extract iid from global id %q. Was looking for an integer after the last slash (/).", globalID)
-	}
-	return iid, nil
+	return api.ExtractIIDFromGlobalID(globalID)
 }
 
 var validateDateFunc = func(v interface{}, k string) (we []string, errors []error) {
@@ -65,33 +63,6 @@ func stringToVisibilityLevel(s string) *gitlab.VisibilityValue {
 	return &value
 }
 
-func stringToProjectCreationLevel(s string) *gitlab.ProjectCreationLevelValue {
-	lookup := map[string]gitlab.ProjectCreationLevelValue{
-		"noone":      gitlab.NoOneProjectCreation,
-		"maintainer": gitlab.MaintainerProjectCreation,
-		"developer":  gitlab.DeveloperProjectCreation,
-	}
-
-	value, ok := lookup[s]
-	if !ok {
-		return nil
-	}
-	return &value
-}
-
-func stringToSubGroupCreationLevel(s string) *gitlab.SubGroupCreationLevelValue {
-	lookup := map[string]gitlab.SubGroupCreationLevelValue{
-		"owner":      gitlab.OwnerSubGroupCreationLevelValue,
-		"maintainer": gitlab.MaintainerSubGroupCreationLevelValue,
-	}
-
-	value, ok := lookup[s]
-	if !ok {
-		return nil
-	}
-	return &value
-}
-
 func stringToVariableType(s string) *gitlab.VariableTypeValue {
 	lookup := map[string]gitlab.VariableTypeValue{
 		"env_var": gitlab.EnvVariableType,
@@ -105,50 +76,6 @@ func stringToVariableType(s string) *gitlab.VariableTypeValue {
 	return &value
 }
 
-func stringToMergeMethod(s string) *gitlab.MergeMethodValue {
-	lookup := map[string]gitlab.MergeMethodValue{
-		"merge":        gitlab.NoFastForwardMerge,
-		"ff":           gitlab.FastForwardMerge,
-		"rebase_merge": gitlab.RebaseMerge,
-	}
-
-	value, ok := lookup[s]
-	if !ok {
-		return nil
-	}
-	return &value
-}
-
-func stringToSquashOptionValue(s string) *gitlab.SquashOptionValue {
-	lookup := map[string]gitlab.SquashOptionValue{
-		"never":       gitlab.SquashOptionNever,
-		"always":      gitlab.SquashOptionAlways,
-		"default_on":  gitlab.SquashOptionDefaultOn,
-		"default_off": gitlab.SquashOptionDefaultOff,
-	}
-
-	value, ok := lookup[s]
-	if !ok {
-		return nil
-	}
-	return &value
-}
-
-func stringToAccessControlValue(s string) *gitlab.AccessControlValue {
-	lookup := map[string]gitlab.AccessControlValue{
-		"disabled": gitlab.DisabledAccessControl,
-		"enabled":  gitlab.EnabledAccessControl,
-		"private":  gitlab.PrivateAccessControl,
-		"public":   gitlab.PublicAccessControl,
-	}
-
-	value, ok := lookup[s]
-	if !ok {
-		return nil
-	}
-	return &value
-}
-
 // lintignore: V011 // TODO: Resolve this tfproviderlint issue
 var StringIsGitlabVariableName = func(v interface{}, k string) (s []string, es []error) {
 	value, ok := v.(string)
@@ -225,41 +152,6 @@ func intSetToIntSlice(intSet *schema.Set) *[]int {
 	return &ret
 }
 
-func intListToIntSlice(intList []interface{}) *[]int {
-	ret := []int{}
-	if intList == nil {
-		return &ret
-	}
-	for _, envVal := range intList {
-		ret = append(ret, envVal.(int))
-	}
-	return &ret
-}
-
-func stringListToVisibilityLevelSlice(strings []interface{}) *[]gitlab.VisibilityValue {
-	ret := []gitlab.VisibilityValue{}
-	if strings == nil {
-		return &ret
-	}
-	for _, envVal := range strings {
-		ret = append(ret, *stringToVisibilityLevel(envVal.(string)))
-	}
-	return &ret
-}
-
-func stringListToCommaSeparatedString(stringList []interface{}) *string {
-	ret := strings.Join(*stringListToStringSlice(stringList), ",")
-	return &ret
-}
-
-func fromIntegerMap(value interface{}) map[string]int {
-	integerMap := make(map[string]int)
-	for k, v := range value.(map[string]interface{}) {
-		integerMap[k] = v.(int)
-	}
-	return integerMap
-}
-
 func isCurrentUserAdmin(ctx context.Context, client *gitlab.Client) (bool, error) {
 	currentUser, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
 	if err != nil {
@@ -401,6 +293,56 @@ func setStateMapInResourceData(stateMap map[string]interface{}, d *schema.Resour
 	return nil
 }
 
+// idLookupFunc resolves a human-readable identifier, like a full path or a username, to its canonical ID.
+// The value of the first piece of a two part ID is passed as parent, e.g. the project of a project hook.
+type idLookupFunc func(ctx context.Context, client *gitlab.Client, parent string, value string) (string, error)
+
+// lookupGroupID is an idLookupFunc for the full path of a group.
+func lookupGroupID(ctx context.Context, client *gitlab.Client, _ string, group string) (string, error) {
+	return api.LookupGroupID(ctx, client, group)
+}
+
+// lookupProjectID is an idLookupFunc for the full path of a project.
+func lookupProjectID(ctx context.Context, client *gitlab.Client, _ string, project string) (string, error) {
+	return api.LookupProjectID(ctx, client, project)
+}
+
+// lookupUserID is an idLookupFunc for the username of a user.
+func lookupUserID(ctx context.Context, client *gitlab.Client, _ string, username string) (string, error) {
+	return api.LookupUserID(ctx, client, username)
+}
+
+// importStateTwoPartIDWithLookup returns an importer for resources with an ID of the form `a:b`,
+// which also accepts full paths and names in place of the numeric IDs.
+// They are resolved with the given lookups, so that the imported ID is always the canonical one.
+// A nil lookup keeps the piece as given, e.g. for attributes which accept either the ID or the full path.
+func importStateTwoPartIDWithLookup(lookupFirst idLookupFunc, lookupSecond idLookupFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		client := meta.(*api.ProviderData).Client
+
+		var first func(ctx context.Context, a string) (string, error)
+		if lookupFirst != nil {
+			first = func(ctx context.Context, a string) (string, error) {
+				return lookupFirst(ctx, client, "", a)
+			}
+		}
+		var second func(ctx context.Context, a string, b string) (string, error)
+		if lookupSecond != nil {
+			second = func(ctx context.Context, a string, b string) (string, error) {
+				return lookupSecond(ctx, client, a, b)
+			}
+		}
+
+		a, b, err := utils.ParseTwoPartIDWithLookup(ctx, d.Id(), first, second)
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(utils.BuildTwoPartID(&a, &b))
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
 // lock can be used to lock, but make it `context.Context` aware.
 // e.g. it'll respect cancelling and timeouts.
 type lock chan struct{}
@@ -424,3 +366,137 @@ func (c lock) lock(ctx context.Context) error {
 func (c lock) unlock() {
 	<-c
 }
+
+// keyedLock can be used to lock per key, e.g. to serialize operations
+// on the same project and branch while operations for other keys run in parallel.
+// Like `lock`, it is `context.Context` aware.
+type keyedLock struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLockEntry
+}
+
+type keyedLockEntry struct {
+	lock lock
+	// refs counts the holders and waiters of the lock,
+	// it's used to clean up unused locks.
+	refs int
+}
+
+func newKeyedLock() *keyedLock {
+	return &keyedLock{locks: make(map[string]*keyedLockEntry)}
+}
+
+func (k *keyedLock) lock(ctx context.Context, key string) error {
+	k.mu.Lock()
+	entry, ok := k.locks[key]
+	if !ok {
+		entry = &keyedLockEntry{lock: newLock()}
+		k.locks[key] = entry
+	}
+	entry.refs++
+	k.mu.Unlock()
+
+	if err := entry.lock.lock(ctx); err != nil {
+		k.release(key)
+		return err
+	}
+	return nil
+}
+
+func (k *keyedLock) unlock(key string) {
+	k.mu.Lock()
+	entry := k.locks[key]
+	k.mu.Unlock()
+
+	entry.lock.unlock()
+	k.release(key)
+}
+
+func (k *keyedLock) release(key string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	entry := k.locks[key]
+	entry.refs--
+	if entry.refs == 0 {
+		delete(k.locks, key)
+	}
+}
+
+// NOTE: this lock is used to prevent parallel writes to the same branch of a repository.
+//
+//	If it is written concurrently, the API will return a 400 error along the lines of:
+//	```
+//	(400 Bad Request) DELETE https://gitlab.com/api/v4/projects/30716/repository/files/somefile.yaml: 400
+//	{message: 9:Could not update refs/heads/master. Please refresh and try again..}
+//	```
+//
+//	Writes to different projects or branches are not serialized.
+//	The project is resolved to its ID first, so that the same project referenced
+//	once by ID and once by full path is serialized as well.
+var repositoryBranchWriteLock = newKeyedLock()
+
+// repositoryBranchProjectIDs caches the IDs of the projects resolved for the lock keys,
+// keyed by the API base URL and the project as given in the configuration.
+// Projects can't change their ID, so the cached IDs are never invalidated.
+var repositoryBranchProjectIDs = struct {
+	mu  sync.Mutex
+	ids map[string]string
+}{ids: make(map[string]string)}
+
+// lockRepositoryBranch acquires the write lock for the given project and branch.
+// Every successful call must be followed by a call to the returned unlock function.
+func lockRepositoryBranch(ctx context.Context, client *gitlab.Client, project string, branch string) (func(), error) {
+	key := repositoryBranchWriteLockKey(repositoryBranchProjectID(ctx, client, project), branch)
+	if err := repositoryBranchWriteLock.lock(ctx, key); err != nil {
+		return nil, err
+	}
+	return func() { repositoryBranchWriteLock.unlock(key) }, nil
+}
+
+// repositoryBranchProjectID resolves the project to its ID for the lock key.
+// If the project can't be resolved, it's used as given, the error is surfaced by the following write anyway.
+func repositoryBranchProjectID(ctx context.Context, client *gitlab.Client, project string) string {
+	cacheKey := fmt.Sprintf("%s:%s", client.BaseURL(), project)
+
+	repositoryBranchProjectIDs.mu.Lock()
+	id, ok := repositoryBranchProjectIDs.ids[cacheKey]
+	repositoryBranchProjectIDs.mu.Unlock()
+	if ok {
+		return id
+	}
+
+	id, err := api.LookupProjectID(ctx, client, project)
+	if err != nil {
+		log.Printf("[DEBUG] failed to resolve the project %s for the repository branch write lock: %v", project, err)
+		return project
+	}
+
+	repositoryBranchProjectIDs.mu.Lock()
+	repositoryBranchProjectIDs.ids[cacheKey] = id
+	repositoryBranchProjectIDs.mu.Unlock()
+	return id
+}
+
+func repositoryBranchWriteLockKey(project string, branch string) string {
+	return fmt.Sprintf("%s:%s", project, branch)
+}
+
+// customizeDiffRequireFeature returns a CustomizeDiffFunc which fails the plan if the given attribute is configured,
+// but the GitLab instance doesn't support the given feature from the api.Features registry.
+func customizeDiffRequireFeature(feature string, attribute string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if _, ok := d.GetOk(attribute); !ok {
+			return nil
+		}
+		return meta.(*api.ProviderData).Capabilities.CheckFeatureForAttribute(ctx, feature, attribute)
+	}
+}
+
+// customizeDiffRequireFeatureForResource returns a CustomizeDiffFunc which fails the plan of the given resource,
+// if the GitLab instance doesn't support the given feature from the api.Features registry.
+func customizeDiffRequireFeatureForResource(feature string, resourceType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		return meta.(*api.ProviderData).Capabilities.CheckFeatureForResource(ctx, feature, resourceType)
+	}
+}
@@ -1,9 +1,17 @@
 package sdk
 
 import (
+	"context"
+	"fmt"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/testutil/fakegitlab"
 )
 
 func TestGitlab_extractIIDFromGlobalID(t *testing.T) {
@@ -119,3 +127,130 @@ func TestValidateURLFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestGitlab_keyedLock(t *testing.T) {
+	l := newKeyedLock()
+	ctx := context.Background()
+
+	if err := l.lock(ctx, "project-a:main"); err != nil {
+		t.Fatalf("unexpected error acquiring lock: %v", err)
+	}
+
+	// a different key must not be blocked
+	if err := l.lock(ctx, "project-b:main"); err != nil {
+		t.Fatalf("unexpected error acquiring lock for another key: %v", err)
+	}
+	l.unlock("project-b:main")
+
+	// the same key must be blocked until it's unlocked
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.lock(timeoutCtx, "project-a:main"); err == nil {
+		t.Fatalf("expected lock for the same key to time out")
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		if err := l.lock(ctx, "project-a:main"); err != nil {
+			t.Errorf("unexpected error acquiring lock: %v", err)
+		}
+		close(acquired)
+	}()
+
+	l.unlock("project-a:main")
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatalf("expected lock to be acquired after unlock")
+	}
+	l.unlock("project-a:main")
+
+	if len(l.locks) != 0 {
+		t.Fatalf("expected all unused locks to be cleaned up, got %d", len(l.locks))
+	}
+}
+
+func TestGitlab_lockRepositoryBranch(t *testing.T) {
+	server := fakegitlab.NewServer()
+	defer server.Close()
+	client, err := gitlab.NewClient(fakegitlab.Token, gitlab.WithBaseURL(server.URL()))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	group, _, err := client.Groups.CreateGroup(&gitlab.CreateGroupOptions{Name: gitlab.String("Foo"), Path: gitlab.String("foo")})
+	if err != nil {
+		t.Fatalf("failed to create group: %v", err)
+	}
+	project, _, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.String("Bar"), NamespaceID: gitlab.Int(group.ID)})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	ctx := context.Background()
+
+	unlock, err := lockRepositoryBranch(ctx, client, "foo/bar", "main")
+	if err != nil {
+		t.Fatalf("unexpected error acquiring lock: %v", err)
+	}
+
+	// the same project referenced by its ID must be blocked until it's unlocked
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := lockRepositoryBranch(timeoutCtx, client, strconv.Itoa(project.ID), "main"); err == nil {
+		t.Fatalf("expected lock for the same project referenced by its ID to time out")
+	}
+	unlock()
+
+	// the resolved project ID is cached
+	requests := len(server.Requests())
+	unlock, err = lockRepositoryBranch(ctx, client, "foo/bar", "main")
+	if err != nil {
+		t.Fatalf("unexpected error acquiring lock: %v", err)
+	}
+	unlock()
+	if len(server.Requests()) != requests {
+		t.Fatalf("expected the project ID to be cached, got the requests %v", server.Requests()[requests:])
+	}
+}
+
+func TestGitlab_importStateTwoPartIDWithLookup(t *testing.T) {
+	lookupFirst := func(ctx context.Context, client *gitlab.Client, parent string, value string) (string, error) {
+		if parent != "" {
+			t.Errorf("expected no parent for the first piece, got %q", parent)
+		}
+		return map[string]string{"my-org/team": "7"}[value], nil
+	}
+	lookupSecond := func(ctx context.Context, client *gitlab.Client, parent string, value string) (string, error) {
+		if value == "https://hooks.example/ci" {
+			return parent + "-hook", nil
+		}
+		return "", fmt.Errorf("unknown hook %q", value)
+	}
+
+	cases := []struct {
+		lookupFirst idLookupFunc
+		id          string
+		expectedID  string
+	}{
+		{lookupFirst: lookupFirst, id: "my-org/team:https://hooks.example/ci", expectedID: "7:7-hook"},
+		{lookupFirst: nil, id: "my-org/team:https://hooks.example/ci", expectedID: "my-org/team:my-org/team-hook"},
+	}
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		d.SetId(tc.id)
+
+		_, err := importStateTwoPartIDWithLookup(tc.lookupFirst, lookupSecond)(context.Background(), d, &api.ProviderData{})
+		if err != nil {
+			t.Fatalf("unexpected error importing %q: %v", tc.id, err)
+		}
+		if d.Id() != tc.expectedID {
+			t.Errorf("expected the imported ID %q, got %q", tc.expectedID, d.Id())
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("my-org/team:https://hooks.example/unknown")
+	if _, err := importStateTwoPartIDWithLookup(lookupFirst, lookupSecond)(context.Background(), d, &api.ProviderData{}); err == nil {
+		t.Errorf("expected an error for an unknown hook")
+	}
+}
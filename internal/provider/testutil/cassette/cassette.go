@@ -0,0 +1,301 @@
+// Package cassette records the interactions with the GitLab API to files and replays them without a GitLab instance.
+//
+// A cassette is recorded by running a test against a GitLab instance. The tokens and the credentials returned by specific endpoints
+// are scrubbed with the same regexes as they are masked in the logs, see utils.MaskSensitiveValues.
+// When replayed, each request is answered with the recorded response of an interaction with the same method, URL and body.
+// The write requests are replayed in the recorded order, while a read request is answered with the latest response recorded
+// before the next write, so that a test may refresh its resources more or less often than when the cassette was recorded.
+// The cassette also holds a seed for the random values of the test, so that the requests are deterministic.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/utils"
+)
+
+// Mode is the mode of a Recorder.
+type Mode string
+
+const (
+	// ModeRecord sends the requests to the GitLab instance and records the interactions.
+	ModeRecord Mode = "record"
+	// ModeReplay answers the requests with the recorded interactions without sending them.
+	ModeReplay Mode = "replay"
+)
+
+// Cassette is the content of a cassette file.
+type Cassette struct {
+	// Seed is the seed for the random values of the test, e.g. the names of the created resources.
+	Seed         int64         `json:"seed"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request with its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. The URL only contains the path and the query, so that cassettes don't depend on the host.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder records or replays the interactions of a single cassette.
+type Recorder struct {
+	path string
+	mode Mode
+
+	mu       sync.Mutex
+	cassette Cassette
+	// used are the replayed write interactions.
+	used []bool
+	// position is the index of the last replayed write interaction.
+	position int
+}
+
+// New creates a recorder for the cassette file at the given path.
+// In replay mode, the cassette is loaded from the file, otherwise it's written by Save.
+func New(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, position: -1}
+
+	switch mode {
+	case ModeRecord:
+		r.cassette.Seed = time.Now().UnixNano()
+	case ModeReplay:
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		if err := json.Unmarshal(content, &r.cassette); err != nil {
+			return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("invalid cassette mode %q, valid modes are %q and %q", mode, ModeRecord, ModeReplay)
+	}
+
+	return r, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Seed returns the seed for the random values of the test.
+func (r *Recorder) Seed() int64 {
+	return r.cassette.Seed
+}
+
+// Save scrubs the recorded interactions and writes them to the cassette file. It's a no-op in replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cassette := Cassette{Seed: r.cassette.Seed, Interactions: make([]Interaction, 0, len(r.cassette.Interactions))}
+	for _, interaction := range r.cassette.Interactions {
+		cassette.Interactions = append(cassette.Interactions, scrubInteraction(interaction))
+	}
+
+	content, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, append(content, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+// Transport returns a transport which records the interactions sent with next, or replays them.
+// next is not used in replay mode.
+func (r *Recorder) Transport(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if r.mode == ModeReplay {
+			return r.replay(req)
+		}
+		return r.record(next, req)
+	})
+}
+
+func (r *Recorder) record(next http.RoundTripper, req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request:  Request{Method: req.Method, URL: req.URL.RequestURI(), Body: reqBody},
+		Response: Response{StatusCode: resp.StatusCode, Headers: resp.Header.Clone(), Body: respBody},
+	})
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	// The recorded requests are scrubbed, so the request must be scrubbed the same way to match them.
+	request := scrubRequest(Request{Method: req.Method, URL: req.URL.RequestURI(), Body: reqBody})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	match := -1
+	if isWriteMethod(request.Method) {
+		for i, interaction := range r.cassette.Interactions {
+			if !r.used[i] && interaction.Request == request {
+				match = i
+				break
+			}
+		}
+	} else {
+		// Prefer the latest interaction before the next write, i.e. the current state of the object,
+		// otherwise the first one after it, e.g. for a read which wasn't recorded before the next write.
+		next := r.nextWrite()
+		for i, interaction := range r.cassette.Interactions {
+			if i >= next && match >= 0 {
+				break
+			}
+			if interaction.Request == request {
+				match = i
+				if i >= next {
+					break
+				}
+			}
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("no interaction recorded in cassette %s for %s %s", r.path, request.Method, request.URL)
+	}
+	if isWriteMethod(request.Method) {
+		r.used[match] = true
+		r.position = match
+	}
+
+	recorded := r.cassette.Interactions[match].Response
+	header := recorded.Headers.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// nextWrite returns the index of the first write interaction after the last replayed one,
+// or the number of interactions if there is none.
+func (r *Recorder) nextWrite() int {
+	for i := r.position + 1; i < len(r.cassette.Interactions); i++ {
+		if isWriteMethod(r.cassette.Interactions[i].Request.Method) {
+			return i
+		}
+	}
+	return len(r.cassette.Interactions)
+}
+
+// isWriteMethod returns true if requests with the method may change the state of the GitLab instance.
+func isWriteMethod(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead
+}
+
+// readBody reads the body and replaces it with a copy, so that it can still be read by the caller.
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+	content, err := io.ReadAll(*body)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read body: %w", err)
+	}
+	if err := (*body).Close(); err != nil {
+		return "", fmt.Errorf("failed to close body: %w", err)
+	}
+	*body = io.NopCloser(bytes.NewReader(content))
+	return string(content), nil
+}
+
+func scrubRequest(request Request) Request {
+	path := requestPath(request.URL)
+	request.URL = utils.MaskSensitiveValues(path, request.URL)
+	request.Body = utils.MaskSensitiveValues(path, request.Body)
+	return request
+}
+
+func scrubInteraction(interaction Interaction) Interaction {
+	interaction.Request = scrubRequest(interaction.Request)
+	path := requestPath(interaction.Request.URL)
+
+	headers := make(http.Header)
+	for name, values := range interaction.Response.Headers {
+		if utils.IsSensitiveHeader(name) || strings.EqualFold(name, "Set-Cookie") {
+			continue
+		}
+		for _, value := range values {
+			headers.Add(name, utils.MaskSensitiveValues(path, value))
+		}
+	}
+	interaction.Response.Headers = headers
+	interaction.Response.Body = utils.MaskSensitiveValues(path, interaction.Response.Body)
+	return interaction
+}
+
+// requestPath returns the path of the recorded request URL, which decides the fields scrubbed from the interaction.
+func requestPath(requestURI string) string {
+	path, _, _ := strings.Cut(requestURI, "?")
+	return path
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,891 @@
+package fakegitlab
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var (
+	routeNotFound    = &apiError{status: http.StatusNotFound, message: "404 Not Found"}
+	methodNotAllowed = &apiError{status: http.StatusMethodNotAllowed, message: "405 Method Not Allowed"}
+)
+
+// rootUser is the user which owns the Token.
+var rootUser = object{
+	"id":       1,
+	"username": "root",
+	"name":     "Administrator",
+	"email":    "admin@example.com",
+	"state":    "active",
+	"is_admin": true,
+}
+
+// kind describes a collection of objects nested in a project or group, e.g. hooks.
+type kind struct {
+	// name is used in error messages, e.g. `Hook`.
+	name string
+	// key is the attribute which identifies an object in the path, alternativeKey is another one, e.g. the name of a label.
+	key            string
+	alternativeKey string
+	// create builds a new object from the parameters.
+	create func(s *Server, collection []object, owner object, params object) (object, *apiError)
+	// update applies the parameters to an object. If it's nil, the parameters are merged into the object.
+	update func(collection []object, obj object, params object) *apiError
+}
+
+var memberKind = kind{
+	name: "Member",
+	key:  "id",
+	create: func(s *Server, collection []object, owner object, params object) (object, *apiError) {
+		userID, ok := intParam(params, "user_id")
+		if !ok {
+			return nil, badRequest("user_id is missing")
+		}
+		if _, ok := intParam(params, "access_level"); !ok {
+			return nil, badRequest("access_level is missing")
+		}
+		if find(collection, "id", "", fmt.Sprint(userID)) != -1 {
+			return nil, &apiError{status: http.StatusConflict, message: "Member already exists"}
+		}
+		member := object{
+			"id":         userID,
+			"username":   fmt.Sprintf("user%d", userID),
+			"name":       fmt.Sprintf("User %d", userID),
+			"state":      "active",
+			"expires_at": nil,
+		}
+		merge(member, params, "user_id")
+		return member, nil
+	},
+}
+
+var variableKind = kind{
+	name: "Variable",
+	key:  "key",
+	create: func(s *Server, collection []object, owner object, params object) (object, *apiError) {
+		key, ok := stringParam(params, "key")
+		if !ok || key == "" {
+			return nil, badRequest("key is missing")
+		}
+		if _, ok := stringParam(params, "value"); !ok {
+			return nil, badRequest("value is missing")
+		}
+		variable := object{
+			"variable_type":     "env_var",
+			"protected":         false,
+			"masked":            false,
+			"raw":               false,
+			"environment_scope": "*",
+		}
+		merge(variable, params)
+		for _, other := range collection {
+			if other["key"] == key && other["environment_scope"] == variable["environment_scope"] {
+				return nil, badRequest(object{"key": []string{fmt.Sprintf("(%s) has already been taken", key)}})
+			}
+		}
+		return variable, nil
+	},
+}
+
+var hookKind = kind{
+	name: "Hook",
+	key:  "id",
+	create: func(s *Server, collection []object, owner object, params object) (object, *apiError) {
+		if _, ok := stringParam(params, "url"); !ok {
+			return nil, badRequest("url is missing")
+		}
+		hook := object{
+			"id":                      s.newID(),
+			"push_events":             true,
+			"issues_events":           false,
+			"merge_requests_events":   false,
+			"tag_push_events":         false,
+			"note_events":             false,
+			"job_events":              false,
+			"pipeline_events":         false,
+			"wiki_page_events":        false,
+			"enable_ssl_verification": true,
+			"created_at":              now(),
+		}
+		if _, isGroup := owner["full_path"]; isGroup {
+			hook["group_id"] = owner["id"]
+		} else {
+			hook["project_id"] = owner["id"]
+		}
+		merge(hook, params)
+		// The token is write-only.
+		delete(hook, "token")
+		return hook, nil
+	},
+	update: func(collection []object, obj object, params object) *apiError {
+		merge(obj, params)
+		delete(obj, "token")
+		return nil
+	},
+}
+
+var labelKind = kind{
+	name:           "Label",
+	key:            "id",
+	alternativeKey: "name",
+	create: func(s *Server, collection []object, owner object, params object) (object, *apiError) {
+		name, ok := stringParam(params, "name")
+		if !ok || name == "" {
+			return nil, badRequest("name is missing")
+		}
+		if _, ok := stringParam(params, "color"); !ok {
+			return nil, badRequest("color is missing")
+		}
+		if find(collection, "name", "", name) != -1 {
+			return nil, &apiError{status: http.StatusConflict, message: "Label already exists"}
+		}
+		_, isGroup := owner["full_path"]
+		label := object{
+			"id":               s.newID(),
+			"description":      "",
+			"text_color":       "#FFFFFF",
+			"priority":         nil,
+			"is_project_label": !isGroup,
+		}
+		merge(label, params)
+		return label, nil
+	},
+	update: func(collection []object, obj object, params object) *apiError {
+		if newName, ok := stringParam(params, "new_name"); ok {
+			if i := find(collection, "name", "", newName); i != -1 && collection[i]["id"] != obj["id"] {
+				return &apiError{status: http.StatusConflict, message: "Label already exists"}
+			}
+			obj["name"] = newName
+		}
+		merge(obj, params, "name", "new_name", "label_id")
+		return nil
+	},
+}
+
+var badgeKind = kind{
+	name: "Badge",
+	key:  "id",
+	create: func(s *Server, collection []object, owner object, params object) (object, *apiError) {
+		if _, ok := stringParam(params, "link_url"); !ok {
+			return nil, badRequest("link_url is missing")
+		}
+		if _, ok := stringParam(params, "image_url"); !ok {
+			return nil, badRequest("image_url is missing")
+		}
+		badge := object{
+			"id":   s.newID(),
+			"name": "",
+			"kind": "project",
+		}
+		if _, isGroup := owner["full_path"]; isGroup {
+			badge["kind"] = "group"
+		}
+		merge(badge, params)
+		renderBadgeURLs(badge)
+		return badge, nil
+	},
+	update: func(collection []object, obj object, params object) *apiError {
+		merge(obj, params)
+		renderBadgeURLs(obj)
+		return nil
+	},
+}
+
+// renderBadgeURLs sets the rendered URLs of a badge. The placeholders like `%{project_path}` aren't replaced by the fake.
+func renderBadgeURLs(badge object) {
+	badge["rendered_link_url"] = badge["link_url"]
+	badge["rendered_image_url"] = badge["image_url"]
+}
+
+var protectedBranchKind = kind{
+	name: "Protected Branch",
+	key:  "name",
+	create: func(s *Server, collection []object, owner object, params object) (object, *apiError) {
+		name, ok := stringParam(params, "name")
+		if !ok || name == "" {
+			return nil, badRequest("name is missing")
+		}
+		if find(collection, "name", "", name) != -1 {
+			return nil, &apiError{status: http.StatusConflict, message: fmt.Sprintf("Protected branch '%s' already exists", name)}
+		}
+		protectedBranch := object{
+			"id":                           s.newID(),
+			"name":                         name,
+			"allow_force_push":             false,
+			"code_owner_approval_required": false,
+		}
+		for _, action := range []string{"push", "merge", "unprotect"} {
+			accessLevel, ok := intParam(params, action+"_access_level")
+			if !ok {
+				accessLevel = 40
+			}
+			protectedBranch[action+"_access_levels"] = []object{{
+				"access_level":             accessLevel,
+				"access_level_description": accessLevelDescriptions[accessLevel],
+			}}
+		}
+		merge(protectedBranch, params, "name", "push_access_level", "merge_access_level", "unprotect_access_level")
+		return protectedBranch, nil
+	},
+}
+
+// accessLevelDescriptions are the descriptions of the access levels of protected branches.
+var accessLevelDescriptions = map[int]string{
+	0:  "No one",
+	30: "Developers + Maintainers",
+	40: "Maintainers",
+	60: "Admins",
+}
+
+var branchKind = kind{
+	name: "Branch",
+	key:  "name",
+	create: func(s *Server, collection []object, owner object, params object) (object, *apiError) {
+		name, ok := stringParam(params, "branch")
+		if !ok || name == "" {
+			return nil, badRequest("branch is missing")
+		}
+		ref, ok := stringParam(params, "ref")
+		if !ok || ref == "" {
+			return nil, badRequest("ref is missing")
+		}
+		if find(collection, "name", "", name) != -1 {
+			return nil, badRequest("Branch already exists")
+		}
+		i := findRef(collection, ref)
+		if i == -1 {
+			return nil, badRequest("Invalid reference name: " + ref)
+		}
+		s.copyFiles(owner, collection[i]["name"].(string), name)
+		return newBranch(owner, name, collection[i]["commit"].(object)["id"].(string)), nil
+	},
+}
+
+// route handles a request and writes the response.
+func (s *Server) route(w http.ResponseWriter, r *http.Request, segments []string, params object) {
+	status, result, err := s.handle(r.Method, segments, params)
+	switch {
+	case err != nil:
+		writeError(w, err)
+	case status == http.StatusNoContent:
+		w.WriteHeader(status)
+	default:
+		switch v := result.(type) {
+		case []object:
+			writeList(w, r, v)
+		case []byte:
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(status)
+			_, _ = w.Write(v)
+		default:
+			writeJSON(w, status, v)
+		}
+	}
+}
+
+// handle returns the status code and result of a request. A result of type []object is paginated.
+func (s *Server) handle(method string, segments []string, params object) (int, interface{}, *apiError) {
+	switch segments[0] {
+	case "version":
+		if method == http.MethodGet && len(segments) == 1 {
+			return http.StatusOK, object{"version": Version, "revision": "fake"}, nil
+		}
+	case "metadata":
+		if method == http.MethodGet && len(segments) == 1 {
+			return http.StatusOK, object{
+				"version":    Version,
+				"revision":   "fake",
+				"kas":        object{"enabled": false, "externalUrl": nil, "version": nil},
+				"enterprise": false,
+			}, nil
+		}
+	case "user":
+		if method == http.MethodGet && len(segments) == 1 {
+			return http.StatusOK, rootUser, nil
+		}
+	case "application":
+		if len(segments) == 2 && segments[1] == "settings" {
+			switch method {
+			case http.MethodGet:
+				return http.StatusOK, s.settings, nil
+			case http.MethodPut:
+				merge(s.settings, params)
+				return http.StatusOK, s.settings, nil
+			}
+			return 0, nil, methodNotAllowed
+		}
+	case "projects":
+		if len(segments) == 1 {
+			switch method {
+			case http.MethodGet:
+				return http.StatusOK, filterBySearch(s.projects, params), nil
+			case http.MethodPost:
+				return s.createProject(params)
+			}
+			return 0, nil, methodNotAllowed
+		}
+		i := s.findProject(segments[1])
+		if i == -1 {
+			return 0, nil, notFound("Project")
+		}
+		project := s.projects[i]
+		if len(segments) == 2 {
+			switch method {
+			case http.MethodGet:
+				return http.StatusOK, project, nil
+			case http.MethodPut:
+				return s.updateProject(project, params)
+			case http.MethodDelete:
+				s.deleteProject(i)
+				return http.StatusAccepted, object{"message": "202 Accepted"}, nil
+			}
+			return 0, nil, methodNotAllowed
+		}
+		if segments[2] == "share" {
+			return s.handleShare(method, project, segments[3:], params)
+		}
+		return s.handleChildren(method, project, fmt.Sprintf("projects/%v", project["id"]), segments[2:], params)
+	case "groups":
+		if len(segments) == 1 {
+			switch method {
+			case http.MethodGet:
+				return http.StatusOK, filterBySearch(s.groups, params), nil
+			case http.MethodPost:
+				return s.createGroup(params)
+			}
+			return 0, nil, methodNotAllowed
+		}
+		i := s.findGroup(segments[1])
+		if i == -1 {
+			return 0, nil, notFound("Group")
+		}
+		group := s.groups[i]
+		if len(segments) == 2 {
+			switch method {
+			case http.MethodGet:
+				return http.StatusOK, group, nil
+			case http.MethodPut:
+				merge(group, params, "path", "parent_id", "full_path")
+				return http.StatusOK, group, nil
+			case http.MethodDelete:
+				s.deleteGroup(group["id"])
+				return http.StatusAccepted, object{"message": "202 Accepted"}, nil
+			}
+			return 0, nil, methodNotAllowed
+		}
+		if segments[2] == "subgroups" && len(segments) == 3 && method == http.MethodGet {
+			var subgroups []object
+			for _, subgroup := range s.groups {
+				if subgroup["parent_id"] == group["id"] {
+					subgroups = append(subgroups, subgroup)
+				}
+			}
+			return http.StatusOK, subgroups, nil
+		}
+		if segments[2] == "projects" && len(segments) == 3 && method == http.MethodGet {
+			// The projects shared with the group are included, unless `with_shared` is false.
+			withShared, _ := stringParam(params, "with_shared")
+			var projects []object
+			for _, project := range s.projects {
+				if project["namespace"].(object)["id"] == group["id"] || (withShared != "false" && isSharedWith(project, group)) {
+					projects = append(projects, project)
+				}
+			}
+			return http.StatusOK, projects, nil
+		}
+		return s.handleChildren(method, group, fmt.Sprintf("groups/%v", group["id"]), segments[2:], params)
+	}
+	return 0, nil, routeNotFound
+}
+
+// handleChildren handles the requests to the collections nested in a project or group.
+func (s *Server) handleChildren(method string, owner object, ownerPath string, segments []string, params object) (int, interface{}, *apiError) {
+	_, isGroup := owner["full_path"]
+
+	switch segments[0] {
+	case "members":
+		segments = segments[1:]
+		// The inherited members are the same as the direct members, because the fake doesn't support inheritance.
+		if len(segments) > 0 && segments[0] == "all" {
+			if method != http.MethodGet {
+				return 0, nil, methodNotAllowed
+			}
+			segments = segments[1:]
+		}
+		return s.handleCollection(method, owner, ownerPath+"/members", memberKind, segments, params)
+	case "variables":
+		return s.handleCollection(method, owner, ownerPath+"/variables", variableKind, segments[1:], params)
+	case "hooks":
+		return s.handleCollection(method, owner, ownerPath+"/hooks", hookKind, segments[1:], params)
+	case "badges":
+		return s.handleCollection(method, owner, ownerPath+"/badges", badgeKind, segments[1:], params)
+	case "protected_branches":
+		if isGroup {
+			break
+		}
+		return s.handleCollection(method, owner, ownerPath+"/protected_branches", protectedBranchKind, segments[1:], params)
+	case "labels":
+		// Labels may also be updated and deleted by the name in the parameters.
+		if name, ok := stringParam(params, "name"); ok && len(segments) == 1 && (method == http.MethodPut || method == http.MethodDelete) {
+			segments = append(segments, name)
+		}
+		return s.handleCollection(method, owner, ownerPath+"/labels", labelKind, segments[1:], params)
+	case "repository":
+		if isGroup || len(segments) < 2 {
+			break
+		}
+		switch segments[1] {
+		case "branches":
+			if method == http.MethodPut {
+				return 0, nil, methodNotAllowed
+			}
+			return s.handleCollection(method, owner, ownerPath+"/branches", branchKind, segments[2:], params)
+		case "files":
+			if len(segments) < 3 {
+				break
+			}
+			raw := len(segments) == 4 && segments[3] == "raw"
+			if len(segments) > 4 || (len(segments) == 4 && !raw) {
+				break
+			}
+			return s.handleFile(method, owner, ownerPath, segments[2], raw, params)
+		}
+	}
+	return 0, nil, routeNotFound
+}
+
+// handleCollection handles the list, create, read, update and delete requests of a collection.
+func (s *Server) handleCollection(method string, owner object, collectionPath string, k kind, segments []string, params object) (int, interface{}, *apiError) {
+	collection := s.children[collectionPath]
+
+	if len(segments) == 0 {
+		switch method {
+		case http.MethodGet:
+			return http.StatusOK, append([]object{}, collection...), nil
+		case http.MethodPost:
+			obj, err := k.create(s, collection, owner, params)
+			if err != nil {
+				return 0, nil, err
+			}
+			s.children[collectionPath] = append(collection, obj)
+			return http.StatusCreated, obj, nil
+		}
+		return 0, nil, methodNotAllowed
+	}
+	if len(segments) > 1 {
+		return 0, nil, routeNotFound
+	}
+
+	i := find(collection, k.key, k.alternativeKey, segments[0])
+	if i == -1 {
+		return 0, nil, notFound(k.name)
+	}
+	switch method {
+	case http.MethodGet:
+		return http.StatusOK, collection[i], nil
+	case http.MethodPut:
+		if k.update != nil {
+			if err := k.update(collection, collection[i], params); err != nil {
+				return 0, nil, err
+			}
+		} else {
+			merge(collection[i], params, k.key)
+		}
+		return http.StatusOK, collection[i], nil
+	case http.MethodDelete:
+		s.children[collectionPath] = append(collection[:i:i], collection[i+1:]...)
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, methodNotAllowed
+}
+
+// handleFile handles the requests of the repository files API.
+// The files are stored per branch in the collection `projects/:id/files/:branch`.
+func (s *Server) handleFile(method string, project object, projectPath string, filePath string, raw bool, params object) (int, interface{}, *apiError) {
+	branches := s.children[projectPath+"/branches"]
+
+	if method == http.MethodGet {
+		ref, ok := stringParam(params, "ref")
+		if !ok || ref == "" {
+			return 0, nil, badRequest("ref is missing")
+		}
+		b := findRef(branches, ref)
+		if b == -1 {
+			return 0, nil, notFound("File")
+		}
+		branch := branches[b]["name"].(string)
+		files := s.children[projectPath+"/files/"+branch]
+		i := find(files, "file_path", "", filePath)
+		if i == -1 {
+			return 0, nil, notFound("File")
+		}
+		content := files[i]["content"].(string)
+		if raw {
+			return http.StatusOK, []byte(content), nil
+		}
+		return http.StatusOK, object{
+			"file_name":      filePath[strings.LastIndex(filePath, "/")+1:],
+			"file_path":      filePath,
+			"size":           len(content),
+			"encoding":       "base64",
+			"content":        base64.StdEncoding.EncodeToString([]byte(content)),
+			"ref":            ref,
+			"blob_id":        fmt.Sprintf("%040x", len(content)),
+			"commit_id":      branches[b]["commit"].(object)["id"],
+			"last_commit_id": files[i]["last_commit_id"],
+		}, nil
+	}
+
+	if raw || (method != http.MethodPost && method != http.MethodPut && method != http.MethodDelete) {
+		return 0, nil, methodNotAllowed
+	}
+
+	branch, ok := stringParam(params, "branch")
+	if !ok || branch == "" {
+		return 0, nil, badRequest("branch is missing")
+	}
+	if _, ok := stringParam(params, "commit_message"); !ok {
+		return 0, nil, badRequest("commit_message is missing")
+	}
+	b := find(branches, "name", "", branch)
+	if b == -1 {
+		startBranch, ok := stringParam(params, "start_branch")
+		if !ok || find(branches, "name", "", startBranch) == -1 {
+			return 0, nil, badRequest("You can only create or edit files when you are on a branch")
+		}
+		_, created, err := s.handleCollection(http.MethodPost, project, projectPath+"/branches", branchKind, nil, object{"branch": branch, "ref": startBranch})
+		if err != nil {
+			return 0, nil, err
+		}
+		branches = s.children[projectPath+"/branches"]
+		b = find(branches, "name", "", created.(object)["name"].(string))
+	}
+
+	filesPath := projectPath + "/files/" + branch
+	files := s.children[filesPath]
+	i := find(files, "file_path", "", filePath)
+
+	var content string
+	if method != http.MethodDelete {
+		c, ok := stringParam(params, "content")
+		if !ok {
+			return 0, nil, badRequest("content is missing")
+		}
+		content = c
+		if encoding, _ := stringParam(params, "encoding"); encoding == "base64" {
+			decoded, err := base64.StdEncoding.DecodeString(c)
+			if err != nil {
+				return 0, nil, badRequest("content is not valid base64")
+			}
+			content = string(decoded)
+		}
+	}
+
+	if method == http.MethodPost && i != -1 {
+		return 0, nil, badRequest("A file with this name already exists")
+	}
+	if method != http.MethodPost && i == -1 {
+		return 0, nil, badRequest("A file with this name doesn't exist")
+	}
+
+	// Every change is a new commit on the branch.
+	commitID := s.newCommitID()
+	branches[b]["commit"] = object{"id": commitID, "short_id": commitID[:8], "title": params["commit_message"]}
+
+	switch method {
+	case http.MethodPost:
+		s.children[filesPath] = append(files, object{"file_path": filePath, "content": content, "last_commit_id": commitID})
+		return http.StatusCreated, object{"file_path": filePath, "branch": branch}, nil
+	case http.MethodPut:
+		files[i]["content"] = content
+		files[i]["last_commit_id"] = commitID
+		return http.StatusOK, object{"file_path": filePath, "branch": branch}, nil
+	default:
+		s.children[filesPath] = append(files[:i:i], files[i+1:]...)
+		return http.StatusNoContent, nil, nil
+	}
+}
+
+// handleShare handles the requests to share a project with a group and to remove the share.
+func (s *Server) handleShare(method string, project object, segments []string, params object) (int, interface{}, *apiError) {
+	shares, _ := project["shared_with_groups"].([]object)
+
+	switch {
+	case method == http.MethodPost && len(segments) == 0:
+		groupID, _ := stringParam(params, "group_id")
+		i := s.findGroup(groupID)
+		if i == -1 {
+			return 0, nil, notFound("Group")
+		}
+		group := s.groups[i]
+		accessLevel, ok := intParam(params, "group_access")
+		if !ok {
+			return 0, nil, badRequest("group_access is missing")
+		}
+		if isSharedWith(project, group) {
+			return 0, nil, badRequest(object{"base": []string{"Project is already shared with this group"}})
+		}
+		share := object{
+			"group_id":           group["id"],
+			"group_name":         group["name"],
+			"group_full_path":    group["full_path"],
+			"group_access_level": accessLevel,
+			"expires_at":         params["expires_at"],
+		}
+		project["shared_with_groups"] = append(shares, share)
+		return http.StatusCreated, object{"id": s.newID(), "project_id": project["id"], "group_id": group["id"], "group_access": accessLevel, "expires_at": params["expires_at"]}, nil
+	case method == http.MethodDelete && len(segments) == 1:
+		i := find(shares, "group_id", "", segments[0])
+		if i == -1 {
+			return 0, nil, notFound("Group Link")
+		}
+		project["shared_with_groups"] = append(shares[:i:i], shares[i+1:]...)
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, methodNotAllowed
+}
+
+// isSharedWith returns true if the project is shared with the group.
+func isSharedWith(project object, group object) bool {
+	shares, _ := project["shared_with_groups"].([]object)
+	return find(shares, "group_id", "", fmt.Sprint(group["id"])) != -1
+}
+
+func (s *Server) createProject(params object) (int, interface{}, *apiError) {
+	name, _ := stringParam(params, "name")
+	path, _ := stringParam(params, "path")
+	if name == "" && path == "" {
+		return 0, nil, badRequest("name or path is missing")
+	}
+	if name == "" {
+		name = path
+	}
+	if path == "" {
+		path = strings.ToLower(strings.ReplaceAll(name, " ", "-"))
+	}
+
+	namespace := object{"id": 1, "name": "root", "path": "root", "kind": "user", "full_path": "root"}
+	if namespaceID, ok := stringParam(params, "namespace_id"); ok {
+		i := s.findGroup(namespaceID)
+		if i == -1 {
+			return 0, nil, notFound("Namespace")
+		}
+		group := s.groups[i]
+		namespace = object{"id": group["id"], "name": group["name"], "path": group["path"], "kind": "group", "full_path": group["full_path"]}
+	}
+
+	pathWithNamespace := fmt.Sprintf("%s/%s", namespace["full_path"], path)
+	if s.findProject(pathWithNamespace) != -1 {
+		return 0, nil, badRequest(object{"path": []string{"has already been taken"}})
+	}
+
+	project := object{
+		"id":                  s.newID(),
+		"description":         "",
+		"default_branch":      "main",
+		"visibility":          "private",
+		"archived":            false,
+		"import_status":       "none",
+		"created_at":          now(),
+		"namespace":           namespace,
+		"name_with_namespace": fmt.Sprintf("%s / %s", namespace["name"], name),
+	}
+	merge(project, params, "namespace_id", "initialize_with_readme")
+	project["name"] = name
+	project["path"] = path
+	s.setProjectURLs(project, pathWithNamespace)
+	s.projects = append(s.projects, project)
+
+	// Every project is created with a default branch, as if it was initialized with a README.
+	projectPath := fmt.Sprintf("projects/%v", project["id"])
+	defaultBranch := project["default_branch"].(string)
+	s.children[projectPath+"/branches"] = []object{newBranch(project, defaultBranch, s.newCommitID())}
+	s.children[projectPath+"/files/"+defaultBranch] = []object{}
+
+	return http.StatusCreated, project, nil
+}
+
+func (s *Server) updateProject(project object, params object) (int, interface{}, *apiError) {
+	merge(project, params, "namespace_id", "namespace", "path_with_namespace")
+	if _, ok := params["path"]; ok {
+		s.setProjectURLs(project, fmt.Sprintf("%s/%s", project["namespace"].(object)["full_path"], project["path"]))
+	}
+	return http.StatusOK, project, nil
+}
+
+func (s *Server) setProjectURLs(project object, pathWithNamespace string) {
+	project["path_with_namespace"] = pathWithNamespace
+	project["web_url"] = s.server.URL + "/" + pathWithNamespace
+	project["http_url_to_repo"] = s.server.URL + "/" + pathWithNamespace + ".git"
+}
+
+func (s *Server) deleteProject(i int) {
+	prefix := fmt.Sprintf("projects/%v/", s.projects[i]["id"])
+	for path := range s.children {
+		if strings.HasPrefix(path, prefix) {
+			delete(s.children, path)
+		}
+	}
+	s.projects = append(s.projects[:i:i], s.projects[i+1:]...)
+}
+
+func (s *Server) createGroup(params object) (int, interface{}, *apiError) {
+	name, _ := stringParam(params, "name")
+	path, _ := stringParam(params, "path")
+	if name == "" || path == "" {
+		return 0, nil, badRequest("name and path are required")
+	}
+
+	fullPath, fullName := path, name
+	var parentID interface{}
+	if id, ok := stringParam(params, "parent_id"); ok {
+		i := s.findGroup(id)
+		if i == -1 {
+			return 0, nil, notFound("Parent Group")
+		}
+		parentID = s.groups[i]["id"]
+		fullPath = fmt.Sprintf("%s/%s", s.groups[i]["full_path"], path)
+		fullName = fmt.Sprintf("%s / %s", s.groups[i]["full_name"], name)
+	}
+	if s.findGroup(fullPath) != -1 {
+		return 0, nil, badRequest(fmt.Sprintf("Failed to save group {:path=>[%q]}", "has already been taken"))
+	}
+
+	group := object{
+		"id":          s.newID(),
+		"description": "",
+		"visibility":  "private",
+		"created_at":  now(),
+	}
+	merge(group, params)
+	group["parent_id"] = parentID
+	group["full_path"] = fullPath
+	group["full_name"] = fullName
+	group["web_url"] = s.server.URL + "/groups/" + fullPath
+	s.groups = append(s.groups, group)
+
+	return http.StatusCreated, group, nil
+}
+
+// deleteGroup deletes the group including its subgroups and projects.
+func (s *Server) deleteGroup(id interface{}) {
+	for i := len(s.projects) - 1; i >= 0; i-- {
+		if s.projects[i]["namespace"].(object)["id"] == id {
+			s.deleteProject(i)
+		}
+	}
+	for _, group := range append([]object{}, s.groups...) {
+		if group["parent_id"] == id {
+			s.deleteGroup(group["id"])
+		}
+	}
+
+	prefix := fmt.Sprintf("groups/%v/", id)
+	for path := range s.children {
+		if strings.HasPrefix(path, prefix) {
+			delete(s.children, path)
+		}
+	}
+	if i := find(s.groups, "id", "", fmt.Sprint(id)); i != -1 {
+		s.groups = append(s.groups[:i:i], s.groups[i+1:]...)
+	}
+}
+
+// findProject returns the index of the project with the given ID or full path, or -1.
+func (s *Server) findProject(id string) int {
+	return find(s.projects, "id", "path_with_namespace", id)
+}
+
+// findGroup returns the index of the group with the given ID or full path, or -1.
+func (s *Server) findGroup(id string) int {
+	return find(s.groups, "id", "full_path", id)
+}
+
+// copyFiles copies the files of a branch to a new branch.
+func (s *Server) copyFiles(project object, from string, to string) {
+	projectPath := fmt.Sprintf("projects/%v", project["id"])
+	files := []object{}
+	for _, file := range s.children[projectPath+"/files/"+from] {
+		files = append(files, object{"file_path": file["file_path"], "content": file["content"], "last_commit_id": file["last_commit_id"]})
+	}
+	s.children[projectPath+"/files/"+to] = files
+}
+
+func (s *Server) newID() int {
+	id := s.nextID
+	s.nextID++
+	return id
+}
+
+func (s *Server) newCommitID() string {
+	return fmt.Sprintf("%040x", s.newID())
+}
+
+func newBranch(project object, name string, commitID string) object {
+	return object{
+		"name":                 name,
+		"merged":               false,
+		"protected":            false,
+		"default":              project["default_branch"] == name,
+		"developers_can_push":  false,
+		"developers_can_merge": false,
+		"can_push":             true,
+		"web_url":              fmt.Sprintf("%s/-/tree/%s", project["web_url"], name),
+		"commit":               object{"id": commitID, "short_id": commitID[:8], "title": "Initial commit"},
+	}
+}
+
+// find returns the index of the object whose key or alternative key matches the given value, or -1.
+func find(collection []object, key string, alternativeKey string, value string) int {
+	for i, obj := range collection {
+		if fmt.Sprint(obj[key]) == value || (alternativeKey != "" && fmt.Sprint(obj[alternativeKey]) == value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// findRef returns the index of the branch with the given name or commit ID, or -1.
+func findRef(branches []object, ref string) int {
+	for i, branch := range branches {
+		if branch["name"] == ref || branch["commit"].(object)["id"] == ref {
+			return i
+		}
+	}
+	return -1
+}
+
+// filterBySearch returns the objects whose name contains the `search` parameter.
+func filterBySearch(collection []object, params object) []object {
+	search, _ := stringParam(params, "search")
+	filtered := []object{}
+	for _, obj := range collection {
+		if strings.Contains(fmt.Sprint(obj["name"]), search) || strings.Contains(fmt.Sprint(obj["path"]), search) {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
+
+// merge merges the parameters into the object. Pagination, filter and the excluded parameters are skipped.
+func merge(obj object, params object, exclude ...string) {
+	for k, v := range params {
+		if k == "id" || k == "page" || k == "per_page" || strings.HasPrefix(k, "filter[") {
+			continue
+		}
+		excluded := false
+		for _, e := range exclude {
+			excluded = excluded || k == e
+		}
+		if !excluded {
+			obj[k] = v
+		}
+	}
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,285 @@
+package fakegitlab
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func newTestClient(t *testing.T) (*Server, *gitlab.Client) {
+	t.Helper()
+
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := gitlab.NewClient(Token, gitlab.WithBaseURL(server.URL()))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return server, client
+}
+
+func TestServer_projects(t *testing.T) {
+	_, client := newTestClient(t)
+
+	group, _, err := client.Groups.CreateGroup(&gitlab.CreateGroupOptions{Name: gitlab.String("Foo"), Path: gitlab.String("foo")})
+	if err != nil {
+		t.Fatalf("failed to create group: %v", err)
+	}
+	project, _, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{
+		Name:        gitlab.String("Bar"),
+		NamespaceID: gitlab.Int(group.ID),
+		Description: gitlab.String("description"),
+	})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	if project.PathWithNamespace != "foo/bar" || project.Description != "description" || project.DefaultBranch != "main" {
+		t.Fatalf("got unexpected project: %+v", project)
+	}
+
+	if _, _, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.String("Bar"), NamespaceID: gitlab.Int(group.ID)}); err == nil {
+		t.Fatalf("expected an error for a duplicate project path")
+	}
+
+	project, _, err = client.Projects.EditProject("foo/bar", &gitlab.EditProjectOptions{Description: gitlab.String("updated")})
+	if err != nil || project.Description != "updated" {
+		t.Fatalf("failed to update project by path: %+v, %v", project, err)
+	}
+
+	if _, err := client.Groups.DeleteGroup(group.ID); err != nil {
+		t.Fatalf("failed to delete group: %v", err)
+	}
+	_, resp, err := client.Projects.GetProject(project.ID, nil)
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected the project to be deleted with its group, got %v", err)
+	}
+}
+
+func TestServer_pagination(t *testing.T) {
+	_, client := newTestClient(t)
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		if _, _, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.String(name)}); err != nil {
+			t.Fatalf("failed to create project: %v", err)
+		}
+	}
+
+	var names []string
+	options := &gitlab.ListProjectsOptions{ListOptions: gitlab.ListOptions{PerPage: 2}}
+	for {
+		projects, resp, err := client.Projects.ListProjects(options)
+		if err != nil {
+			t.Fatalf("failed to list projects: %v", err)
+		}
+		for _, project := range projects {
+			names = append(names, project.Name)
+		}
+		if resp.TotalItems != 5 || resp.TotalPages != 3 {
+			t.Fatalf("got %d items on %d pages, expected 5 items on 3 pages", resp.TotalItems, resp.TotalPages)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		options.Page = resp.NextPage
+	}
+
+	if len(names) != 5 || names[0] != "a" || names[4] != "e" {
+		t.Fatalf("got projects %v, expected all projects in order", names)
+	}
+}
+
+func TestServer_children(t *testing.T) {
+	_, client := newTestClient(t)
+
+	project, _, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.String("foo")})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+
+	// Members
+	if _, _, err := client.ProjectMembers.AddProjectMember(project.ID, &gitlab.AddProjectMemberOptions{UserID: 42, AccessLevel: gitlab.AccessLevel(gitlab.DeveloperPermissions)}); err != nil {
+		t.Fatalf("failed to add member: %v", err)
+	}
+	member, _, err := client.ProjectMembers.EditProjectMember(project.ID, 42, &gitlab.EditProjectMemberOptions{AccessLevel: gitlab.AccessLevel(gitlab.MaintainerPermissions)})
+	if err != nil || member.AccessLevel != gitlab.MaintainerPermissions {
+		t.Fatalf("failed to update member: %+v, %v", member, err)
+	}
+
+	// Variables
+	if _, _, err := client.ProjectVariables.CreateVariable(project.ID, &gitlab.CreateProjectVariableOptions{Key: gitlab.String("FOO"), Value: gitlab.String("bar")}); err != nil {
+		t.Fatalf("failed to create variable: %v", err)
+	}
+	if _, _, err := client.ProjectVariables.CreateVariable(project.ID, &gitlab.CreateProjectVariableOptions{Key: gitlab.String("FOO"), Value: gitlab.String("bar")}); err == nil {
+		t.Fatalf("expected an error for a duplicate variable")
+	}
+	variable, _, err := client.ProjectVariables.GetVariable(project.ID, "FOO", nil)
+	if err != nil || variable.Value != "bar" || variable.EnvironmentScope != "*" {
+		t.Fatalf("failed to get variable: %+v, %v", variable, err)
+	}
+
+	// Hooks
+	hook, _, err := client.Projects.AddProjectHook(project.ID, &gitlab.AddProjectHookOptions{URL: gitlab.String("https://example.com"), Token: gitlab.String("secret")})
+	if err != nil || !hook.PushEvents || hook.ProjectID != project.ID {
+		t.Fatalf("failed to create hook: %+v, %v", hook, err)
+	}
+	if _, err := client.Projects.DeleteProjectHook(project.ID, hook.ID); err != nil {
+		t.Fatalf("failed to delete hook: %v", err)
+	}
+	if _, _, err := client.Projects.GetProjectHook(project.ID, hook.ID); err == nil {
+		t.Fatalf("expected the hook to be deleted")
+	}
+
+	// Labels
+	label, _, err := client.Labels.CreateLabel(project.ID, &gitlab.CreateLabelOptions{Name: gitlab.String("bug"), Color: gitlab.String("#FF0000")})
+	if err != nil {
+		t.Fatalf("failed to create label: %v", err)
+	}
+	label, _, err = client.Labels.UpdateLabel(project.ID, &gitlab.UpdateLabelOptions{Name: gitlab.String("bug"), NewName: gitlab.String("defect")})
+	if err != nil || label.Name != "defect" {
+		t.Fatalf("failed to rename label: %+v, %v", label, err)
+	}
+
+	// Badges
+	badge, _, err := client.ProjectBadges.AddProjectBadge(project.ID, &gitlab.AddProjectBadgeOptions{LinkURL: gitlab.String("https://example.com/%{project_path}"), ImageURL: gitlab.String("https://example.com/badge.svg")})
+	if err != nil || badge.Kind != "project" || badge.RenderedLinkURL != badge.LinkURL {
+		t.Fatalf("failed to create badge: %+v, %v", badge, err)
+	}
+
+	// Protected branches
+	protectedBranch, _, err := client.ProtectedBranches.ProtectRepositoryBranches(project.ID, &gitlab.ProtectRepositoryBranchesOptions{Name: gitlab.String("main"), PushAccessLevel: gitlab.AccessLevel(gitlab.DeveloperPermissions)})
+	if err != nil || protectedBranch.PushAccessLevels[0].AccessLevel != gitlab.DeveloperPermissions || protectedBranch.MergeAccessLevels[0].AccessLevel != gitlab.MaintainerPermissions {
+		t.Fatalf("failed to protect branch: %+v, %v", protectedBranch, err)
+	}
+	if _, _, err := client.ProtectedBranches.ProtectRepositoryBranches(project.ID, &gitlab.ProtectRepositoryBranchesOptions{Name: gitlab.String("main")}); err == nil {
+		t.Fatalf("expected an error for an already protected branch")
+	}
+
+	// Branches and files
+	if _, _, err := client.RepositoryFiles.CreateFile(project.ID, "docs/README.md", &gitlab.CreateFileOptions{
+		Branch:        gitlab.String("main"),
+		Content:       gitlab.String("hello"),
+		CommitMessage: gitlab.String("add readme"),
+	}); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+	branch, _, err := client.Branches.CreateBranch(project.ID, &gitlab.CreateBranchOptions{Branch: gitlab.String("feature"), Ref: gitlab.String("main")})
+	if err != nil || branch.Default {
+		t.Fatalf("failed to create branch: %+v, %v", branch, err)
+	}
+	if _, _, err := client.Branches.CreateBranch(project.ID, &gitlab.CreateBranchOptions{Branch: gitlab.String("other"), Ref: gitlab.String("unknown")}); err == nil {
+		t.Fatalf("expected an error for an unknown ref")
+	}
+	file, _, err := client.RepositoryFiles.GetFile(project.ID, "docs/README.md", &gitlab.GetFileOptions{Ref: gitlab.String("feature")})
+	if err != nil || file.Content != "aGVsbG8=" || file.Encoding != "base64" {
+		t.Fatalf("expected the file to be copied to the new branch: %+v, %v", file, err)
+	}
+	raw, _, err := client.RepositoryFiles.GetRawFile(project.ID, "docs/README.md", &gitlab.GetRawFileOptions{Ref: gitlab.String("main")})
+	if err != nil || string(raw) != "hello" {
+		t.Fatalf("failed to get raw file: %q, %v", raw, err)
+	}
+}
+
+func TestServer_subgroups(t *testing.T) {
+	_, client := newTestClient(t)
+
+	parent, _, err := client.Groups.CreateGroup(&gitlab.CreateGroupOptions{Name: gitlab.String("parent"), Path: gitlab.String("parent")})
+	if err != nil {
+		t.Fatalf("failed to create group: %v", err)
+	}
+	if _, _, err := client.Groups.CreateGroup(&gitlab.CreateGroupOptions{Name: gitlab.String("child"), Path: gitlab.String("child"), ParentID: &parent.ID}); err != nil {
+		t.Fatalf("failed to create subgroup: %v", err)
+	}
+
+	subgroups, _, err := client.Groups.ListSubGroups(parent.ID, nil)
+	if err != nil || len(subgroups) != 1 || subgroups[0].FullPath != "parent/child" {
+		t.Fatalf("failed to list subgroups: %+v, %v", subgroups, err)
+	}
+}
+
+func TestServer_sharedProjects(t *testing.T) {
+	_, client := newTestClient(t)
+
+	group, _, err := client.Groups.CreateGroup(&gitlab.CreateGroupOptions{Name: gitlab.String("group"), Path: gitlab.String("group")})
+	if err != nil {
+		t.Fatalf("failed to create group: %v", err)
+	}
+	project, _, err := client.Projects.CreateProject(&gitlab.CreateProjectOptions{Name: gitlab.String("shared")})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	if _, err := client.Projects.ShareProjectWithGroup(project.ID, &gitlab.ShareWithGroupOptions{GroupID: &group.ID, GroupAccess: gitlab.AccessLevel(gitlab.DeveloperPermissions)}); err != nil {
+		t.Fatalf("failed to share project: %v", err)
+	}
+
+	projects, _, err := client.Groups.ListGroupProjects(group.ID, nil)
+	if err != nil || len(projects) != 1 || projects[0].ID != project.ID {
+		t.Fatalf("expected the shared project to be listed by default, got %+v, %v", projects, err)
+	}
+	projects, _, err = client.Groups.ListGroupProjects(group.ID, &gitlab.ListGroupProjectsOptions{WithShared: gitlab.Bool(false)})
+	if err != nil || len(projects) != 0 {
+		t.Fatalf("expected the shared project not to be listed with with_shared=false, got %+v, %v", projects, err)
+	}
+
+	if _, err := client.Projects.DeleteSharedProjectFromGroup(project.ID, group.ID); err != nil {
+		t.Fatalf("failed to remove the share: %v", err)
+	}
+	if projects, _, err := client.Groups.ListGroupProjects(group.ID, nil); err != nil || len(projects) != 0 {
+		t.Fatalf("expected the project not to be listed after the share was removed, got %+v, %v", projects, err)
+	}
+}
+
+func TestServer_injectError(t *testing.T) {
+	server, client := newTestClient(t)
+
+	server.InjectError(http.MethodGet, "projects", http.StatusForbidden, 1)
+	if pending := server.PendingErrors(); pending != 1 {
+		t.Fatalf("got %d pending errors, expected 1", pending)
+	}
+
+	if _, resp, err := client.Projects.ListProjects(nil); err == nil || resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected the injected error, got %v", err)
+	}
+	if _, _, err := client.Projects.ListProjects(nil); err != nil {
+		t.Fatalf("expected the error to be injected only once, got %v", err)
+	}
+	if pending := server.PendingErrors(); pending != 0 {
+		t.Fatalf("got %d pending errors, expected none", pending)
+	}
+
+	requests := server.Requests()
+	if requests[len(requests)-1] != "GET projects" {
+		t.Fatalf("got requests %v, expected the last one to be recorded", requests)
+	}
+}
+
+func TestServer_settings(t *testing.T) {
+	_, client := newTestClient(t)
+
+	settings, _, err := client.Settings.UpdateSettings(&gitlab.UpdateSettingsOptions{AfterSignUpText: gitlab.String("Welcome")})
+	if err != nil {
+		t.Fatalf("failed to update settings: %v", err)
+	}
+	if settings.AfterSignUpText != "Welcome" || settings.DefaultProjectsLimit != 100000 {
+		t.Fatalf("got unexpected settings: %+v", settings)
+	}
+
+	settings, _, err = client.Settings.GetSettings()
+	if err != nil || settings.AfterSignUpText != "Welcome" {
+		t.Fatalf("failed to get updated settings: %+v, %v", settings, err)
+	}
+}
+
+func TestServer_unauthorized(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	client, err := gitlab.NewClient("invalid", gitlab.WithBaseURL(server.URL()))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	if _, resp, err := client.Users.CurrentUser(); err == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/api"
+)
+
+// attributeChanged returns true if the planned value of an attribute is known and differs from its state value.
+// It's used to only send the changed attributes in an update.
+func attributeChanged(plan attr.Value, state attr.Value) bool {
+	return !plan.IsUnknown() && !plan.IsNull() && !plan.Equal(state)
+}
+
+// attributeConfigured returns true if the value of an attribute is known and not null.
+func attributeConfigured(value attr.Value) bool {
+	return !value.IsUnknown() && !value.IsNull()
+}
+
+// stringSetValue returns the known elements of a set of strings as a slice.
+func stringSetValue(ctx context.Context, set types.Set) (*[]string, error) {
+	values := make([]string, 0, len(set.Elements()))
+	if diags := set.ElementsAs(ctx, &values, false); diags.HasError() {
+		return nil, fmt.Errorf("unable to read set of strings: %v", diags)
+	}
+	return &values, nil
+}
+
+// waitFor polls the check function until it returns true or an error, or the timeout has elapsed.
+func waitFor(ctx context.Context, timeout time.Duration, interval time.Duration, check func() (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout after %s", timeout)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// modifyDependentAttributesPlan marks the dependent attributes as unknown whose values are changed upstream when
+// another attribute changes. Dependent attributes which are configured keep their planned value.
+// The changed attributes are processed in sorted order and read from the modified plan, so that changes propagate
+// over multiple dependencies.
+func modifyDependentAttributesPlan(ctx context.Context, dependentAttributes map[string][]string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	attributeNames := make([]string, 0, len(dependentAttributes))
+	for attributeName := range dependentAttributes {
+		attributeNames = append(attributeNames, attributeName)
+	}
+	sort.Strings(attributeNames)
+
+	for _, attributeName := range attributeNames {
+		var planValue, stateValue attr.Value
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(attributeName), &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attributeName), &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !planValue.IsUnknown() && planValue.Equal(stateValue) {
+			continue
+		}
+
+		for _, dependentAttributeName := range dependentAttributes[attributeName] {
+			var configValue attr.Value
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(dependentAttributeName), &configValue)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if !configValue.IsNull() {
+				continue
+			}
+
+			var planDependentValue attr.Value
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(dependentAttributeName), &planDependentValue)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			unknownValue, err := unknownValueOf(ctx, planDependentValue)
+			if err != nil {
+				resp.Diagnostics.AddError("Provider Error, report upstream", err.Error())
+				return
+			}
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(dependentAttributeName), unknownValue)...)
+		}
+	}
+}
+
+// unknownValueOf returns an unknown value of the same type as the given value.
+func unknownValueOf(ctx context.Context, value attr.Value) (attr.Value, error) {
+	switch v := value.(type) {
+	case types.String:
+		return types.StringUnknown(), nil
+	case types.Bool:
+		return types.BoolUnknown(), nil
+	case types.Int64:
+		return types.Int64Unknown(), nil
+	case types.Set:
+		return types.SetUnknown(v.ElementType(ctx)), nil
+	case types.List:
+		return types.ListUnknown(v.ElementType(ctx)), nil
+	default:
+		return nil, fmt.Errorf("unable to create an unknown value for a value of type %T", value)
+	}
+}
+
+// driftReportDiagnostics returns a warning naming the author of the most recent change of an audited resource,
+// if the provider is configured with `drift_report` and any of the attributes differ between the prior and the refreshed state.
+// Attributes which are null in the prior state, e.g. after an import, aren't compared.
+func driftReportDiagnostics(ctx context.Context, data *api.ProviderData, req resource.ReadRequest, resp *resource.ReadResponse, resourceType string, audited api.AuditedResource, attributes ...string) diag.Diagnostics {
+	if !data.DriftReport || req.State.Raw.IsNull() || resp.State.Raw.IsNull() {
+		return nil
+	}
+
+	changed, diags := driftChangedAttributes(ctx, req.State, resp.State, attributes...)
+	if diags.HasError() || len(changed) == 0 {
+		return diags
+	}
+
+	summary, detail := api.DriftWarning(ctx, data, audited, resourceType, changed)
+	diags.AddWarning(summary, detail)
+	return diags
+}
+
+// driftChangedAttributes returns the attributes which differ between the prior and the refreshed state.
+// Attributes which are null in the prior state aren't compared.
+func driftChangedAttributes(ctx context.Context, prior tfsdk.State, refreshed tfsdk.State, attributes ...string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var changed []string
+	for _, attributeName := range attributes {
+		var previousValue, refreshedValue attr.Value
+		diags.Append(prior.GetAttribute(ctx, path.Root(attributeName), &previousValue)...)
+		diags.Append(refreshed.GetAttribute(ctx, path.Root(attributeName), &refreshedValue)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if !previousValue.IsNull() && !previousValue.Equal(refreshedValue) {
+			changed = append(changed, attributeName)
+		}
+	}
+	return changed, diags
+}
+
+// modifyPlanRequireFeature fails the plan of the given resource, unless it's destroyed,
+// if the GitLab instance doesn't support the given feature from the api.Features registry.
+// It's the equivalent of the `customizeDiffRequireFeatureForResource` of the SDK resources.
+func modifyPlanRequireFeature(ctx context.Context, data *api.ProviderData, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, feature string, resourceType string) {
+	// The provider data is not available if the provider is not configured yet, e.g. during the validation.
+	if data == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	if err := data.Capabilities.CheckFeatureForResource(ctx, feature, resourceType); err != nil {
+		resp.Diagnostics.AddError("GitLab feature unavailable", err.Error())
+	}
+}
+
+// modifyPlanRequireFeatureForAttribute fails the plan if the given attribute is configured,
+// but the GitLab instance doesn't support the given feature from the api.Features registry.
+// It's the equivalent of the `customizeDiffRequireFeature` of the SDK resources.
+func modifyPlanRequireFeatureForAttribute(ctx context.Context, data *api.ProviderData, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, feature string, attributeName string) {
+	if data == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configValue attr.Value
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attributeName), &configValue)...)
+	if resp.Diagnostics.HasError() || !isConfigured(configValue) {
+		return
+	}
+	if err := data.Capabilities.CheckFeatureForAttribute(ctx, feature, attributeName); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attributeName), "GitLab feature unavailable", err.Error())
+	}
+}
+
+// isConfigured returns true if the value is neither null nor an empty collection.
+// Unknown values are considered configured, because they may be set once they are known.
+func isConfigured(value attr.Value) bool {
+	if value == nil || value.IsNull() {
+		return false
+	}
+	if value.IsUnknown() {
+		return true
+	}
+	switch v := value.(type) {
+	case types.Set:
+		return len(v.Elements()) > 0
+	case types.List:
+		return len(v.Elements()) > 0
+	case types.Map:
+		return len(v.Elements()) > 0
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDriftChangedAttributes(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":           schema.StringAttribute{Computed: true},
+			"access_level": schema.StringAttribute{Optional: true},
+			"required":     schema.Int64Attribute{Optional: true},
+		},
+	}
+	newState := func(accessLevel interface{}, required interface{}) tfsdk.State {
+		return tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":           tftypes.NewValue(tftypes.String, "42"),
+			"access_level": tftypes.NewValue(tftypes.String, accessLevel),
+			"required":     tftypes.NewValue(tftypes.Number, required),
+		})}
+	}
+
+	cases := []struct {
+		name      string
+		prior     tfsdk.State
+		refreshed tfsdk.State
+		expected  []string
+	}{
+		{
+			name:      "without changes",
+			prior:     newState("developer", 1),
+			refreshed: newState("developer", 1),
+		},
+		{
+			name:      "with changes",
+			prior:     newState("developer", 1),
+			refreshed: newState("maintainer", 2),
+			expected:  []string{"access_level", "required"},
+		},
+		{
+			name:      "null prior values after an import",
+			prior:     newState(nil, nil),
+			refreshed: newState("maintainer", 2),
+		},
+		{
+			name:      "partially null prior values",
+			prior:     newState(nil, 1),
+			refreshed: newState("maintainer", 2),
+			expected:  []string{"required"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			changed, diags := driftChangedAttributes(ctx, tc.prior, tc.refreshed, "access_level", "required")
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(changed, tc.expected) {
+				t.Errorf("expected the changed attributes %v, got %v", tc.expected, changed)
+			}
+		})
+	}
+}
@@ -3,29 +3,149 @@ package utils
 import (
 	"context"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// The regex to use for masking the token configurations
-const gitlabPersonlAccessTokenRegex string = "glpat-.{20}"
+// The regexes to use for masking the GitLab tokens, see https://docs.gitlab.com/ee/security/token_overview.html
+var gitlabTokenRegexes = []*regexp.Regexp{
+	// Personal, project and group access tokens (`glpat-`), deploy tokens (`gldt-`), runner authentication tokens (`glrt-`),
+	// pipeline trigger tokens (`glptt-`), feed tokens (`glft-`), incoming email tokens (`glimt-`), cluster agent tokens (`glagent-`),
+	// OAuth application secrets (`gloas-`), SCIM tokens (`glsoat-`) and CI/CD job tokens (`glcbt-`).
+	regexp.MustCompile(`(glpat|gldt|glrt|glptt|glft|glimt|glagent|gloas|glsoat|glcbt)-[0-9A-Za-z_-]{20,}`),
+	// Runner registration tokens
+	regexp.MustCompile(`GR1348941[0-9A-Za-z_-]{20,}`),
+	// OAuth and other bearer tokens, e.g. in the `Authorization` header.
+	regexp.MustCompile(`(?i)bearer\s+[0-9A-Za-z._~+/=-]+`),
+}
+
+// The fields of the request and response logs which contain the headers used for authentication.
+var gitlabTokenHeaderFieldKeys = []string{
+	"Authorization",
+	"Private-Token",
+	"Job-Token",
+	"X-Gitlab-Token",
+}
+
+// sensitiveBodyFields are the fields of the request and response bodies which contain credentials without a well-known format.
+// They are scoped to the endpoints returning them, because names like `token` or `url` are used for other values by most endpoints.
+var sensitiveBodyFields = []struct {
+	path    *regexp.Regexp
+	regexes []*regexp.Regexp
+}{
+	// The secret tokens of project, group and system hooks.
+	{path: regexp.MustCompile(`/hooks(/|$)`), regexes: sensitiveFieldRegexes("token")},
+	// The secrets of OAuth applications created before they had a prefix.
+	{path: regexp.MustCompile(`/applications(/|$)`), regexes: sensitiveFieldRegexes("secret")},
+	// The tokens of runners, deploy tokens, pipeline triggers and access tokens created before they had a prefix.
+	{path: regexp.MustCompile(`/(runners|deploy_tokens|triggers|access_tokens|personal_access_tokens)(/|$)`), regexes: sensitiveFieldRegexes("token")},
+	// The Kubernetes tokens of clusters.
+	{path: regexp.MustCompile(`/clusters(/|$)`), regexes: sensitiveFieldRegexes("token")},
+	// The URLs of push mirrors, which may contain the credentials of the remote repository.
+	{path: regexp.MustCompile(`/remote_mirrors(/|$)`), regexes: sensitiveFieldRegexes("url")},
+	// The passwords of users and the credentials of integrations.
+	{path: regexp.MustCompile(`/(users|services|integrations)(/|$)`), regexes: sensitiveFieldRegexes("password", "token")},
+}
+
+// sensitiveFieldReplacements are the replacements of the regexes returned by sensitiveFieldRegexes,
+// which keep the field names, so that JSON and form encoded bodies stay valid.
+var sensitiveFieldReplacements = []string{`"$1":"***"`, `${1}${2}=***`}
+
+// sensitiveFieldRegexes returns the regexes matching the values of the given fields in JSON and form encoded bodies.
+func sensitiveFieldRegexes(fields ...string) []*regexp.Regexp {
+	quotedNames := make([]string, len(fields))
+	for i, field := range fields {
+		quotedNames[i] = regexp.QuoteMeta(field)
+	}
+	alternation := strings.Join(quotedNames, "|")
+
+	return []*regexp.Regexp{
+		// JSON bodies, e.g. `"token":"secret"`
+		regexp.MustCompile(`"(` + alternation + `)"\s*:\s*"(?:[^"\\]|\\.)*"`),
+		// Form encoded bodies and query strings, e.g. `token=secret`
+		regexp.MustCompile(`(^|[?&\s])(` + alternation + `)=[^&\s"]*`),
+	}
+}
+
+// sensitiveBodyFieldRegexes returns the regexes matching the sensitive fields of the bodies of the given endpoint path.
+func sensitiveBodyFieldRegexes(path string) []*regexp.Regexp {
+	var regexes []*regexp.Regexp
+	for _, endpoint := range sensitiveBodyFields {
+		if endpoint.path.MatchString(path) {
+			regexes = append(regexes, endpoint.regexes...)
+		}
+	}
+	return regexes
+}
+
+// MaskSensitiveValues masks the GitLab tokens and the sensitive fields of the given endpoint path in the given string
+// with the same regexes as they are masked in the logs.
+// Unlike in the logs, the names of the sensitive fields are kept, so that JSON and form encoded bodies stay valid.
+func MaskSensitiveValues(path string, value string) string {
+	for _, re := range gitlabTokenRegexes {
+		value = re.ReplaceAllString(value, "***")
+	}
+
+	for i, re := range sensitiveBodyFieldRegexes(path) {
+		value = re.ReplaceAllString(value, sensitiveFieldReplacements[i%len(sensitiveFieldReplacements)])
+	}
+	return value
+}
+
+// IsSensitiveHeader returns true if the header is used for authentication and its value must be masked.
+func IsSensitiveHeader(name string) bool {
+	for _, key := range gitlabTokenHeaderFieldKeys {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
 
 // Takes in a provider context, and applies masking to the root and `GitLab` subsystem logger.
 // the `GitLab` subsystem is what is used for logging API calls.
 func ApplyLogMaskingToContext(ctx context.Context) context.Context {
+	return applyLogMaskingRegexesToContext(ctx, gitlabTokenRegexes)
+}
+
+// ApplyRequestLogMaskingToContext masks the sensitive fields of the given endpoint path in the root and `GitLab` subsystem logger,
+// in addition to what ApplyLogMaskingToContext masks. It's used for the logs of the requests to the GitLab API.
+func ApplyRequestLogMaskingToContext(ctx context.Context, path string) context.Context {
+	return applyLogMaskingRegexesToContext(ctx, append(sensitiveBodyFieldRegexes(path), gitlabTokenRegexes...))
+}
 
+func applyLogMaskingRegexesToContext(ctx context.Context, regexes []*regexp.Regexp) context.Context {
 	// Configure the "root" logger within the context
 	// This will mask any logging done directly using the tflog command that doesn't explicitly call a subsystem.
-	ctx = tflog.MaskMessageRegexes(ctx, regexp.MustCompile(gitlabPersonlAccessTokenRegex))
-	ctx = tflog.MaskLogRegexes(ctx, regexp.MustCompile(gitlabPersonlAccessTokenRegex))
-	ctx = tflog.MaskAllFieldValuesRegexes(ctx, regexp.MustCompile(gitlabPersonlAccessTokenRegex))
+	ctx = tflog.MaskMessageRegexes(ctx, regexes...)
+	ctx = tflog.MaskLogRegexes(ctx, regexes...)
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, regexes...)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, gitlabTokenHeaderFieldKeys...)
 
 	// The "GitLab" subsystem is what is used for logging API messages, so configure it here as well.
 	subSystemName := "GitLab"
 	ctx = tflog.NewSubsystem(ctx, subSystemName)
-	ctx = tflog.SubsystemMaskMessageRegexes(ctx, subSystemName, regexp.MustCompile(gitlabPersonlAccessTokenRegex))
-	ctx = tflog.SubsystemMaskLogRegexes(ctx, subSystemName, regexp.MustCompile(gitlabPersonlAccessTokenRegex))
-	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, subSystemName, regexp.MustCompile(gitlabPersonlAccessTokenRegex))
+	ctx = tflog.SubsystemMaskMessageRegexes(ctx, subSystemName, regexes...)
+	ctx = tflog.SubsystemMaskLogRegexes(ctx, subSystemName, regexes...)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, subSystemName, regexes...)
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subSystemName, gitlabTokenHeaderFieldKeys...)
+
+	return ctx
+}
+
+// ApplyTokenLogMaskingToContext masks the given tokens in the root and `GitLab` subsystem logger,
+// like ApplyLogMaskingToContext does for personal access tokens.
+// This is used for tokens which don't have a well-known format, e.g. the ones read from a command or file.
+func ApplyTokenLogMaskingToContext(ctx context.Context, tokens ...string) context.Context {
+	ctx = tflog.MaskMessageStrings(ctx, tokens...)
+	ctx = tflog.MaskLogStrings(ctx, tokens...)
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, tokens...)
+
+	subSystemName := "GitLab"
+	ctx = tflog.SubsystemMaskMessageStrings(ctx, subSystemName, tokens...)
+	ctx = tflog.SubsystemMaskLogStrings(ctx, subSystemName, tokens...)
+	ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, subSystemName, tokens...)
 
 	return ctx
 }
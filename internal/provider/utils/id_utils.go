@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"strings"
 )
@@ -15,6 +16,34 @@ func ParseTwoPartID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+// ParseTwoPartIDWithLookup returns the pieces of id `a:b` as a, b like ParseTwoPartID,
+// but resolves them with the given lookups first, e.g. to allow a full path or a name in place of a numeric ID.
+// The second lookup is called with the already resolved first piece.
+// A nil lookup returns the piece as is.
+func ParseTwoPartIDWithLookup(
+	ctx context.Context,
+	id string,
+	lookupFirst func(ctx context.Context, a string) (string, error),
+	lookupSecond func(ctx context.Context, a string, b string) (string, error),
+) (string, string, error) {
+	a, b, err := ParseTwoPartID(id)
+	if err != nil {
+		return "", "", err
+	}
+
+	if lookupFirst != nil {
+		if a, err = lookupFirst(ctx, a); err != nil {
+			return "", "", err
+		}
+	}
+	if lookupSecond != nil {
+		if b, err = lookupSecond(ctx, a, b); err != nil {
+			return "", "", err
+		}
+	}
+	return a, b, nil
+}
+
 // format the strings into an id `a:b`
 func BuildTwoPartID(a, b *string) string {
 	return fmt.Sprintf("%s:%s", *a, *b)
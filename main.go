@@ -4,10 +4,12 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 
 	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider"
+	"gitlab.com/gitlab-org/terraform-provider-gitlab/internal/provider/generate"
 )
 
 var (
@@ -17,6 +19,14 @@ var (
 )
 
 func main() {
+	// The `generate` command generates the configuration of existing resources instead of serving the provider.
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := generate.Run(context.Background(), version, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	debugFlag := flag.Bool("debug", false, "Start provider in debug mode.")
 	flag.Parse()
 